@@ -17,7 +17,9 @@ func newMonoEVMAnteHandler(options HandlerOptions) sdk.AnteHandler {
 			options.EvmKeeper,
 			options.DistributionKeeper,
 			options.StakingKeeper,
+			options.FeegrantKeeper,
 			options.MaxTxGasWanted,
+			options.PriceBump,
 		),
 	)
 }
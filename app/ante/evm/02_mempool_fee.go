@@ -3,9 +3,13 @@
 package evm
 
 import (
+	"math/big"
+
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 )
 
 // CheckMempoolFee checks if the provided fee is at least as large as the local validator's
@@ -26,3 +30,38 @@ func CheckMempoolFee(fee, mempoolMinGasPrice, gasLimit sdkmath.LegacyDec, isLond
 
 	return nil
 }
+
+// CheckMinTip checks if the priority fee per gas of the transaction is at least
+// the minimum tip defined in the fee market parameters. For dynamic fee
+// transactions the priority fee is the GasTipCap, while for legacy and access list
+// transactions it is the effective tip, i.e. the gas price minus the base fee.
+// A nil or zero minimum tip disables the check.
+func CheckMinTip(txData evmtypes.TxData, baseFee, minTip *big.Int) error {
+	if minTip == nil || minTip.Sign() == 0 {
+		return nil
+	}
+
+	tip := new(big.Int)
+	if txData.TxType() == ethtypes.DynamicFeeTxType {
+		if gasTipCap := txData.GetGasTipCap(); gasTipCap != nil {
+			tip.Set(gasTipCap)
+		}
+	} else {
+		if gasPrice := txData.GetGasPrice(); gasPrice != nil {
+			tip.Set(gasPrice)
+		}
+		if baseFee != nil {
+			tip.Sub(tip, baseFee)
+		}
+	}
+
+	if tip.Cmp(minTip) < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFee,
+			"priority fee per gas below minimum tip; got: %s required: %s",
+			tip, minTip,
+		)
+	}
+
+	return nil
+}
@@ -3,9 +3,12 @@
 package evm_test
 
 import (
+	"math/big"
+
 	sdkmath "cosmossdk.io/math"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/evmos/evmos/v16/app/ante/evm"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 )
 
 func (suite *EvmAnteTestSuite) TestMempoolFee() {
@@ -63,3 +66,100 @@ func (suite *EvmAnteTestSuite) TestMempoolFee() {
 		})
 	}
 }
+
+func (suite *EvmAnteTestSuite) TestMinTip() {
+	intPtr := func(i int64) *sdkmath.Int {
+		v := sdkmath.NewInt(i)
+		return &v
+	}
+
+	testCases := []struct {
+		name          string
+		expectedError error
+		txData        evmtypes.TxData
+		baseFee       *big.Int
+		minTip        *big.Int
+	}{
+		{
+			name:          "success: nil min tip disables the check",
+			expectedError: nil,
+			txData:        &evmtypes.DynamicFeeTx{GasFeeCap: intPtr(100), GasTipCap: intPtr(0)},
+			baseFee:       big.NewInt(10),
+			minTip:        nil,
+		},
+		{
+			name:          "success: zero min tip disables the check",
+			expectedError: nil,
+			txData:        &evmtypes.LegacyTx{GasPrice: intPtr(10)},
+			baseFee:       big.NewInt(10),
+			minTip:        big.NewInt(0),
+		},
+		{
+			name:          "success: dynamic fee tx tip equal to min tip",
+			expectedError: nil,
+			txData:        &evmtypes.DynamicFeeTx{GasFeeCap: intPtr(100), GasTipCap: intPtr(5)},
+			baseFee:       big.NewInt(10),
+			minTip:        big.NewInt(5),
+		},
+		{
+			name:          "fail: dynamic fee tx tip below min tip",
+			expectedError: errortypes.ErrInsufficientFee,
+			txData:        &evmtypes.DynamicFeeTx{GasFeeCap: intPtr(100), GasTipCap: intPtr(4)},
+			baseFee:       big.NewInt(10),
+			minTip:        big.NewInt(5),
+		},
+		{
+			name:          "fail: dynamic fee tx without tip",
+			expectedError: errortypes.ErrInsufficientFee,
+			txData:        &evmtypes.DynamicFeeTx{GasFeeCap: intPtr(100)},
+			baseFee:       big.NewInt(10),
+			minTip:        big.NewInt(5),
+		},
+		{
+			name:          "success: legacy tx effective tip equal to min tip",
+			expectedError: nil,
+			txData:        &evmtypes.LegacyTx{GasPrice: intPtr(15)},
+			baseFee:       big.NewInt(10),
+			minTip:        big.NewInt(5),
+		},
+		{
+			name:          "fail: legacy tx effective tip below min tip",
+			expectedError: errortypes.ErrInsufficientFee,
+			txData:        &evmtypes.LegacyTx{GasPrice: intPtr(14)},
+			baseFee:       big.NewInt(10),
+			minTip:        big.NewInt(5),
+		},
+		{
+			name:          "success: access list tx effective tip above min tip",
+			expectedError: nil,
+			txData:        &evmtypes.AccessListTx{GasPrice: intPtr(20)},
+			baseFee:       big.NewInt(10),
+			minTip:        big.NewInt(5),
+		},
+		{
+			name:          "success: legacy tx without base fee uses gas price as tip",
+			expectedError: nil,
+			txData:        &evmtypes.LegacyTx{GasPrice: intPtr(5)},
+			baseFee:       nil,
+			minTip:        big.NewInt(5),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Function under test
+			err := evm.CheckMinTip(
+				tc.txData,
+				tc.baseFee,
+				tc.minTip,
+			)
+
+			if tc.expectedError != nil {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.expectedError.Error())
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -9,6 +9,8 @@ import (
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/ethereum/go-ethereum/common"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 )
 
@@ -33,6 +35,37 @@ func ValidateMsg(
 	)
 }
 
+// CheckPaused returns an error if the processing of ethereum transactions is
+// paused through governance.
+func CheckPaused(evmParams evmtypes.Params) error {
+	if evmParams.Paused {
+		return errorsmod.Wrap(evmtypes.ErrEVMPaused, "ethereum transactions are paused by governance")
+	}
+	return nil
+}
+
+// CheckBlockedAddresses returns an error if the sender or the recipient of the
+// transaction is blocked through governance. The check is skipped when no
+// address is blocked.
+//
+// NOTE: only the tx fields are checked. A contract that is not blocked can
+// still transfer value to or call a blocked address during the execution.
+func CheckBlockedAddresses(evmParams evmtypes.Params, from common.Address, to *common.Address) error {
+	if len(evmParams.BlockedAddresses) == 0 {
+		return nil
+	}
+
+	if evmParams.IsBlockedAddress(from) {
+		return errorsmod.Wrapf(evmtypes.ErrBlockedAddress, "sender %s", from)
+	}
+
+	if to != nil && evmParams.IsBlockedAddress(*to) {
+		return errorsmod.Wrapf(evmtypes.ErrBlockedAddress, "recipient %s", to)
+	}
+
+	return nil
+}
+
 // checkDisabledCreateCall checks if the transaction is a contract creation or call
 // and it is disabled through governance
 func checkDisabledCreateCall(
@@ -100,6 +133,52 @@ func ValidateTx(tx sdktypes.Tx) (*tx.Fee, error) {
 	return authInfo.Fee, nil
 }
 
+// GetFeePayer returns the fee payer set in the ethereum tx extension option of
+// the given tx, or nil if the fees are paid by the senders of the ethereum
+// transactions. Since the extension option is not covered by the ethereum
+// signatures, the fee payer must sign the hashes of the ethereum transactions
+// to consent to pay their fees.
+func GetFeePayer(tx sdktypes.Tx) (sdktypes.AccAddress, error) {
+	txWithExtensions, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return nil, nil
+	}
+
+	opts := txWithExtensions.GetExtensionOptions()
+	if len(opts) == 0 {
+		return nil, nil
+	}
+
+	var option evmtypes.ExtensionOptionsEthereumTx
+	if err := option.Unmarshal(opts[0].GetValue()); err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "invalid ethereum tx extension option")
+	}
+
+	if option.FeePayer == "" {
+		return nil, nil
+	}
+
+	feePayer, err := sdktypes.AccAddressFromBech32(option.FeePayer)
+	if err != nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid fee payer address: %s", err)
+	}
+
+	msgs := tx.GetMsgs()
+	txHashes := make([]common.Hash, 0, len(msgs))
+	for _, msg := range msgs {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return nil, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+		txHashes = append(txHashes, ethMsg.AsTransaction().Hash())
+	}
+
+	if err := evmtypes.VerifyFeePayerSignature(feePayer, option.FeePayerSig, txHashes...); err != nil {
+		return nil, err
+	}
+	return feePayer, nil
+}
+
 func CheckTxFee(txFeeInfo *tx.Fee, txFee sdktypes.Coins, txGasLimit uint64) error {
 	if txFeeInfo == nil {
 		return nil
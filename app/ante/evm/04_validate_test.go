@@ -3,13 +3,19 @@
 package evm_test
 
 import (
+	"fmt"
 	"math/big"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v16/app/ante/evm"
+	commonfactory "github.com/evmos/evmos/v16/testutil/integration/common/factory"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/factory"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
 	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 )
 
@@ -214,3 +220,157 @@ func getTxByType(typeTx string, recipient common.Address) evmtypes.EvmTxArgs {
 		panic("invalid type")
 	}
 }
+
+func (suite *EvmAnteTestSuite) TestCheckPaused() {
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+
+	testCases := []struct {
+		name          string
+		paused        bool
+		expectedError error
+	}{
+		{
+			name:          "success: EVM not paused",
+			paused:        false,
+			expectedError: nil,
+		},
+		{
+			name:          "fail: EVM paused",
+			paused:        true,
+			expectedError: evmtypes.ErrEVMPaused,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("%v_%v", evmtypes.GetTxTypeName(suite.ethTxType), tc.name), func() {
+			params := unitNetwork.App.EvmKeeper.GetParams(unitNetwork.GetContext())
+			params.Paused = tc.paused
+			err := unitNetwork.App.EvmKeeper.SetParams(unitNetwork.GetContext(), params)
+			suite.Require().NoError(err)
+
+			// Function under test
+			err = evm.CheckPaused(params)
+			if tc.expectedError != nil {
+				suite.Require().ErrorIs(err, tc.expectedError)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			// ethereum txs are rejected by the ante handler while paused
+			receiver := keyring.GetAddr(1)
+			txArgs, err := txFactory.GenerateDefaultTxTypeArgs(keyring.GetAddr(0), suite.ethTxType)
+			suite.Require().NoError(err)
+			txArgs.To = &receiver
+			txArgs.Amount = big.NewInt(1)
+
+			_, err = txFactory.ExecuteEthTx(keyring.GetPrivKey(0), txArgs)
+			if tc.expectedError != nil {
+				suite.Require().ErrorContains(err, tc.expectedError.Error())
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			// cosmos txs are not affected
+			_, err = txFactory.ExecuteCosmosTx(keyring.GetPrivKey(1), commonfactory.CosmosTxArgs{
+				Msgs: []sdktypes.Msg{banktypes.NewMsgSend(
+					keyring.GetAccAddr(1),
+					keyring.GetAccAddr(0),
+					sdktypes.NewCoins(sdktypes.NewCoin(unitNetwork.GetDenom(), sdktypes.NewInt(1))),
+				)},
+			})
+			suite.Require().NoError(err)
+
+			suite.Require().NoError(unitNetwork.NextBlock())
+		})
+	}
+}
+
+func (suite *EvmAnteTestSuite) TestCheckBlockedAddresses() {
+	keyring := testkeyring.New(3)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+
+	sender := keyring.GetAddr(0)
+	receiver := keyring.GetAddr(1)
+
+	testCases := []struct {
+		name          string
+		blocked       []string
+		to            *common.Address
+		expectedError error
+	}{
+		{
+			name:          "success: no blocked addresses",
+			blocked:       nil,
+			to:            &receiver,
+			expectedError: nil,
+		},
+		{
+			name:          "success: sender and recipient not blocked",
+			blocked:       []string{keyring.GetAddr(2).Hex()},
+			to:            &receiver,
+			expectedError: nil,
+		},
+		{
+			name:          "success: contract creation from not blocked sender",
+			blocked:       []string{receiver.Hex()},
+			to:            nil,
+			expectedError: nil,
+		},
+		{
+			name:          "fail: blocked sender",
+			blocked:       []string{sender.Hex()},
+			to:            &receiver,
+			expectedError: evmtypes.ErrBlockedAddress,
+		},
+		{
+			name:          "fail: blocked recipient",
+			blocked:       []string{receiver.Hex()},
+			to:            &receiver,
+			expectedError: evmtypes.ErrBlockedAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("%v_%v", evmtypes.GetTxTypeName(suite.ethTxType), tc.name), func() {
+			params := unitNetwork.App.EvmKeeper.GetParams(unitNetwork.GetContext())
+			params.BlockedAddresses = tc.blocked
+			err := unitNetwork.App.EvmKeeper.SetParams(unitNetwork.GetContext(), params)
+			suite.Require().NoError(err)
+
+			// Function under test
+			err = evm.CheckBlockedAddresses(params, sender, tc.to)
+			if tc.expectedError != nil {
+				suite.Require().ErrorIs(err, tc.expectedError)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			// value transfers involving a blocked address are rejected by the ante handler
+			if tc.to == nil {
+				return
+			}
+			txArgs, err := txFactory.GenerateDefaultTxTypeArgs(sender, suite.ethTxType)
+			suite.Require().NoError(err)
+			txArgs.To = tc.to
+			txArgs.Amount = big.NewInt(1)
+
+			_, err = txFactory.ExecuteEthTx(keyring.GetPrivKey(0), txArgs)
+			if tc.expectedError != nil {
+				suite.Require().ErrorContains(err, tc.expectedError.Error())
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			suite.Require().NoError(unitNetwork.NextBlock())
+		})
+	}
+}
@@ -14,16 +14,18 @@ import (
 )
 
 // VerifyAccountBalance checks that the account balance is greater than the total transaction cost.
+// If the fees are paid by a fee payer, the account balance only needs to cover the transaction value.
 // The account will be set to store if it doesn't exist, i.e. cannot be found on store.
 // This method will fail if:
 // - from address is NOT an EOA
-// - account balance is lower than the transaction cost
+// - account balance is lower than the transaction cost, or the value if there is a fee payer
 func VerifyAccountBalance(
 	ctx sdk.Context,
 	accountKeeper evmtypes.AccountKeeper,
 	account *statedb.Account,
 	from common.Address,
 	txData evmtypes.TxData,
+	feePayer sdk.AccAddress,
 ) error {
 	// check whether the sender address is EOA
 	if account != nil && account.IsContract() {
@@ -39,6 +41,16 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
+	if !feePayer.Empty() {
+		if value := txData.GetValue(); account.Balance.Cmp(value) < 0 {
+			return errorsmod.Wrapf(
+				errortypes.ErrInsufficientFunds,
+				"sender balance < tx value (%s < %s)", account.Balance, value,
+			)
+		}
+		return nil
+	}
+
 	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance), txData); err != nil {
 		return errorsmod.Wrap(err, "failed to check sender balance")
 	}
@@ -111,6 +111,7 @@ func (suite *EvmAnteTestSuite) TestVerifyAccountBalance() {
 				statedbAccount,
 				senderKey.Addr,
 				txData,
+				nil,
 			)
 
 			if tc.expectedError != nil {
@@ -9,6 +9,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
 	anteutils "github.com/evmos/evmos/v16/app/ante/utils"
 	"github.com/evmos/evmos/v16/types"
@@ -58,12 +59,61 @@ func ConsumeFeesAndEmitEvent(
 		return err
 	}
 
+	emitFeeEvent(ctx, fees)
+	return nil
+}
+
+// ConsumeGrantedFeesAndEmitEvent deduces the fees of a tx paid through a fee grant
+// from the fee payer and emits the event. Unlike ConsumeFeesAndEmitEvent, the
+// staking rewards of the fee payer are never claimed to cover the fees, since
+// the tx was signed by the grantee.
+func ConsumeGrantedFeesAndEmitEvent(
+	ctx sdktypes.Context,
+	evmKeeper EVMKeeper,
+	fees sdktypes.Coins,
+	feePayer sdktypes.AccAddress,
+) error {
+	if !fees.IsZero() {
+		if err := evmKeeper.DeductTxCostsFromUserBalance(
+			ctx,
+			fees,
+			common.BytesToAddress(feePayer),
+		); err != nil {
+			return errorsmod.Wrapf(err, "failed to deduct transaction costs from fee payer balance")
+		}
+	}
+
+	emitFeeEvent(ctx, fees)
+	return nil
+}
+
+// emitFeeEvent emits the event with the fees paid by the tx.
+func emitFeeEvent(ctx sdktypes.Context, fees sdktypes.Coins) {
 	ctx.EventManager().EmitEvent(
 		sdktypes.NewEvent(
 			sdktypes.EventTypeTx,
 			sdktypes.NewAttribute(sdktypes.AttributeKeyFee, fees.String()),
 		),
 	)
+}
+
+// UseFeeGrant checks that the fee payer has granted an allowance to the sender of
+// the given ethereum msg that covers the fees, and updates it accordingly.
+func UseFeeGrant(
+	ctx sdktypes.Context,
+	feegrantKeeper authante.FeegrantKeeper,
+	feePayer sdktypes.AccAddress,
+	sender sdktypes.AccAddress,
+	fees sdktypes.Coins,
+	msg sdktypes.Msg,
+) error {
+	if feegrantKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee grants are not enabled")
+	}
+
+	if err := feegrantKeeper.UseGrantedFees(ctx, feePayer, sender, fees, []sdktypes.Msg{msg}); err != nil {
+		return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feePayer, sender)
+	}
 	return nil
 }
 
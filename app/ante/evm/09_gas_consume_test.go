@@ -3,12 +3,25 @@
 package evm_test
 
 import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v16/app"
 	evmante "github.com/evmos/evmos/v16/app/ante/evm"
+	"github.com/evmos/evmos/v16/encoding"
+	"github.com/evmos/evmos/v16/testutil"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/factory"
 	"github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
 	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
 	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 )
 
 func (suite *EvmAnteTestSuite) TestUpdateComulativeGasWanted() {
@@ -192,3 +205,261 @@ func (suite *EvmAnteTestSuite) TestConsumeGasAndEmitEvent() {
 		})
 	}
 }
+
+func (suite *EvmAnteTestSuite) TestConsumeGrantedFeesAndEmitEvent() {
+	keyring := testkeyring.New(1)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	keepers := &evmante.ConsumeGasKeepers{
+		Bank:         unitNetwork.App.BankKeeper,
+		Distribution: unitNetwork.App.DistrKeeper,
+		Evm:          unitNetwork.App.EvmKeeper,
+		Staking:      unitNetwork.App.StakingKeeper,
+	}
+	fees := sdktypes.NewCoins(sdktypes.NewCoin(unitNetwork.GetDenom(), sdktypes.NewInt(1000)))
+
+	// the fee payer has no spendable balance but enough staking rewards
+	feePayer := keyring.GetKey(keyring.AddKey()).AccAddr
+	_, err := testutil.PrepareAccountsForDelegationRewards(
+		suite.T(), unitNetwork.GetContext(), unitNetwork.App, feePayer, sdkmath.ZeroInt(), sdkmath.NewInt(1e18),
+	)
+	suite.Require().NoError(err)
+	suite.Require().NoError(unitNetwork.NextBlock())
+	ctx := unitNetwork.GetContext()
+
+	// the rewards are claimed for the fees of a tx signed by the fee payer
+	cacheCtx, _ := ctx.CacheContext()
+	err = evmante.ConsumeFeesAndEmitEvent(cacheCtx, keepers, fees, feePayer)
+	suite.Require().NoError(err)
+
+	// but not for the fees granted to another signer
+	cacheCtx, _ = ctx.CacheContext()
+	err = evmante.ConsumeGrantedFeesAndEmitEvent(cacheCtx, unitNetwork.App.EvmKeeper, fees, feePayer)
+	suite.Require().ErrorContains(err, sdkerrors.ErrInsufficientFunds.Error())
+
+	rewards, err := testutil.GetTotalDelegationRewards(cacheCtx, unitNetwork.App.DistrKeeper, feePayer)
+	suite.Require().NoError(err)
+	suite.Require().False(rewards.IsZero())
+}
+
+func (suite *EvmAnteTestSuite) TestFeeGrant() {
+	keyring := testkeyring.New(1)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+	txConfig := encoding.MakeConfig(app.ModuleBasics).TxConfig
+
+	granter := keyring.GetKey(0)
+	denom := unitNetwork.GetDenom()
+	spendLimit := sdktypes.NewCoins(sdktypes.NewCoin(denom, sdktypes.NewInt(1e18)))
+
+	grantBasicAllowance := func(grantee sdktypes.AccAddress) {
+		err := unitNetwork.App.FeeGrantKeeper.GrantAllowance(
+			unitNetwork.GetContext(), granter.AccAddr, grantee, &feegrant.BasicAllowance{},
+		)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(grantee sdktypes.AccAddress)
+		// feePayerSig returns the fee payer signature of the tx, which is signed
+		// by the granter if nil
+		feePayerSig func(txHash common.Hash, grantee testkeyring.Key) []byte
+		// postCheck runs additional checks after a successful tx
+		postCheck   func(grantee sdktypes.AccAddress, fees *big.Int)
+		errContains string
+	}{
+		{
+			name:     "success: fees are paid by the granter",
+			malleate: grantBasicAllowance,
+		},
+		{
+			name: "success: refunded fees are given back to the allowance",
+			malleate: func(grantee sdktypes.AccAddress) {
+				err := unitNetwork.App.FeeGrantKeeper.GrantAllowance(
+					unitNetwork.GetContext(), granter.AccAddr, grantee, &feegrant.BasicAllowance{SpendLimit: spendLimit},
+				)
+				suite.Require().NoError(err)
+			},
+			postCheck: func(grantee sdktypes.AccAddress, fees *big.Int) {
+				allowance, err := unitNetwork.App.FeeGrantKeeper.GetAllowance(unitNetwork.GetContext(), granter.AccAddr, grantee)
+				suite.Require().NoError(err)
+				basicAllowance, ok := allowance.(*feegrant.BasicAllowance)
+				suite.Require().True(ok)
+				expSpendLimit := spendLimit.Sub(sdktypes.NewCoin(denom, sdkmath.NewIntFromBigInt(fees)))
+				suite.Require().Equal(expSpendLimit.String(), basicAllowance.SpendLimit.String())
+			},
+		},
+		{
+			name: "success: refunded fees are given back to a periodic allowance restricted to eth txs",
+			malleate: func(grantee sdktypes.AccAddress) {
+				allowance, err := feegrant.NewAllowedMsgAllowance(
+					&feegrant.PeriodicAllowance{
+						Basic:            feegrant.BasicAllowance{SpendLimit: spendLimit},
+						Period:           time.Hour,
+						PeriodSpendLimit: spendLimit,
+					},
+					[]string{sdktypes.MsgTypeURL(&evmtypes.MsgEthereumTx{})},
+				)
+				suite.Require().NoError(err)
+				err = unitNetwork.App.FeeGrantKeeper.GrantAllowance(unitNetwork.GetContext(), granter.AccAddr, grantee, allowance)
+				suite.Require().NoError(err)
+			},
+			postCheck: func(grantee sdktypes.AccAddress, fees *big.Int) {
+				allowance, err := unitNetwork.App.FeeGrantKeeper.GetAllowance(unitNetwork.GetContext(), granter.AccAddr, grantee)
+				suite.Require().NoError(err)
+				allowedMsgAllowance, ok := allowance.(*feegrant.AllowedMsgAllowance)
+				suite.Require().True(ok)
+				inner, err := allowedMsgAllowance.GetAllowance()
+				suite.Require().NoError(err)
+				periodicAllowance, ok := inner.(*feegrant.PeriodicAllowance)
+				suite.Require().True(ok)
+
+				expSpendLimit := spendLimit.Sub(sdktypes.NewCoin(denom, sdkmath.NewIntFromBigInt(fees)))
+				suite.Require().Equal(expSpendLimit.String(), periodicAllowance.PeriodCanSpend.String())
+				suite.Require().Equal(expSpendLimit.String(), periodicAllowance.Basic.SpendLimit.String())
+			},
+		},
+		{
+			name:     "fail: missing fee payer signature",
+			malleate: grantBasicAllowance,
+			feePayerSig: func(common.Hash, testkeyring.Key) []byte {
+				return nil
+			},
+			errContains: "signature 65 bytes",
+		},
+		{
+			name:     "fail: tx rewrapped with a fee payer that did not sign it",
+			malleate: grantBasicAllowance,
+			feePayerSig: func(txHash common.Hash, grantee testkeyring.Key) []byte {
+				sig, err := grantee.Priv.Sign(evmtypes.FeePayerSignBytes(txHash))
+				suite.Require().NoError(err)
+				return sig
+			},
+			errContains: "fee payer signature signed by",
+		},
+		{
+			name:        "fail: no fee grant",
+			malleate:    func(sdktypes.AccAddress) {},
+			errContains: "fee-grant not found",
+		},
+		{
+			name: "fail: revoked fee grant",
+			malleate: func(grantee sdktypes.AccAddress) {
+				grantBasicAllowance(grantee)
+
+				msgServer := feegrantkeeper.NewMsgServerImpl(unitNetwork.App.FeeGrantKeeper)
+				_, err := msgServer.RevokeAllowance(unitNetwork.GetContext(), &feegrant.MsgRevokeAllowance{
+					Granter: granter.AccAddr.String(),
+					Grantee: grantee.String(),
+				})
+				suite.Require().NoError(err)
+			},
+			errContains: "fee-grant not found",
+		},
+		{
+			name: "fail: expired fee grant",
+			malleate: func(grantee sdktypes.AccAddress) {
+				ctx := unitNetwork.GetContext()
+				expiration := ctx.BlockTime().Add(time.Hour)
+				err := unitNetwork.App.FeeGrantKeeper.GrantAllowance(ctx, granter.AccAddr, grantee, &feegrant.BasicAllowance{
+					Expiration: &expiration,
+				})
+				suite.Require().NoError(err)
+
+				suite.Require().NoError(unitNetwork.NextBlockAfter(2 * time.Hour))
+			},
+			errContains: "fee allowance expired",
+		},
+		{
+			name: "fail: fees exceed the spend limit",
+			malleate: func(grantee sdktypes.AccAddress) {
+				err := unitNetwork.App.FeeGrantKeeper.GrantAllowance(
+					unitNetwork.GetContext(), granter.AccAddr, grantee, &feegrant.BasicAllowance{
+						SpendLimit: sdktypes.NewCoins(sdktypes.NewCoin(denom, sdktypes.NewInt(1))),
+					},
+				)
+				suite.Require().NoError(err)
+			},
+			errContains: "fee limit exceeded",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("%v_%v", evmtypes.GetTxTypeName(suite.ethTxType), tc.name), func() {
+			// the grantee has no funds to pay for the fees
+			grantee := keyring.GetKey(keyring.AddKey())
+			tc.malleate(grantee.AccAddr)
+
+			txArgs, err := txFactory.GenerateDefaultTxTypeArgs(grantee.Addr, suite.ethTxType)
+			suite.Require().NoError(err)
+			txArgs.To = &granter.Addr
+			txArgs.GasLimit = 100_000
+
+			msg, err := txFactory.GenerateMsgEthereumTx(grantee.Priv, txArgs)
+			suite.Require().NoError(err)
+			msg, err = txFactory.SignMsgEthereumTx(grantee.Priv, msg)
+			suite.Require().NoError(err)
+
+			txHash := msg.AsTransaction().Hash()
+			var feePayerSig []byte
+			if tc.feePayerSig != nil {
+				feePayerSig = tc.feePayerSig(txHash, grantee)
+			} else {
+				feePayerSig, err = granter.Priv.Sign(evmtypes.FeePayerSignBytes(txHash))
+				suite.Require().NoError(err)
+			}
+
+			tx, err := msg.BuildTxWithFeePayer(txConfig.NewTxBuilder(), denom, granter.AccAddr, feePayerSig)
+			suite.Require().NoError(err)
+			txBytes, err := txConfig.TxEncoder()(tx)
+			suite.Require().NoError(err)
+
+			baseFeeRes, err := grpcHandler.GetBaseFee()
+			suite.Require().NoError(err)
+			prevBalance, err := grpcHandler.GetBalance(granter.AccAddr, denom)
+			suite.Require().NoError(err)
+
+			// the tx goes through the whole ante handler and message execution
+			res, err := unitNetwork.BroadcastTxSync(txBytes)
+			suite.Require().NoError(err)
+
+			if tc.errContains != "" {
+				suite.Require().False(res.IsOK(), "expected tx to fail")
+				suite.Require().Contains(res.Log, tc.errContains)
+			} else {
+				suite.Require().True(res.IsOK(), "expected tx to succeed: %s", res.Log)
+
+				ethRes, err := txFactory.GetEvmTxResponseFromTxResult(res)
+				suite.Require().NoError(err)
+
+				// the granter pays the gas used, since the leftover gas is refunded to it
+				txData, err := evmtypes.UnpackTxData(msg.Data)
+				suite.Require().NoError(err)
+				gasPrice := txData.EffectiveGasPrice(baseFeeRes.BaseFee.BigInt())
+				expFees := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(ethRes.GasUsed))
+
+				afterBalance, err := grpcHandler.GetBalance(granter.AccAddr, denom)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expFees.String(), prevBalance.Balance.Amount.Sub(afterBalance.Balance.Amount).String())
+
+				// the grantee does not pay any fees
+				granteeBalance, err := grpcHandler.GetBalance(grantee.AccAddr, denom)
+				suite.Require().NoError(err)
+				suite.Require().True(granteeBalance.Balance.Amount.IsZero())
+
+				if tc.postCheck != nil {
+					tc.postCheck(grantee.AccAddr, expFees)
+				}
+			}
+
+			// Reset the context
+			err = unitNetwork.NextBlock()
+			suite.Require().NoError(err)
+		})
+	}
+}
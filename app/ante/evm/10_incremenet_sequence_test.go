@@ -3,12 +3,19 @@
 package evm_test
 
 import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/evmos/evmos/v16/app/ante/evm"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/factory"
 	"github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
 	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
 	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 )
 
 func (suite *EvmAnteTestSuite) TestIncrementSequence() {
@@ -70,3 +77,159 @@ func (suite *EvmAnteTestSuite) TestIncrementSequence() {
 		})
 	}
 }
+
+func (suite *EvmAnteTestSuite) TestIncrementNonceOrReplace() {
+	keyring := testkeyring.New(1)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	accAddr := keyring.GetAccAddr(0)
+	sender := keyring.GetAddr(0)
+	priceBump := uint64(10)
+
+	newTxData := func(nonce uint64, gasPrice int64) evmtypes.TxData {
+		price := sdkmath.NewInt(gasPrice)
+		return &evmtypes.LegacyTx{Nonce: nonce, GasPrice: &price}
+	}
+
+	ctx := unitNetwork.GetContext().WithIsCheckTx(true)
+	account := unitNetwork.App.AccountKeeper.GetAccount(ctx, accAddr)
+	nonce := account.GetSequence()
+
+	testCases := []struct {
+		name          string
+		ctx           func() sdk.Context
+		txData        evmtypes.TxData
+		expectedError error
+		expSequence   uint64
+		expGasPrice   *big.Int
+	}{
+		{
+			name:        "success: pending tx increments sequence",
+			ctx:         func() sdk.Context { return ctx },
+			txData:      newTxData(nonce, 100),
+			expSequence: nonce + 1,
+			expGasPrice: big.NewInt(100),
+		},
+		{
+			name:          "fail: replacement with the same gas price",
+			ctx:           func() sdk.Context { return ctx },
+			txData:        newTxData(nonce, 100),
+			expectedError: errortypes.ErrInsufficientFee,
+			expSequence:   nonce + 1,
+			expGasPrice:   big.NewInt(100),
+		},
+		{
+			name:          "fail: replacement below the price bump",
+			ctx:           func() sdk.Context { return ctx },
+			txData:        newTxData(nonce, 109),
+			expectedError: errortypes.ErrInsufficientFee,
+			expSequence:   nonce + 1,
+			expGasPrice:   big.NewInt(100),
+		},
+		{
+			name:        "success: replacement with the price bump",
+			ctx:         func() sdk.Context { return ctx },
+			txData:      newTxData(nonce, 110),
+			expSequence: nonce + 1,
+			expGasPrice: big.NewInt(110),
+		},
+		{
+			name:          "fail: same nonce outside of CheckTx",
+			ctx:           func() sdk.Context { return ctx.WithIsCheckTx(false) },
+			txData:        newTxData(nonce, 200),
+			expectedError: errortypes.ErrInvalidSequence,
+			expSequence:   nonce + 1,
+			expGasPrice:   big.NewInt(110),
+		},
+		{
+			name: "fail: used nonce without a pending tx",
+			ctx: func() sdk.Context {
+				// the nonce was used by a committed tx
+				account := unitNetwork.App.AccountKeeper.GetAccount(ctx, accAddr)
+				suite.Require().NoError(account.SetSequence(nonce + 2))
+				unitNetwork.App.AccountKeeper.SetAccount(ctx, account)
+				return ctx
+			},
+			txData:        newTxData(nonce+1, 200),
+			expectedError: errortypes.ErrInvalidSequence,
+			expSequence:   nonce + 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := tc.ctx()
+			account := unitNetwork.App.AccountKeeper.GetAccount(ctx, accAddr)
+
+			// Function under test
+			err := evm.IncrementNonceOrReplace(
+				ctx,
+				unitNetwork.App.AccountKeeper,
+				unitNetwork.App.EvmKeeper,
+				account,
+				tc.txData,
+				nil,
+				priceBump,
+			)
+
+			if tc.expectedError != nil {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.expectedError.Error())
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			updatedAccount := unitNetwork.App.AccountKeeper.GetAccount(ctx, accAddr)
+			suite.Require().Equal(tc.expSequence, updatedAccount.GetSequence())
+			suite.Require().Equal(tc.expGasPrice, unitNetwork.App.EvmKeeper.GetPendingTxGasPrice(ctx, sender, tc.txData.GetNonce()))
+		})
+	}
+}
+
+func (suite *EvmAnteTestSuite) TestReplacementFeeRefund() {
+	keyring := testkeyring.New(1)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+
+	recipient := keyring.GetAddr(0)
+	gasLimit := uint64(21000)
+	newTxArgs := func(gasPrice int64) evmtypes.EvmTxArgs {
+		return evmtypes.EvmTxArgs{To: &recipient, GasLimit: gasLimit, GasPrice: big.NewInt(gasPrice)}
+	}
+
+	// the sender can only pay for the fees of the replacement once
+	senderAddr, senderPriv := utiltx.NewAddrKey()
+	replacementFee := sdkmath.NewIntFromUint64(gasLimit).MulRaw(200e9)
+	err := unitNetwork.App.BankKeeper.SendCoins(
+		unitNetwork.GetContext(),
+		keyring.GetAccAddr(0),
+		senderAddr.Bytes(),
+		sdk.Coins{{Denom: unitNetwork.GetDenom(), Amount: replacementFee}},
+	)
+	suite.Require().NoError(err)
+	suite.Require().NoError(unitNetwork.NextBlock())
+
+	res, err := txFactory.CheckEthTx(senderPriv, newTxArgs(100e9))
+	suite.Require().NoError(err)
+	suite.Require().True(res.IsOK(), "expected the original tx to pass: %s", res.Log)
+
+	// the fees of the original tx are refunded before charging the replacement
+	res, err = txFactory.CheckEthTx(senderPriv, newTxArgs(200e9))
+	suite.Require().NoError(err)
+	suite.Require().True(res.IsOK(), "expected the replacement to pass: %s", res.Log)
+
+	// a rejected replacement doesn't keep the refund of the previous one
+	res, err = txFactory.CheckEthTx(senderPriv, newTxArgs(150e9))
+	suite.Require().NoError(err)
+	suite.Require().False(res.IsOK(), "expected the underpriced replacement to fail")
+	suite.Require().Contains(res.Log, "replacement transaction underpriced")
+
+	res, err = txFactory.CheckEthTx(senderPriv, newTxArgs(300e9))
+	suite.Require().NoError(err)
+	suite.Require().False(res.IsOK(), "expected the unaffordable replacement to fail")
+	suite.Require().Contains(res.Log, "insufficient funds")
+}
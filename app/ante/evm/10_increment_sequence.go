@@ -4,11 +4,15 @@
 package evm
 
 import (
+	"math/big"
+
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 )
@@ -39,3 +43,99 @@ func IncrementNonce(
 	accountKeeper.SetAccount(ctx, account)
 	return nil
 }
+
+// IncrementNonceOrReplace increments the sequence of the account. In CheckTx, a
+// tx reusing the nonce of an eth tx accepted since the last commit is instead
+// accepted as its replacement, without incrementing the sequence, if its
+// effective gas price exceeds the one of the pending tx by at least priceBump
+// percent. The replaced tx is left out of the block proposals by the
+// PrepareProposal handler of the app, and dropped from the mempool on recheck
+// once a tx with its nonce has been committed.
+func IncrementNonceOrReplace(
+	ctx sdk.Context,
+	accountKeeper evmtypes.AccountKeeper,
+	evmKeeper EVMKeeper,
+	account authtypes.AccountI,
+	txData evmtypes.TxData,
+	baseFee *big.Int,
+	priceBump uint64,
+) error {
+	txNonce := txData.GetNonce()
+	if !ctx.IsCheckTx() {
+		return IncrementNonce(ctx, accountKeeper, account, txNonce)
+	}
+
+	sender := common.BytesToAddress(account.GetAddress())
+	gasPrice := txData.GetGasPrice()
+	if baseFee != nil {
+		gasPrice = txData.EffectiveGasPrice(baseFee)
+	}
+
+	if txNonce < account.GetSequence() {
+		if pendingGasPrice := evmKeeper.GetPendingTxGasPrice(ctx, sender, txNonce); pendingGasPrice != nil {
+			if err := CheckReplacementGasPrice(pendingGasPrice, gasPrice, priceBump); err != nil {
+				return err
+			}
+
+			evmKeeper.SetPendingTxGasPrice(ctx, sender, txNonce, gasPrice)
+			return nil
+		}
+	}
+
+	if err := IncrementNonce(ctx, accountKeeper, account, txNonce); err != nil {
+		return err
+	}
+
+	evmKeeper.SetPendingTxGasPrice(ctx, sender, txNonce, gasPrice)
+	return nil
+}
+
+// RefundReplacedTxFee refunds, in CheckTx, the fees paid by the pending tx with
+// the given sender and nonce, if any, to the account that paid them. It must be
+// called before the fees of the replacement are verified and deducted, so that
+// the sender only pays for one of the txs. If the replacement is rejected, the
+// refund is discarded along with the other CheckTx state changes.
+//
+// NOTE: the fee allowance used by the replaced tx, if it was paid through a fee
+// grant, is not restored.
+func RefundReplacedTxFee(
+	ctx sdk.Context,
+	bankKeeper evmtypes.BankKeeper,
+	evmKeeper EVMKeeper,
+	sender common.Address,
+	txNonce uint64,
+	evmDenom string,
+) error {
+	if !ctx.IsCheckTx() {
+		return nil
+	}
+
+	payer, fee := evmKeeper.GetPendingTxFee(ctx, sender, txNonce)
+	if fee == nil || fee.Sign() == 0 {
+		return nil
+	}
+
+	coins := sdk.Coins{{Denom: evmDenom, Amount: sdkmath.NewIntFromBigInt(fee)}}
+	if err := bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, payer, coins); err != nil {
+		return errorsmod.Wrapf(err, "failed to refund the fees of the replaced tx")
+	}
+
+	return nil
+}
+
+// CheckReplacementGasPrice checks that the gas price of a replacement tx is
+// higher than the one of the pending tx by at least priceBump percent.
+func CheckReplacementGasPrice(pendingGasPrice, gasPrice *big.Int, priceBump uint64) error {
+	minGasPrice := new(big.Int).Mul(pendingGasPrice, new(big.Int).SetUint64(100+priceBump))
+	minGasPrice.Div(minGasPrice, big.NewInt(100))
+
+	if gasPrice.Cmp(pendingGasPrice) <= 0 || gasPrice.Cmp(minGasPrice) < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFee,
+			"replacement transaction underpriced; got gas price %s, pending tx gas price %s, price bump %d%%",
+			gasPrice, pendingGasPrice, priceBump,
+		)
+	}
+
+	return nil
+}
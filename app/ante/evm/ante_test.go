@@ -1248,3 +1248,104 @@ func (suite *AnteTestSuite) TestAnteHandlerWithParams() {
 	}
 	suite.evmParamsOption = nil
 }
+
+func (suite *AnteTestSuite) TestAnteHandlerWithMultipleEthMsgs() {
+	addr, privKey := utiltx.NewAddrKey()
+	to := utiltx.GenerateAddress()
+
+	newMsg := func(nonce uint64, amount *big.Int) *evmtypes.MsgEthereumTx {
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  suite.app.EvmKeeper.ChainID(),
+			To:       &to,
+			Nonce:    nonce,
+			Amount:   amount,
+			GasLimit: 100000,
+			GasPrice: big.NewInt(150),
+		})
+		msg.From = addr.Hex()
+		return msg
+	}
+
+	testCases := []struct {
+		name         string
+		msgs         func() []*evmtypes.MsgEthereumTx
+		expPass      bool
+		expErr       string
+		expNonce     uint64
+		expGasWanted uint64
+	}{
+		{
+			"success - sequential nonces",
+			func() []*evmtypes.MsgEthereumTx {
+				return []*evmtypes.MsgEthereumTx{newMsg(1, big.NewInt(10)), newMsg(2, big.NewInt(10)), newMsg(3, big.NewInt(10))}
+			},
+			true,
+			"",
+			4,
+			300000,
+		},
+		{
+			"fail - repeated nonce within the bundle",
+			func() []*evmtypes.MsgEthereumTx {
+				return []*evmtypes.MsgEthereumTx{newMsg(1, big.NewInt(10)), newMsg(1, big.NewInt(10))}
+			},
+			false,
+			"invalid nonce; got 1, expected 2",
+			1,
+			0,
+		},
+		{
+			"fail - nonce gap within the bundle",
+			func() []*evmtypes.MsgEthereumTx {
+				return []*evmtypes.MsgEthereumTx{newMsg(1, big.NewInt(10)), newMsg(3, big.NewInt(10))}
+			},
+			false,
+			"invalid nonce; got 3, expected 2",
+			1,
+			0,
+		},
+		{
+			"fail - last message cannot be paid",
+			func() []*evmtypes.MsgEthereumTx {
+				return []*evmtypes.MsgEthereumTx{newMsg(1, big.NewInt(10)), newMsg(2, big.NewInt(10000000000))}
+			},
+			false,
+			"insufficient funds",
+			1,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.enableFeemarket = false
+			suite.SetupTest() // reset
+
+			acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+			suite.Require().NoError(acc.SetSequence(1))
+			suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+			err := suite.app.EvmKeeper.SetBalance(suite.ctx, addr, big.NewInt(10000000000))
+			suite.Require().NoError(err)
+
+			suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(100))
+
+			tx := suite.CreateTestMultiEthTx(privKey, tc.msgs()...)
+
+			// the ante handler runs on a cached context, which is only written
+			// if the whole bundle is valid, as done by the base app
+			cacheCtx, write := suite.ctx.CacheContext()
+			newCtx, err := suite.anteHandler(cacheCtx, tx, false)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expGasWanted, newCtx.GasMeter().Limit())
+				write()
+			} else {
+				suite.Require().ErrorContains(err, tc.expErr)
+			}
+
+			nonce := suite.app.AccountKeeper.GetAccount(suite.ctx, addr.Bytes()).GetSequence()
+			suite.Require().Equal(tc.expNonce, nonce)
+		})
+	}
+}
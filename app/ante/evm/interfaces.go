@@ -27,6 +27,11 @@ type EVMKeeper interface { //nolint: revive
 	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
 	ResetTransientGasUsed(ctx sdk.Context)
 	GetTxIndexTransient(ctx sdk.Context) uint64
+	SetTxFeePayerTransient(ctx sdk.Context, feePayer sdk.AccAddress)
+	GetPendingTxGasPrice(ctx sdk.Context, sender common.Address, nonce uint64) *big.Int
+	SetPendingTxGasPrice(ctx sdk.Context, sender common.Address, nonce uint64, gasPrice *big.Int)
+	GetPendingTxFee(ctx sdk.Context, sender common.Address, nonce uint64) (sdk.AccAddress, *big.Int)
+	SetPendingTxFee(ctx sdk.Context, sender common.Address, nonce uint64, payer sdk.AccAddress, fee *big.Int)
 	GetParams(ctx sdk.Context) evmtypes.Params
 }
 
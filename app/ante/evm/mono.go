@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
@@ -30,7 +31,9 @@ type MonoDecorator struct {
 	evmKeeper          EVMKeeper
 	distributionKeeper anteutils.DistributionKeeper
 	stakingKeeper      anteutils.StakingKeeper
+	feegrantKeeper     authante.FeegrantKeeper
 	maxGasWanted       uint64
+	priceBump          uint64
 }
 
 type DecoratorUtils struct {
@@ -42,6 +45,7 @@ type DecoratorUtils struct {
 	EvmDenom           string
 	MempoolMinGasPrice sdkmath.LegacyDec
 	GlobalMinGasPrice  sdkmath.LegacyDec
+	MinTip             *big.Int
 	BlockTxIndex       uint64
 	TxGasLimit         uint64
 	GasWanted          uint64
@@ -57,7 +61,9 @@ func NewMonoDecorator(
 	evmKeeper EVMKeeper,
 	distributionKeeper anteutils.DistributionKeeper,
 	stakingKeeper anteutils.StakingKeeper,
+	feegrantKeeper authante.FeegrantKeeper,
 	maxGasWanted uint64,
+	priceBump uint64,
 ) MonoDecorator {
 	return MonoDecorator{
 		accountKeeper:      accountKeeper,
@@ -66,7 +72,9 @@ func NewMonoDecorator(
 		evmKeeper:          evmKeeper,
 		distributionKeeper: distributionKeeper,
 		stakingKeeper:      stakingKeeper,
+		feegrantKeeper:     feegrantKeeper,
 		maxGasWanted:       maxGasWanted,
+		priceBump:          priceBump,
 	}
 }
 
@@ -95,6 +103,7 @@ func (md MonoDecorator) NewUtils(ctx sdk.Context) (*DecoratorUtils, error) {
 		BaseFee:            baseFee,
 		MempoolMinGasPrice: ctx.MinGasPrices().AmountOf(evmParams.EvmDenom),
 		GlobalMinGasPrice:  feeMarketParams.MinGasPrice,
+		MinTip:             feeMarketParams.MinTip.BigInt(),
 		EvmDenom:           evmParams.EvmDenom,
 		BlockTxIndex:       md.evmKeeper.GetTxIndexTransient(ctx),
 		TxGasLimit:         0,
@@ -128,6 +137,18 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
+	if err := CheckPaused(decUtils.EvmParams); err != nil {
+		return ctx, err
+	}
+
+	// the fee payer, if any, pays the fees of all the messages through fee grants
+	// to their senders, and receives the refund of the leftover gas
+	feePayer, err := GetFeePayer(tx)
+	if err != nil {
+		return ctx, err
+	}
+	md.evmKeeper.SetTxFeePayerTransient(ctx, feePayer)
+
 	// Use the lowest priority of all the messages as the final one.
 	for i, msg := range tx.GetMsgs() {
 		ethMsg, txData, from, err := evmtypes.UnpackEthMsg(msg)
@@ -145,6 +166,10 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			if err := CheckMempoolFee(fee, decUtils.MempoolMinGasPrice, gasLimit, decUtils.Rules.IsLondon); err != nil {
 				return ctx, err
 			}
+
+			if err := CheckMinTip(txData, decUtils.BaseFee, decUtils.MinTip); err != nil {
+				return ctx, err
+			}
 		}
 
 		// 3. min gas price (global min fee)
@@ -179,8 +204,24 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		// NOTE: sender address has been verified and cached
 		from = ethMsg.GetFrom()
 
-		// 6. account balance verification
 		fromAddr := common.HexToAddress(ethMsg.From)
+		if err := CheckBlockedAddresses(decUtils.EvmParams, fromAddr, txData.GetTo()); err != nil {
+			return ctx, err
+		}
+
+		// in CheckTx, refund the fees of the pending tx replaced by this one, if any
+		if err := RefundReplacedTxFee(
+			ctx,
+			md.bankKeeper,
+			md.evmKeeper,
+			fromAddr,
+			txData.GetNonce(),
+			decUtils.EvmDenom,
+		); err != nil {
+			return ctx, err
+		}
+
+		// 6. account balance verification
 		// TODO: Use account from AccountKeeper instead
 		account := md.evmKeeper.GetAccount(ctx, fromAddr)
 		if err := VerifyAccountBalance(
@@ -189,6 +230,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			account,
 			fromAddr,
 			txData,
+			feePayer,
 		); err != nil {
 			return ctx, err
 		}
@@ -247,17 +289,26 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			return ctx, err
 		}
 
-		err = ConsumeFeesAndEmitEvent(
-			ctx,
-			&ConsumeGasKeepers{
-				Bank:         md.bankKeeper,
-				Distribution: md.distributionKeeper,
-				Evm:          md.evmKeeper,
-				Staking:      md.stakingKeeper,
-			},
-			msgFees,
-			from,
-		)
+		deductFeesFrom := from
+		if feePayer.Empty() {
+			err = ConsumeFeesAndEmitEvent(
+				ctx,
+				&ConsumeGasKeepers{
+					Bank:         md.bankKeeper,
+					Distribution: md.distributionKeeper,
+					Evm:          md.evmKeeper,
+					Staking:      md.stakingKeeper,
+				},
+				msgFees,
+				from,
+			)
+		} else {
+			if err := UseFeeGrant(ctx, md.feegrantKeeper, feePayer, from, msgFees, msg); err != nil {
+				return ctx, err
+			}
+			deductFeesFrom = feePayer
+			err = ConsumeGrantedFeesAndEmitEvent(ctx, md.evmKeeper, msgFees, feePayer)
+		}
 		if err != nil {
 			return ctx, err
 		}
@@ -285,11 +336,23 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		decUtils.TxFee = txFee
 		decUtils.TxGasLimit += gas
 
-		// 10. increment sequence
-		if err := IncrementNonce(ctx, md.accountKeeper, acc, txData.GetNonce()); err != nil {
+		// 10. increment sequence or replace a pending tx
+		if err := IncrementNonceOrReplace(
+			ctx,
+			md.accountKeeper,
+			md.evmKeeper,
+			acc,
+			txData,
+			decUtils.BaseFee,
+			md.priceBump,
+		); err != nil {
 			return ctx, err
 		}
 
+		if ctx.IsCheckTx() {
+			md.evmKeeper.SetPendingTxFee(ctx, fromAddr, txData.GetNonce(), deductFeesFrom, msgFees.AmountOf(decUtils.EvmDenom).BigInt())
+		}
+
 		// 11. gas wanted
 		if err := CheckGasWanted(ctx, md.feeMarketKeeper, tx, decUtils.Rules.IsLondon); err != nil {
 			return ctx, err
@@ -162,6 +162,41 @@ func (suite *AnteTestSuite) CreateTestTxBuilder(
 	return txBuilder
 }
 
+// CreateTestMultiEthTx is a helper function to create a tx that bundles the
+// given Ethereum messages, signed with the given key.
+func (suite *AnteTestSuite) CreateTestMultiEthTx(priv cryptotypes.PrivKey, msgs ...*evmtypes.MsgEthereumTx) sdk.Tx {
+	option, err := codectypes.NewAnyWithValue(&evmtypes.ExtensionOptionsEthereumTx{})
+	suite.Require().NoError(err)
+
+	txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+	builder, ok := txBuilder.(authtx.ExtensionOptionsTxBuilder)
+	suite.Require().True(ok)
+	builder.SetExtensionOptions(option)
+
+	fees := sdk.NewCoins()
+	gasLimit := uint64(0)
+	sdkMsgs := make([]sdk.Msg, len(msgs))
+	for i, msg := range msgs {
+		err = msg.Sign(suite.ethSigner, utiltx.NewSigner(priv))
+		suite.Require().NoError(err)
+		msg.From = ""
+
+		txData, err := evmtypes.UnpackTxData(msg.Data)
+		suite.Require().NoError(err)
+
+		fees = fees.Add(sdk.NewCoin(evmtypes.DefaultEVMDenom, math.NewIntFromBigInt(txData.Fee())))
+		gasLimit += msg.GetGas()
+		sdkMsgs[i] = msg
+	}
+
+	err = builder.SetMsgs(sdkMsgs...)
+	suite.Require().NoError(err)
+	builder.SetFeeAmount(fees)
+	builder.SetGasLimit(gasLimit)
+
+	return builder.GetTx()
+}
+
 func (suite *AnteTestSuite) RequireErrorForLegacyTypedData(err error) {
 	if suite.useLegacyEIP712TypedData {
 		suite.Require().Error(err)
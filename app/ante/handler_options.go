@@ -35,6 +35,7 @@ type HandlerOptions struct {
 	SignModeHandler        authsigning.SignModeHandler
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params authtypes.Params) error
 	MaxTxGasWanted         uint64
+	PriceBump              uint64
 	TxFeeChecker           anteutils.TxFeeChecker
 }
 
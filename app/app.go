@@ -30,6 +30,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -123,6 +124,7 @@ import (
 
 	"github.com/evmos/evmos/v16/app/ante"
 	ethante "github.com/evmos/evmos/v16/app/ante/evm"
+	evmosmempool "github.com/evmos/evmos/v16/app/mempool"
 	"github.com/evmos/evmos/v16/app/post"
 	v17 "github.com/evmos/evmos/v16/app/upgrades/v17"
 	"github.com/evmos/evmos/v16/encoding"
@@ -424,13 +426,19 @@ func NewEvmos(
 		keys[feemarkettypes.StoreKey],
 		tkeys[feemarkettypes.TransientKey],
 		app.GetSubspace(feemarkettypes.ModuleName),
-	)
+	).WithQueryContextFn(bApp.CreateQueryContext)
 
 	evmKeeper := evmkeeper.NewKeeper(
 		appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey], authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper, stakingKeeper, app.FeeMarketKeeper,
 		tracer, app.GetSubspace(evmtypes.ModuleName),
-	)
+	).
+		WithRevertLogLevel(
+			cast.ToString(appOpts.Get(srvflags.EVMRevertLogLevel)),
+			cast.ToString(appOpts.Get(flags.FlagLogLevel)),
+		).
+		WithReplayTxEnabled(cast.ToBool(appOpts.Get(srvflags.EVMEnableReplayTx))).
+		WithFeegrantKeeper(app.FeeGrantKeeper)
 
 	app.EvmKeeper = evmKeeper
 
@@ -495,6 +503,16 @@ func NewEvmos(
 		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
 		app.Erc20Keeper, // Add ERC20 Keeper for ERC20 transfers
 	)
+
+	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
+	app.EpochsKeeper = *epochsKeeper.SetHooks(
+		epochskeeper.NewMultiEpochHooks(
+			// insert epoch hooks receivers here
+			app.InflationKeeper.Hooks(),
+			app.EvmKeeper.EpochHooks(),
+		),
+	)
+
 	chainID := bApp.ChainID()
 	// We call this after setting the hooks to ensure that the hooks are set on the keeper
 	evmKeeper.WithPrecompiles(
@@ -502,20 +520,15 @@ func NewEvmos(
 			chainID,
 			*stakingKeeper,
 			app.DistrKeeper,
+			app.AccountKeeper,
 			app.BankKeeper,
 			app.Erc20Keeper,
 			app.VestingKeeper,
 			app.AuthzKeeper,
 			app.TransferKeeper,
 			app.IBCKeeper.ChannelKeeper,
-		),
-	)
-
-	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
-	app.EpochsKeeper = *epochsKeeper.SetHooks(
-		epochskeeper.NewMultiEpochHooks(
-			// insert epoch hooks receivers here
-			app.InflationKeeper.Hooks(),
+			app.EpochsKeeper,
+			app.InflationKeeper,
 		),
 	)
 
@@ -772,10 +785,19 @@ func NewEvmos(
 	app.SetBeginBlocker(app.BeginBlocker)
 
 	maxGasWanted := cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted))
+	priceBump := cast.ToUint64(appOpts.Get(srvflags.EVMPriceBump))
 
-	app.setAnteHandler(encodingConfig.TxConfig, maxGasWanted)
+	app.setAnteHandler(encodingConfig.TxConfig, maxGasWanted, priceBump)
 	app.setPostHandler()
 	app.SetEndBlocker(app.EndBlocker)
+
+	// remove the replaced eth txs from the proposals and optionally order the proposed txs
+	// by their effective tip instead of the FIFO order of the mempool
+	app.SetPrepareProposal(evmosmempool.NewPrepareProposalHandler(
+		encodingConfig.TxConfig.TxDecoder(),
+		app.EvmKeeper,
+		cast.ToBool(appOpts.Get(srvflags.EVMPrioritizeByTip)),
+	))
 	app.setupUpgradeHandlers()
 
 	if loadLatest {
@@ -819,7 +841,7 @@ func NewEvmos(
 // Name returns the name of the App
 func (app *Evmos) Name() string { return app.BaseApp.Name() }
 
-func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
+func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted, priceBump uint64) {
 	options := ante.HandlerOptions{
 		Cdc:                    app.appCodec,
 		AccountKeeper:          app.AccountKeeper,
@@ -834,6 +856,7 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 		SignModeHandler:        txConfig.SignModeHandler(),
 		SigGasConsumer:         ante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         maxGasWanted,
+		PriceBump:              priceBump,
 		TxFeeChecker:           ethante.NewDynamicFeeChecker(app.EvmKeeper),
 	}
 
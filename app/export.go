@@ -6,6 +6,7 @@ package app
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
@@ -16,6 +17,7 @@ import (
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/evmos/evmos/v16/encoding"
 )
@@ -218,3 +220,13 @@ func (app *Evmos) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []s
 	)
 	return nil
 }
+
+// ExportGethGenesis writes the EVM state of the application at its latest
+// height to the given writer as a geth genesis file. If addresses are given,
+// only these accounts are exported.
+func (app *Evmos) ExportGethGenesis(w io.Writer, addresses []common.Address) error {
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight(), ChainID: app.ChainID()})
+	app.EvmKeeper.WithChainID(ctx)
+
+	return app.EvmKeeper.ExportGethGenesis(ctx, w, addresses)
+}
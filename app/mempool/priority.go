@@ -0,0 +1,86 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package mempool
+
+import (
+	"math"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmante "github.com/evmos/evmos/v16/app/ante/evm"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// TxPriority returns the priority of the given tx, using the same logic as the
+// ante handler:
+//
+//   - ethereum txs are prioritized by the lowest effective tip of their
+//     messages, as Ethereum nodes do.
+//   - cosmos txs are prioritized by their effective tip through the dynamic fee
+//     checker, so that they are comparable to the ethereum txs.
+//
+// Txs whose priority cannot be computed get the lowest priority.
+func TxPriority(ctx sdk.Context, k evmante.DynamicFeeEVMKeeper, tx sdk.Tx) int64 {
+	params := k.GetParams(ctx)
+	ethCfg := params.ChainConfig.EthereumConfig(k.ChainID())
+
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return math.MinInt64
+	}
+
+	if _, ok := msgs[0].(*evmtypes.MsgEthereumTx); ok {
+		baseFee := k.GetBaseFee(ctx, ethCfg)
+
+		priority := int64(math.MaxInt64)
+		for _, msg := range msgs {
+			_, txData, _, err := evmtypes.UnpackEthMsg(msg)
+			if err != nil {
+				return math.MinInt64
+			}
+
+			if p := evmtypes.GetTxPriority(txData, baseFee); p < priority {
+				priority = p
+			}
+		}
+		return priority
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 {
+		return math.MinInt64
+	}
+
+	_, priority, err := evmante.FeeChecker(ctx, k, params.EvmDenom, ethCfg, feeTx)
+	if err != nil {
+		return math.MinInt64
+	}
+	return priority
+}
+
+// txSender returns the address of the sender of the given tx, which is used to
+// keep the txs of a same sender in their original (nonce) order. The sender of
+// ethereum txs is recovered from their signature, as the From field is not part
+// of the encoded tx. Both ethereum and cosmos txs return the same account
+// address bytes, so that the txs of an account are kept in order regardless of
+// their type.
+func txSender(tx sdk.Tx, chainID *big.Int) (sdk.AccAddress, bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, false
+	}
+
+	if ethMsg, ok := msgs[0].(*evmtypes.MsgEthereumTx); ok {
+		from, err := ethMsg.GetSender(chainID)
+		if err != nil {
+			return nil, false
+		}
+		return sdk.AccAddress(from.Bytes()), true
+	}
+
+	signers := msgs[0].GetSigners()
+	if len(signers) == 0 {
+		return nil, false
+	}
+	return signers[0], true
+}
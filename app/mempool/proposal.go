@@ -0,0 +1,136 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package mempool
+
+import (
+	"container/heap"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmante "github.com/evmos/evmos/v16/app/ante/evm"
+)
+
+// NewPrepareProposalHandler returns a PrepareProposal handler that removes the
+// replaced ethereum txs from the txs proposed by CometBFT. If prioritizeByTip is
+// set, the txs are also ordered by their TxPriority, instead of the FIFO order
+// of the CometBFT mempool. The txs of a same sender keep their original order,
+// so that their nonces remain sequential.
+func NewPrepareProposalHandler(txDecoder sdk.TxDecoder, k evmante.DynamicFeeEVMKeeper, prioritizeByTip bool) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+		var maxBlockGas uint64
+		if b := ctx.ConsensusParams().Block; b != nil {
+			maxBlockGas = uint64(b.MaxGas) //#nosec G701 -- block max gas is validated by CometBFT
+		}
+
+		selector := baseapp.NewDefaultTxSelector()
+		defer selector.Clear()
+
+		txs := RemoveReplacedTxs(ctx, txDecoder, k, req.Txs)
+		if prioritizeByTip {
+			txs = SortTxsByPriority(ctx, txDecoder, k, txs)
+		}
+
+		for _, txBz := range txs {
+			// NOTE: nil is passed as the tx in order to not skip txs based on their gas,
+			// which would break the nonce order of their senders. The txs requested from
+			// CometBFT already fit the block max gas.
+			if stop := selector.SelectTxForProposal(uint64(req.MaxTxBytes), maxBlockGas, nil, txBz); stop {
+				break
+			}
+		}
+
+		return abci.ResponsePrepareProposal{Txs: selector.SelectedTxs()}
+	}
+}
+
+// SortTxsByPriority returns the given txs sorted by descending TxPriority,
+// while keeping the relative order of the txs of a same sender. Txs with the
+// same priority keep their original order. Txs that cannot be decoded are
+// returned last.
+func SortTxsByPriority(ctx sdk.Context, txDecoder sdk.TxDecoder, k evmante.DynamicFeeEVMKeeper, txs [][]byte) [][]byte {
+	queues := make(map[string][]prioritizedTx)
+	var senders []string
+	var undecodable [][]byte
+
+	for i, txBz := range txs {
+		tx, err := txDecoder(txBz)
+		if err != nil {
+			undecodable = append(undecodable, txBz)
+			continue
+		}
+
+		// the queues are keyed by the sender address bytes
+		var sender string
+		if addr, ok := txSender(tx, k.ChainID()); ok {
+			sender = string(addr)
+		} else {
+			// keep the tx in its own queue
+			sender = "#" + strconv.Itoa(i)
+		}
+
+		if _, found := queues[sender]; !found {
+			senders = append(senders, sender)
+		}
+		queues[sender] = append(queues[sender], prioritizedTx{
+			bz:       txBz,
+			priority: TxPriority(ctx, k, tx),
+			index:    i,
+		})
+	}
+
+	// merge the sender queues by the priority of their first tx
+	heads := make(txHeap, 0, len(senders))
+	for _, sender := range senders {
+		heads = append(heads, queues[sender])
+	}
+	heap.Init(&heads)
+
+	sorted := make([][]byte, 0, len(txs))
+	for heads.Len() > 0 {
+		queue := heads[0]
+		sorted = append(sorted, queue[0].bz)
+		if len(queue) > 1 {
+			heads[0] = queue[1:]
+			heap.Fix(&heads, 0)
+		} else {
+			heap.Pop(&heads)
+		}
+	}
+
+	return append(sorted, undecodable...)
+}
+
+// prioritizedTx is an encoded tx with its priority and its index in the
+// original list of txs.
+type prioritizedTx struct {
+	bz       []byte
+	priority int64
+	index    int
+}
+
+// txHeap is a max-heap of the tx queues of each sender, ordered by the
+// priority of their first tx and then by its original index.
+type txHeap [][]prioritizedTx
+
+func (h txHeap) Len() int { return len(h) }
+
+func (h txHeap) Less(i, j int) bool {
+	if h[i][0].priority != h[j][0].priority {
+		return h[i][0].priority > h[j][0].priority
+	}
+	return h[i][0].index < h[j][0].index
+}
+
+func (h txHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *txHeap) Push(x interface{}) { *h = append(*h, x.([]prioritizedTx)) }
+
+func (h *txHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	queue := old[n-1]
+	*h = old[:n-1]
+	return queue
+}
@@ -0,0 +1,212 @@
+package mempool_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v16/app/mempool"
+	commonfactory "github.com/evmos/evmos/v16/testutil/integration/common/factory"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/factory"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrepareProposalHandler(t *testing.T) {
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+	txConfig := unitNetwork.App.GetTxConfig()
+
+	recipient := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	txArgs := func(tipGwei int64) evmtypes.EvmTxArgs {
+		return evmtypes.EvmTxArgs{
+			To:        &recipient,
+			GasLimit:  21000,
+			GasTipCap: new(big.Int).Mul(big.NewInt(tipGwei), big.NewInt(1e9)),
+			GasFeeCap: new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e9)),
+		}
+	}
+
+	// generateTxs generates the given txs from the given key and returns them encoded
+	generateTxs := func(index int, args ...evmtypes.EvmTxArgs) [][]byte {
+		txs, err := txFactory.GenerateSignedEthTxBatch(keyring.GetPrivKey(index), args)
+		require.NoError(t, err, "failed to generate txs")
+
+		txsBz := make([][]byte, 0, len(txs))
+		for _, tx := range txs {
+			bz, err := txConfig.TxEncoder()(tx)
+			require.NoError(t, err, "failed to encode tx")
+			txsBz = append(txsBz, bz)
+		}
+		return txsBz
+	}
+
+	// the first sender sends a low tip tx followed by a high tip tx
+	txsA := generateTxs(0, txArgs(1), txArgs(5))
+	// the second sender sends two medium tip txs
+	txsB := generateTxs(1, txArgs(3), txArgs(2))
+	invalidTx := []byte("invalid tx")
+	// the first sender replaces its first tx with a higher tip one
+	replacementA := generateTxs(0, txArgs(4))
+
+	// the first sender also sends a cosmos tx with a high gas price
+	gasPrice := sdkmath.NewInt(100e9)
+	cosmosTx, err := txFactory.BuildCosmosTx(keyring.GetPrivKey(0), commonfactory.CosmosTxArgs{
+		Gas:      200_000,
+		GasPrice: &gasPrice,
+		Msgs: []sdk.Msg{banktypes.NewMsgSend(
+			keyring.GetAccAddr(0),
+			keyring.GetAccAddr(1),
+			sdk.NewCoins(sdk.NewInt64Coin(unitNetwork.GetDenom(), 1)),
+		)},
+	})
+	require.NoError(t, err, "failed to build cosmos tx")
+	cosmosTxA, err := txConfig.TxEncoder()(cosmosTx)
+	require.NoError(t, err, "failed to encode cosmos tx")
+
+	testCases := []struct {
+		name   string
+		txs    [][]byte
+		expTxs [][]byte
+	}{
+		{
+			name:   "higher tip first",
+			txs:    [][]byte{txsA[0], txsB[0]},
+			expTxs: [][]byte{txsB[0], txsA[0]},
+		},
+		{
+			name:   "txs of a same sender keep their nonce order",
+			txs:    [][]byte{txsA[0], txsA[1], txsB[0], txsB[1]},
+			expTxs: [][]byte{txsB[0], txsB[1], txsA[0], txsA[1]},
+		},
+		{
+			name:   "high tip tx is included after its lower tip predecessor",
+			txs:    [][]byte{txsB[0], txsB[1], txsA[0], txsA[1]},
+			expTxs: [][]byte{txsB[0], txsB[1], txsA[0], txsA[1]},
+		},
+		{
+			name:   "cosmos tx is included after the eth tx of the same account",
+			txs:    [][]byte{txsA[0], txsB[0], cosmosTxA},
+			expTxs: [][]byte{txsB[0], txsA[0], cosmosTxA},
+		},
+		{
+			name:   "replaced tx is removed",
+			txs:    [][]byte{txsA[0], txsB[0], replacementA[0]},
+			expTxs: [][]byte{replacementA[0], txsB[0]},
+		},
+		{
+			name:   "undecodable txs are included last",
+			txs:    [][]byte{invalidTx, txsA[0], txsB[0]},
+			expTxs: [][]byte{txsB[0], txsA[0], invalidTx},
+		},
+	}
+
+	handler := mempool.NewPrepareProposalHandler(txConfig.TxDecoder(), unitNetwork.App.EvmKeeper, true)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := handler(unitNetwork.GetContext(), abci.RequestPrepareProposal{
+				Txs:        tc.txs,
+				MaxTxBytes: 1_000_000,
+			})
+			require.Equal(t, tc.expTxs, res.Txs)
+		})
+	}
+
+	t.Run("txs exceeding the max tx bytes are not included", func(t *testing.T) {
+		res := handler(unitNetwork.GetContext(), abci.RequestPrepareProposal{
+			Txs:        [][]byte{txsA[0], txsB[0]},
+			MaxTxBytes: int64(len(txsB[0])),
+		})
+		require.Equal(t, [][]byte{txsB[0]}, res.Txs)
+	})
+}
+
+func TestPrepareProposalHandlerReplacedTxs(t *testing.T) {
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+	txConfig := unitNetwork.App.GetTxConfig()
+
+	recipient := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	// generateTx generates a tx with the current nonce of the given key and returns it encoded
+	generateTx := func(index int, gasPriceGwei int64) []byte {
+		txs, err := txFactory.GenerateSignedEthTxBatch(keyring.GetPrivKey(index), []evmtypes.EvmTxArgs{{
+			To:       &recipient,
+			GasLimit: 21000,
+			GasPrice: new(big.Int).Mul(big.NewInt(gasPriceGwei), big.NewInt(1e9)),
+		}})
+		require.NoError(t, err, "failed to generate tx")
+
+		bz, err := txConfig.TxEncoder()(txs[0])
+		require.NoError(t, err, "failed to encode tx")
+		return bz
+	}
+
+	// the txs of the first sender share the same nonce
+	original := generateTx(0, 100)
+	replacement := generateTx(0, 200)
+	other := generateTx(1, 100)
+
+	// next is the tx of the first sender with the following nonce
+	batch, err := txFactory.GenerateSignedEthTxBatch(keyring.GetPrivKey(0), []evmtypes.EvmTxArgs{
+		{To: &recipient, GasLimit: 21000, GasPrice: big.NewInt(100e9)},
+		{To: &recipient, GasLimit: 21000, GasPrice: big.NewInt(100e9)},
+	})
+	require.NoError(t, err, "failed to generate txs")
+	next, err := txConfig.TxEncoder()(batch[1])
+	require.NoError(t, err, "failed to encode tx")
+
+	testCases := []struct {
+		name   string
+		txs    [][]byte
+		expTxs [][]byte
+	}{
+		{
+			name:   "replacement takes the position of the replaced tx",
+			txs:    [][]byte{original, other, replacement},
+			expTxs: [][]byte{replacement, other},
+		},
+		{
+			name:   "replacement stays before the next nonce of the sender",
+			txs:    [][]byte{original, next, replacement},
+			expTxs: [][]byte{replacement, next},
+		},
+		{
+			name:   "lower priced tx with a pending nonce is removed",
+			txs:    [][]byte{replacement, original, other},
+			expTxs: [][]byte{replacement, other},
+		},
+		{
+			name:   "last tx is kept among txs with the same gas price",
+			txs:    [][]byte{original, original},
+			expTxs: [][]byte{original},
+		},
+	}
+
+	handler := mempool.NewPrepareProposalHandler(txConfig.TxDecoder(), unitNetwork.App.EvmKeeper, false)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := handler(unitNetwork.GetContext(), abci.RequestPrepareProposal{
+				Txs:        tc.txs,
+				MaxTxBytes: 1_000_000,
+			})
+			require.Equal(t, tc.expTxs, res.Txs)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package mempool
+
+import (
+	"math/big"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmante "github.com/evmos/evmos/v16/app/ante/evm"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// senderNonce identifies an ethereum tx by its sender and nonce.
+type senderNonce struct {
+	sender common.Address
+	nonce  uint64
+}
+
+// RemoveReplacedTxs returns the given txs without the ethereum txs that were
+// replaced by a tx with the same sender and nonce and a higher effective gas
+// price, as accepted by the ante handler in CheckTx. The replaced txs stay in
+// the CometBFT mempool until they fail the recheck once their nonce has been
+// committed, so they are removed from the proposals instead, where they would
+// fail and waste block space. Among the txs with the same effective gas price,
+// the last one is kept. The kept tx takes the position of the first tx with its
+// sender and nonce, so that it stays before the txs of the sender with higher
+// nonces.
+func RemoveReplacedTxs(ctx sdk.Context, txDecoder sdk.TxDecoder, k evmante.DynamicFeeEVMKeeper, txs [][]byte) [][]byte {
+	ethCfg := k.GetParams(ctx).ChainConfig.EthereumConfig(k.ChainID())
+	baseFee := k.GetBaseFee(ctx, ethCfg)
+
+	// the index and gas price of the best tx of each sender and nonce
+	type bestTx struct {
+		index    int
+		gasPrice *big.Int
+	}
+	best := make(map[senderNonce]bestTx)
+	// the index of the first tx of each sender and nonce
+	first := make(map[senderNonce]int)
+	txKeys := make([][]senderNonce, len(txs))
+
+	for i, txBz := range txs {
+		tx, err := txDecoder(txBz)
+		if err != nil {
+			continue
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				break
+			}
+
+			txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+			if err != nil {
+				break
+			}
+			sender, err := ethMsg.GetSender(k.ChainID())
+			if err != nil {
+				break
+			}
+
+			gasPrice := txData.GetGasPrice()
+			if baseFee != nil {
+				gasPrice = txData.EffectiveGasPrice(baseFee)
+			}
+
+			key := senderNonce{sender: sender, nonce: txData.GetNonce()}
+			txKeys[i] = append(txKeys[i], key)
+			if _, found := first[key]; !found {
+				first[key] = i
+			}
+			if prev, found := best[key]; !found || gasPrice.Cmp(prev.gasPrice) >= 0 {
+				best[key] = bestTx{index: i, gasPrice: gasPrice}
+			}
+		}
+	}
+
+	// the position of each kept tx in the proposal
+	type keptTx struct {
+		bz   []byte
+		slot int
+	}
+	kept := make([]keptTx, 0, len(txs))
+	for i, txBz := range txs {
+		replaced := false
+		slot := i
+		for _, key := range txKeys[i] {
+			if best[key].index != i {
+				replaced = true
+				break
+			}
+			if first[key] < slot {
+				slot = first[key]
+			}
+		}
+
+		if !replaced {
+			kept = append(kept, keptTx{bz: txBz, slot: slot})
+		}
+	}
+
+	sort.SliceStable(kept, func(i, j int) bool {
+		return kept[i].slot < kept[j].slot
+	})
+
+	filtered := make([][]byte, len(kept))
+	for i, tx := range kept {
+		filtered[i] = tx.bz
+	}
+
+	return filtered
+}
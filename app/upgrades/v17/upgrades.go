@@ -10,6 +10,18 @@ import (
 )
 
 // CreateUpgradeHandler creates an SDK upgrade handler for v17.0.0
+//
+// The EVM module migration to v7 sets the EnableEIP3529 param to true, so that
+// the gas refunds stay the same as before the upgrade. The FreePrecompileReads
+// param is left at zero, so the precompile gas costs don't change until it is
+// raised by governance.
+//
+// NOTE: the following state machine breaking changes have no store migration
+// and take effect once the chain runs the v17.0.0 binary at the upgrade height:
+//   - failed precompile methods revert with the method context and charge the
+//     gas consumed by the failed method (see common.HandleMethodError).
+//   - the distribution precompile delegationTotalRewards query charges gas for
+//     each validator (see distribution.GasDelegationTotalRewardsPerValidator).
 func CreateUpgradeHandler(
 	mm *module.Manager,
 	configurator module.Configurator,
@@ -40,6 +40,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	evmosclient "github.com/evmos/evmos/v16/client"
 	"github.com/evmos/evmos/v16/client/block"
@@ -53,6 +54,7 @@ import (
 	"github.com/evmos/evmos/v16/app"
 	cmdcfg "github.com/evmos/evmos/v16/cmd/config"
 	evmoskr "github.com/evmos/evmos/v16/crypto/keyring"
+	evmcli "github.com/evmos/evmos/v16/x/evm/client/cli"
 )
 
 const (
@@ -121,6 +123,7 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 		genutilcli.GenTxCmd(app.ModuleBasics, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, app.DefaultNodeHome),
 		genutilcli.ValidateGenesisCmd(app.ModuleBasics),
 		AddGenesisAccountCmd(app.DefaultNodeHome),
+		evmcli.GetGenesisCmd(a.gethGenesisExport, app.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		NewTestnetCmd(app.ModuleBasics, banktypes.GenesisBalancesIterator{}),
 		debug.Cmd(),
@@ -345,6 +348,30 @@ func (a appCreator) appExport(
 	return evmosApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
 }
 
+// gethGenesisExport creates a new evmos app (optionally at a given height)
+// and exports its EVM state as a geth genesis file.
+func (a appCreator) gethGenesisExport(
+	logger log.Logger,
+	db dbm.DB,
+	height int64,
+	appOpts servertypes.AppOptions,
+	w io.Writer,
+	addresses []common.Address,
+) error {
+	evmosApp := app.NewEvmos(
+		logger, db, nil, height == -1, map[int64]bool{}, "", uint(1), a.encCfg, appOpts,
+		baseapp.SetChainID(cast.ToString(appOpts.Get(flags.FlagChainID))),
+	)
+
+	if height != -1 {
+		if err := evmosApp.LoadHeight(height); err != nil {
+			return err
+		}
+	}
+
+	return evmosApp.ExportGethGenesis(w, addresses)
+}
+
 // initTendermintConfig helps to override default Tendermint Config values.
 // return tmcfg.DefaultConfig if no custom configuration is required for the application.
 func initTendermintConfig() *tmcfg.Config {
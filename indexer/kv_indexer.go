@@ -21,8 +21,9 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash           = 1
+	KeyPrefixTxIndex          = 2
+	KeyPrefixBackfillProgress = 3
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
@@ -131,6 +132,36 @@ func (kv *KVIndexer) FirstIndexedBlock() (int64, error) {
 	return LoadFirstBlock(kv.db)
 }
 
+// BackfillProgress returns the last block number backfilled within the given
+// block range, returns -1 if the backfill of the range has not started
+func (kv *KVIndexer) BackfillProgress(start, end int64) (int64, error) {
+	bz, err := kv.db.Get(BackfillProgressKey(start, end))
+	if err != nil {
+		return 0, errorsmod.Wrapf(err, "BackfillProgress %d %d", start, end)
+	}
+	if len(bz) == 0 {
+		return -1, nil
+	}
+	return int64(sdk.BigEndianToUint64(bz)), nil
+}
+
+// SetBackfillProgress stores the last block number backfilled within the given
+// block range, so that an interrupted backfill can be resumed
+func (kv *KVIndexer) SetBackfillProgress(start, end, height int64) error {
+	if err := kv.db.Set(BackfillProgressKey(start, end), sdk.Uint64ToBigEndian(uint64(height))); err != nil {
+		return errorsmod.Wrapf(err, "SetBackfillProgress %d %d", start, end)
+	}
+	return nil
+}
+
+// DeleteBackfillProgress removes the progress of a completed backfill
+func (kv *KVIndexer) DeleteBackfillProgress(start, end int64) error {
+	if err := kv.db.Delete(BackfillProgressKey(start, end)); err != nil {
+		return errorsmod.Wrapf(err, "DeleteBackfillProgress %d %d", start, end)
+	}
+	return nil
+}
+
 // GetByTxHash finds eth tx by eth tx hash
 func (kv *KVIndexer) GetByTxHash(hash common.Hash) (*evmostypes.TxResult, error) {
 	bz, err := kv.db.Get(TxHashKey(hash))
@@ -171,6 +202,13 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// BackfillProgressKey returns the key for db entry: `(start, end) -> last backfilled block number`
+func BackfillProgressKey(start, end int64) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(start))
+	bz2 := sdk.Uint64ToBigEndian(uint64(end))
+	return append(append([]byte{KeyPrefixBackfillProgress}, bz1...), bz2...)
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
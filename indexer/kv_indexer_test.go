@@ -188,6 +188,34 @@ func TestKVIndexer(t *testing.T) {
 	}
 }
 
+func TestKVIndexerBackfillProgress(t *testing.T) {
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), client.Context{})
+
+	progress, err := idxer.BackfillProgress(1, 100)
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), progress, "expected no progress before the backfill starts")
+
+	require.NoError(t, idxer.SetBackfillProgress(1, 100, 42))
+	progress, err = idxer.BackfillProgress(1, 100)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), progress)
+
+	progress, err = idxer.BackfillProgress(1, 200)
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), progress, "expected the progress to be tracked per block range")
+
+	// the progress must not be mistaken for indexed blocks
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), last)
+
+	require.NoError(t, idxer.DeleteBackfillProgress(1, 100))
+	progress, err = idxer.BackfillProgress(1, 100)
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), progress)
+}
+
 // MakeEncodingConfig creates the EncodingConfig
 func MakeEncodingConfig() params.EncodingConfig {
 	return evmenc.MakeConfig(app.ModuleBasics)
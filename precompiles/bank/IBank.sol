@@ -18,9 +18,24 @@ struct Balance {
 /**
  * @author Evmos Team
  * @title Bank Interface
- * @dev Interface for querying balances and supply from the Bank module.
+ * @dev Interface for querying balances and supply from the Bank module
+ * and for delegating the spending of native coins.
  */
 interface IBank {
+  /// @dev Emitted when the allowance of a spender for a native coin is set by a call to approve.
+  /// @param owner the address of the owner of the coins
+  /// @param spender the address of the spender
+  /// @param denom the denomination of the native coin
+  /// @param amount the new allowance of the spender
+  event Approval(address indexed owner, address indexed spender, string denom, uint256 amount);
+
+  /// @dev Emitted when native coins are moved from one account to another by a call to transferFrom.
+  /// @param from the address the coins are sent from
+  /// @param to the address the coins are sent to
+  /// @param denom the denomination of the native coin
+  /// @param amount the amount of coins transferred
+  event Transfer(address indexed from, address indexed to, string denom, uint256 amount);
+
   /// @dev Balances defines a method for retrieving all the native token balances
   /// for a given account.
   /// @param account the address of the account to query balances for
@@ -36,4 +51,40 @@ interface IBank {
   /// @dev supplyOf defines a method for retrieving the total supply of a particular native coin.
   /// @return totalSupply the supply as a uint256
   function supplyOf(address erc20Address) external view returns (uint256 totalSupply);
+
+  /// @dev moduleBalance defines a method for retrieving the balance of a native coin
+  /// held by a module account. Only the fee collector, distribution, bonded and not
+  /// bonded pool module accounts can be queried.
+  /// @param moduleName the name of the module
+  /// @param denom the denomination of the native coin
+  /// @return balance the balance of the module account, zero for unknown denominations
+  function moduleBalance(string calldata moduleName, string calldata denom) external view returns (uint256 balance);
+
+  /// @dev approve defines a method for setting the amount of a native coin that
+  /// the spender is allowed to transfer on behalf of the caller. Setting a zero
+  /// amount removes the allowance. The allowance is stored as an authz send
+  /// authorization, so it is shared with the ERC-20 precompile of the coin and
+  /// expires with the grant.
+  /// @param spender the address of the spender
+  /// @param denom the denomination of the native coin
+  /// @param amount the amount the spender is allowed to transfer
+  /// @return approved true if the approval was successful
+  function approve(address spender, string calldata denom, uint256 amount) external returns (bool approved);
+
+  /// @dev allowance defines a method for retrieving the remaining amount of a native
+  /// coin that the spender is allowed to transfer on behalf of the owner.
+  /// @param owner the address of the owner of the coins
+  /// @param spender the address of the spender
+  /// @param denom the denomination of the native coin
+  /// @return remaining the remaining allowance
+  function allowance(address owner, address spender, string calldata denom) external view returns (uint256 remaining);
+
+  /// @dev transferFrom defines a method for transferring native coins on behalf of
+  /// the owner, decreasing the caller's allowance accordingly.
+  /// @param from the address the coins are sent from
+  /// @param to the address the coins are sent to
+  /// @param denom the denomination of the native coin
+  /// @param amount the amount of coins to transfer
+  /// @return success true if the transfer was successful
+  function transferFrom(address from, address to, string calldata denom, uint256 amount) external returns (bool success);
 }
@@ -8,9 +8,12 @@ import (
 	"fmt"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
+	auth "github.com/evmos/evmos/v16/precompiles/authorization"
 	cmn "github.com/evmos/evmos/v16/precompiles/common"
 	erc20keeper "github.com/evmos/evmos/v16/x/erc20/keeper"
 )
@@ -27,6 +30,19 @@ const (
 
 	// GasSupplyOf defines the gas cost for a single ERC-20 supplyOf query, taken from totalSupply of ERC20
 	GasSupplyOf = 2_477
+
+	// GasApprove defines the gas cost for an approve transaction, taken from approve of ERC20
+	GasApprove = 30_956
+
+	// GasAllowance defines the gas cost for an allowance query, taken from allowance of ERC20
+	GasAllowance = 3_246
+
+	// GasTransferFrom defines the gas cost for a transferFrom transaction, taken from
+	// transferFrom of the OpenZeppelin ERC20 implementation
+	GasTransferFrom = 39_000
+
+	// GasModuleBalance defines the gas cost for a moduleBalance query, taken from balanceOf of ERC20
+	GasModuleBalance = 2_851
 )
 
 var _ vm.PrecompiledContract = &Precompile{}
@@ -39,15 +55,18 @@ var f embed.FS
 // Precompile defines the bank precompile
 type Precompile struct {
 	cmn.Precompile
-	bankKeeper  bankkeeper.Keeper
-	erc20Keeper erc20keeper.Keeper
+	accountKeeper authkeeper.AccountKeeper
+	bankKeeper    bankkeeper.Keeper
+	erc20Keeper   erc20keeper.Keeper
 }
 
 // NewPrecompile creates a new bank Precompile instance as a
 // PrecompiledContract interface.
 func NewPrecompile(
+	accountKeeper authkeeper.AccountKeeper,
 	bankKeeper bankkeeper.Keeper,
 	erc20Keeper erc20keeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
 ) (*Precompile, error) {
 	newABI, err := cmn.LoadABI(f, "abi.json")
 	if err != nil {
@@ -59,11 +78,14 @@ func NewPrecompile(
 	return &Precompile{
 		Precompile: cmn.Precompile{
 			ABI:                  newABI,
+			AuthzKeeper:          authzKeeper,
 			KvGasConfig:          storetypes.GasConfig{},
 			TransientKVGasConfig: storetypes.GasConfig{},
+			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
 		},
-		bankKeeper:  bankKeeper,
-		erc20Keeper: erc20Keeper,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		erc20Keeper:   erc20Keeper,
 	}, nil
 }
 
@@ -83,8 +105,8 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return 0
 	}
 
-	// NOTE: Charge the amount of gas required for a single ERC-20
-	// balanceOf or totalSupply query
+	// NOTE: Charge the amount of gas required for the equivalent ERC-20
+	// method
 	switch method.Name {
 	case BalancesMethod:
 		return GasBalanceOf
@@ -92,36 +114,53 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasTotalSupply
 	case SupplyOfMethod:
 		return GasSupplyOf
+	case auth.ApproveMethod:
+		return GasApprove
+	case auth.AllowanceMethod:
+		return GasAllowance
+	case TransferFromMethod:
+		return GasTransferFrom
+	case ModuleBalanceMethod:
+		return GasModuleBalance
 	}
 
 	return 0
 }
 
-// Run executes the precompiled contract bank query methods defined in the ABI.
+// Run executes the precompiled contract bank methods defined in the ABI.
 func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
-	ctx, _, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
 	if err != nil {
 		return nil, err
 	}
 
-	// This handles any out of gas errors that may occur during the execution of a precompile query.
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
 	switch method.Name {
+	// Bank transactions
+	case auth.ApproveMethod:
+		bz, err = p.Approve(ctx, contract, stateDB, method, args)
+	case TransferFromMethod:
+		bz, err = p.TransferFrom(ctx, contract, stateDB, method, args)
 	// Bank queries
+	case auth.AllowanceMethod:
+		bz, err = p.Allowance(ctx, contract, method, args)
 	case BalancesMethod:
 		bz, err = p.Balances(ctx, contract, method, args)
 	case TotalSupplyMethod:
 		bz, err = p.TotalSupply(ctx, contract, method, args)
 	case SupplyOfMethod:
 		bz, err = p.SupplyOf(ctx, contract, method, args)
+	case ModuleBalanceMethod:
+		bz, err = p.ModuleBalance(ctx, contract, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
 
 	if err != nil {
-		return nil, err
+		return cmn.HandleMethodError(ctx, contract, initialGas, "bank", method.Name, err)
 	}
 
 	cost := ctx.GasMeter().GasConsumed() - initialGas
@@ -134,7 +173,16 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 }
 
 // IsTransaction checks if the given method name corresponds to a transaction or query.
-// It returns false since all bank methods are queries.
-func (Precompile) IsTransaction(_ string) bool {
-	return false
+//
+// Available bank transactions are:
+//   - Approve
+//   - TransferFrom
+func (Precompile) IsTransaction(methodName string) bool {
+	switch methodName {
+	case auth.ApproveMethod,
+		TransferFromMethod:
+		return true
+	default:
+		return false
+	}
 }
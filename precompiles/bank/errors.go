@@ -0,0 +1,19 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package bank
+
+import "errors"
+
+// Errors that have formatted information are defined here as a string.
+const (
+	ErrIntegerOverflow       = "amount %s causes integer overflow"
+	ErrInsufficientAllowance = "insufficient allowance for denom %s: %s < %s"
+	ErrModuleNotAllowed      = "module account balance query not allowed for module %s"
+	ErrModuleNotFound        = "module account %s not found"
+)
+
+var (
+	// ErrSpenderIsOwner is returned when the spender of an approval is the owner of the coins.
+	ErrSpenderIsOwner = errors.New("spender cannot be the owner")
+)
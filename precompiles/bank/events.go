@@ -0,0 +1,77 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package bank
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	auth "github.com/evmos/evmos/v16/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+)
+
+const (
+	// EventTypeTransfer defines the event type for the bank TransferFrom transaction.
+	EventTypeTransfer = "Transfer"
+)
+
+// EmitTransferEvent creates a new Transfer event emitted on transferFrom transactions.
+func (p Precompile) EmitTransferEvent(ctx sdk.Context, stateDB vm.StateDB, from, to common.Address, denom string, amount *big.Int) error {
+	return p.emitCoinEvent(ctx, stateDB, EventTypeTransfer, from, to, denom, amount)
+}
+
+// EmitApprovalEvent creates a new Approval event emitted on approve and transferFrom transactions.
+func (p Precompile) EmitApprovalEvent(ctx sdk.Context, stateDB vm.StateDB, owner, spender common.Address, denom string, amount *big.Int) error {
+	return p.emitCoinEvent(ctx, stateDB, auth.EventTypeApproval, owner, spender, denom, amount)
+}
+
+// emitCoinEvent emits an event of the given type, which has two indexed addresses
+// followed by the coin denomination and amount.
+func (p Precompile) emitCoinEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	eventType string,
+	addr1, addr2 common.Address,
+	denom string,
+	amount *big.Int,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[eventType]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(addr1)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(addr2)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(denom, amount)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/evmos/v16/testutil/integration/evmos/factory"
 	"github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
 	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
@@ -438,4 +439,72 @@ var _ = Describe("Bank Extension -", func() {
 			})
 		})
 	})
+
+	Context("transferFrom from a contract", func() {
+		// forwarderCode is the creation code of a contract that forwards its calldata
+		// to the bank precompile and returns or reverts with the precompile output:
+		//
+		//	calldatacopy(0, 0, calldatasize())
+		//	let success := call(gas(), 0x0804, 0, 0, calldatasize(), 0, 0)
+		//	returndatacopy(0, 0, returndatasize())
+		//	if success { return(0, returndatasize()) }
+		//	revert(0, returndatasize())
+		const forwarderCode = "0x6026600c60003960266000f33660006000376000600036600060006108045af13d600060003e6021573d6000fd5b3d6000f3"
+
+		It("should update the EVM balances and keep the total supply", func() {
+			acc, err := is.grpcHandler.GetEvmAccount(sender.Addr)
+			Expect(err).ToNot(HaveOccurred(), "failed to get account")
+
+			_, err = is.factory.ExecuteEthTx(sender.Priv, evmtypes.EvmTxArgs{
+				Input: common.FromHex(forwarderCode),
+			})
+			Expect(err).ToNot(HaveOccurred(), "failed to deploy forwarder contract")
+			Expect(is.network.NextBlock()).To(BeNil(), "failed to advance block")
+
+			forwarderAddr := crypto.CreateAddress(sender.Addr, acc.GetNonce())
+			recipient := evmosutiltx.GenerateAddress()
+			transferAmount := big.NewInt(4e17)
+
+			err = is.network.App.BankKeeper.SendCoins(
+				is.network.GetContext(),
+				sender.AccAddr,
+				forwarderAddr.Bytes(),
+				sdk.Coins{{Denom: is.bondDenom, Amount: sdk.NewIntFromBigInt(amount)}},
+			)
+			Expect(err).ToNot(HaveOccurred(), "failed to fund forwarder contract")
+
+			supplyBefore := is.network.App.BankKeeper.GetSupply(is.network.GetContext(), is.bondDenom)
+
+			// NOTE: the contract receives some value in the same tx, so its account
+			// is written back to the store when the EVM state is committed.
+			value := big.NewInt(1)
+			input, err := is.precompile.Pack(bank.TransferFromMethod, forwarderAddr, recipient, is.bondDenom, transferAmount)
+			Expect(err).ToNot(HaveOccurred(), "failed to pack transferFrom")
+
+			_, err = is.factory.ExecuteEthTx(sender.Priv, evmtypes.EvmTxArgs{
+				To:     &forwarderAddr,
+				Amount: value,
+				Input:  input,
+			})
+			Expect(err).ToNot(HaveOccurred(), "failed to call forwarder contract")
+			Expect(is.network.NextBlock()).To(BeNil(), "failed to advance block")
+
+			expBalances := map[common.Address]*big.Int{
+				forwarderAddr: new(big.Int).Sub(new(big.Int).Add(amount, value), transferAmount),
+				recipient:     transferAmount,
+			}
+			for addr, expBalance := range expBalances {
+				_, _, evmBalance, err := is.grpcHandler.GetEvmAccountState(addr)
+				Expect(err).ToNot(HaveOccurred(), "failed to get EVM account")
+				Expect(evmBalance).To(Equal(expBalance), "unexpected EVM balance")
+
+				balance, err := is.grpcHandler.GetBalance(addr.Bytes(), is.bondDenom)
+				Expect(err).ToNot(HaveOccurred(), "failed to get balance")
+				Expect(balance.Balance.Amount.BigInt()).To(Equal(expBalance), "unexpected bank balance")
+			}
+
+			supplyAfter := is.network.App.BankKeeper.GetSupply(is.network.GetContext(), is.bondDenom)
+			Expect(supplyAfter).To(Equal(supplyBefore), "expected total supply to be unchanged")
+		})
+	})
 })
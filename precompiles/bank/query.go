@@ -4,11 +4,15 @@
 package bank
 
 import (
+	"fmt"
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
+	erc20precompile "github.com/evmos/evmos/v16/precompiles/erc20"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -21,8 +25,37 @@ const (
 	// SupplyOfMethod defines the ABI method name for the bank SupplyOf
 	// query.
 	SupplyOfMethod = "supplyOf"
+	// ModuleBalanceMethod defines the ABI method name for the bank ModuleBalance
+	// query.
+	ModuleBalanceMethod = "moduleBalance"
 )
 
+// Allowance returns the remaining amount of a native coin that the spender is
+// allowed to transfer on behalf of the owner.
+func (p Precompile) Allowance(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, spender, denom, err := ParseAllowanceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: the allowance is zero if there is no grant or the spender is the owner
+	if owner == spender {
+		return method.Outputs.Pack(common.Big0)
+	}
+
+	_, _, allowance, err := erc20precompile.GetAuthzExpirationAndAllowance(p.AuthzKeeper, ctx, spender, owner, denom)
+	if err != nil {
+		return method.Outputs.Pack(common.Big0)
+	}
+
+	return method.Outputs.Pack(allowance)
+}
+
 // Balances returns all the native token balances (address, amount) for a given
 // account. This method charges the account the corresponding value of an ERC-20
 // balanceOf call for each token returned.
@@ -124,3 +157,31 @@ func (p Precompile) SupplyOf(
 
 	return method.Outputs.Pack(supply.Amount.BigInt())
 }
+
+// ModuleBalance returns the balance of the given denomination held by a module
+// account. Only the module accounts listed in AllowedBalanceModules can be
+// queried, and the balance is zero for unknown denominations.
+func (p Precompile) ModuleBalance(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	moduleName, denom, err := ParseModuleBalanceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(AllowedBalanceModules, moduleName) {
+		return nil, fmt.Errorf(ErrModuleNotAllowed, moduleName)
+	}
+
+	moduleAddr := p.accountKeeper.GetModuleAddress(moduleName)
+	if moduleAddr == nil {
+		return nil, fmt.Errorf(ErrModuleNotFound, moduleName)
+	}
+
+	balance := p.bankKeeper.GetBalance(ctx, moduleAddr, denom)
+
+	return method.Outputs.Pack(balance.Amount.BigInt())
+}
@@ -1,11 +1,15 @@
 package bank_test
 
 import (
+	"fmt"
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/evmos/evmos/v16/precompiles/bank"
 	evmosutiltx "github.com/evmos/evmos/v16/testutil/tx"
+	inflationtypes "github.com/evmos/evmos/v16/x/inflation/v1/types"
 )
 
 func (s *PrecompileTestSuite) TestBalances() {
@@ -257,3 +261,83 @@ func (s *PrecompileTestSuite) TestSupplyOf() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestModuleBalance() {
+	method := s.precompile.Methods[bank.ModuleBalanceMethod]
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+		expBalance  *big.Int
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{authtypes.FeeCollectorName}
+			},
+			false,
+			"invalid number of arguments",
+			nil,
+		},
+		{
+			"fail - module not allowed",
+			func() []interface{} {
+				return []interface{}{govtypes.ModuleName, s.bondDenom}
+			},
+			false,
+			fmt.Sprintf(bank.ErrModuleNotAllowed, govtypes.ModuleName),
+			nil,
+		},
+		{
+			"pass - unknown denom returns zero",
+			func() []interface{} {
+				return []interface{}{authtypes.FeeCollectorName, "unknown"}
+			},
+			true,
+			"",
+			big.NewInt(0),
+		},
+		{
+			"pass - fee collector balance",
+			func() []interface{} {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(s.tokenDenom, 1e18))
+				err := s.network.App.BankKeeper.MintCoins(s.network.GetContext(), inflationtypes.ModuleName, coins)
+				s.Require().NoError(err)
+				err = s.network.App.BankKeeper.SendCoinsFromModuleToModule(s.network.GetContext(), inflationtypes.ModuleName, authtypes.FeeCollectorName, coins)
+				s.Require().NoError(err)
+
+				return []interface{}{authtypes.FeeCollectorName, s.tokenDenom}
+			},
+			true,
+			"",
+			big.NewInt(1e18),
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.ModuleBalance(
+				s.network.GetContext(),
+				nil,
+				&method,
+				tc.malleate(),
+			)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				var balance *big.Int
+				err = s.precompile.UnpackIntoInterface(&balance, method.Name, bz)
+				s.Require().NoError(err)
+				s.Require().Zero(tc.expBalance.Cmp(balance), "expected different balance")
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
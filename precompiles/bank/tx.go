@@ -0,0 +1,234 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package bank
+
+import (
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	auth "github.com/evmos/evmos/v16/precompiles/authorization"
+	erc20precompile "github.com/evmos/evmos/v16/precompiles/erc20"
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+const (
+	// TransferFromMethod defines the ABI method name for the bank TransferFrom
+	// transaction.
+	TransferFromMethod = "transferFrom"
+)
+
+// SendMsgURL defines the authorization type for MsgSend
+var SendMsgURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+// Approve sets the given amount of a native coin as the allowance of the spender
+// address over the caller's coins. A zero amount removes the allowance for the
+// given denomination. The allowances are stored as a send authorization grant
+// instead of a separate allowance store, so that they are shared with the ERC-20
+// extensions of the native coins, are decreased when the send is dispatched
+// through the authz keeper, and can be queried and revoked through x/authz.
+// It returns a boolean value indicating whether the operation succeeded and emits
+// the Approval event on success.
+func (p Precompile) Approve(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	spender, denom, amount, err := ParseApproveArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := contract.CallerAddress
+
+	// NOTE: approvals where the spender is the owner are not supported,
+	// since the owner can transfer the coins without authorization.
+	if owner == spender {
+		return nil, ErrSpenderIsOwner
+	}
+
+	if amount.BitLen() > sdkmath.MaxBitLen {
+		return nil, fmt.Errorf(ErrIntegerOverflow, amount)
+	}
+
+	if err := p.setAllowance(ctx, spender, owner, denom, amount); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, owner, spender, denom, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// TransferFrom transfers native coins from the given owner address to the
+// destination address on behalf of the caller. If the caller is not the owner,
+// the allowance of the caller is decreased by the transferred amount, and the
+// transaction reverts if the allowance is insufficient.
+// It returns a boolean value indicating whether the operation succeeded and emits
+// the Transfer event on success, as well as the Approval event with the remaining
+// allowance if the caller is not the owner.
+func (p Precompile) TransferFrom(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	from, to, denom, amount, err := ParseTransferFromArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if amount.BitLen() > sdkmath.MaxBitLen {
+		return nil, fmt.Errorf(ErrIntegerOverflow, amount)
+	}
+
+	coins := sdk.Coins{{Denom: denom, Amount: sdkmath.NewIntFromBigInt(amount)}}
+	msg := banktypes.NewMsgSend(from.Bytes(), to.Bytes(), coins)
+	if err = msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	spender := contract.CallerAddress
+	ownerIsSpender := spender == from
+
+	// NOTE: load the accounts into the stateDB before the send, so that their
+	// cached balances can be updated afterwards. Accounts that are not cached
+	// yet read the updated balances from the bank keeper when first accessed.
+	isEVMDenom := denom == evmDenom(stateDB)
+	var fromCached, toCached bool
+	if isEVMDenom {
+		fromCached = stateDB.Exist(from)
+		toCached = stateDB.Exist(to)
+	}
+
+	var prevAllowance *big.Int
+	if ownerIsSpender {
+		msgSrv := bankkeeper.NewMsgServerImpl(p.bankKeeper)
+		_, err = msgSrv.Send(sdk.WrapSDKContext(ctx), msg)
+	} else {
+		_, _, prevAllowance, err = erc20precompile.GetAuthzExpirationAndAllowance(p.AuthzKeeper, ctx, spender, from, denom)
+		if err != nil {
+			return nil, errorsmod.Wrap(authz.ErrNoAuthorizationFound, err.Error())
+		}
+
+		if prevAllowance.Cmp(amount) < 0 {
+			return nil, fmt.Errorf(ErrInsufficientAllowance, denom, prevAllowance, amount)
+		}
+
+		// NOTE: dispatching the send message through the authz keeper decreases
+		// the spend limit of the grant, or deletes it when it is fully used.
+		_, err = p.AuthzKeeper.DispatchActions(ctx, spender.Bytes(), []sdk.Msg{msg})
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+	// This prevents the stateDB from overwriting the changed balances in the bank keeper when committing the EVM state.
+	if fromCached {
+		stateDB.SubBalance(from, amount)
+	}
+	if toCached {
+		stateDB.AddBalance(to, amount)
+	}
+
+	if err = p.EmitTransferEvent(ctx, stateDB, from, to, denom, amount); err != nil {
+		return nil, err
+	}
+
+	if !ownerIsSpender {
+		newAllowance := new(big.Int).Sub(prevAllowance, amount)
+		if err = p.EmitApprovalEvent(ctx, stateDB, from, spender, denom, newAllowance); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// setAllowance sets the spend limit of the given denomination on the send
+// authorization from the granter to the grantee. The grant is created if it
+// doesn't exist, and deleted if no spend limit is left.
+func (p Precompile) setAllowance(ctx sdk.Context, grantee, granter common.Address, denom string, amount *big.Int) error {
+	authorization, expiration, _ := auth.CheckAuthzExists(ctx, p.AuthzKeeper, grantee, granter, SendMsgURL) //#nosec:G703 -- we are handling the error case (authorization == nil) below
+
+	if authorization == nil {
+		// no-op when removing a non-existent allowance
+		if amount.Sign() == 0 {
+			return nil
+		}
+
+		coins := sdk.Coins{{Denom: denom, Amount: sdkmath.NewIntFromBigInt(amount)}}
+		expiration := ctx.BlockTime().Add(p.ApprovalExpiration)
+
+		// NOTE: we leave the allowed arg empty as all recipients are allowed
+		sendAuthz := banktypes.NewSendAuthorization(coins, []sdk.AccAddress{})
+		if err := sendAuthz.ValidateBasic(); err != nil {
+			return err
+		}
+
+		return p.AuthzKeeper.SaveGrant(ctx, grantee.Bytes(), granter.Bytes(), sendAuthz, &expiration)
+	}
+
+	sendAuthz, ok := authorization.(*banktypes.SendAuthorization)
+	if !ok {
+		return authz.ErrUnknownAuthorizationType
+	}
+
+	// replace the spend limit for the given denomination
+	spendLimit := sendAuthz.SpendLimit
+	if found, coin := spendLimit.Find(denom); found {
+		spendLimit = spendLimit.Sub(coin)
+	}
+	if amount.Sign() > 0 {
+		spendLimit = spendLimit.Add(sdk.Coin{Denom: denom, Amount: sdkmath.NewIntFromBigInt(amount)})
+	}
+
+	if spendLimit.IsZero() {
+		return p.AuthzKeeper.DeleteGrant(ctx, grantee.Bytes(), granter.Bytes(), SendMsgURL)
+	}
+
+	sendAuthz.SpendLimit = spendLimit
+	if err := sendAuthz.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return p.AuthzKeeper.SaveGrant(ctx, grantee.Bytes(), granter.Bytes(), sendAuthz, expiration)
+}
+
+// evmParamsKeeper defines the EVM keeper method used to read the EVM denomination.
+type evmParamsKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+}
+
+// evmDenom returns the denomination of the EVM balances held by the stateDB, or
+// an empty string if the stateDB is not backed by the EVM keeper.
+func evmDenom(stateDB vm.StateDB) string {
+	db, ok := stateDB.(*statedb.StateDB)
+	if !ok {
+		return ""
+	}
+
+	keeper, ok := db.Keeper().(evmParamsKeeper)
+	if !ok {
+		return ""
+	}
+
+	return keeper.GetParams(db.GetContext()).EvmDenom
+}
@@ -0,0 +1,188 @@
+package bank_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v16/precompiles/authorization"
+	"github.com/evmos/evmos/v16/precompiles/bank"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+)
+
+func (s *PrecompileTestSuite) TestApprove() {
+	method := s.precompile.Methods[authorization.ApproveMethod]
+	owner := s.keyring.GetKey(0)
+	spender := s.keyring.GetKey(1)
+
+	testcases := []struct {
+		name         string
+		malleate     func() []interface{}
+		expPass      bool
+		errContains  string
+		expAllowance *big.Int
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{spender.Addr}
+			},
+			false,
+			"invalid number of arguments",
+			nil,
+		},
+		{
+			"fail - spender is the owner",
+			func() []interface{} {
+				return []interface{}{owner.Addr, s.tokenDenom, big.NewInt(1)}
+			},
+			false,
+			bank.ErrSpenderIsOwner.Error(),
+			nil,
+		},
+		{
+			"pass - approve allowance",
+			func() []interface{} {
+				return []interface{}{spender.Addr, s.tokenDenom, big.NewInt(1e18)}
+			},
+			true,
+			"",
+			big.NewInt(1e18),
+		},
+		{
+			"pass - zero amount removes the allowance",
+			func() []interface{} {
+				s.approve(owner.Addr, spender.Addr, big.NewInt(1e18))
+				return []interface{}{spender.Addr, s.tokenDenom, big.NewInt(0)}
+			},
+			true,
+			"",
+			big.NewInt(0),
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			contract := vm.NewContract(vm.AccountRef(owner.Addr), s.precompile, big.NewInt(0), 200_000)
+			bz, err := s.precompile.Approve(
+				s.network.GetContext(),
+				contract,
+				s.network.GetStateDB(),
+				&method,
+				args,
+			)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().NotNil(bz)
+				s.Require().Zero(tc.expAllowance.Cmp(s.allowance(owner.Addr, spender.Addr)), "expected different allowance")
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestTransferFrom() {
+	method := s.precompile.Methods[bank.TransferFromMethod]
+	owner := s.keyring.GetKey(0)
+	spender := s.keyring.GetKey(1)
+	toAddr := utiltx.GenerateAddress()
+
+	testcases := []struct {
+		name         string
+		malleate     func() []interface{}
+		caller       func() *vm.Contract
+		expPass      bool
+		errContains  string
+		expAllowance *big.Int
+	}{
+		{
+			"fail - no allowance",
+			func() []interface{} {
+				s.mintAndSendXMPLCoin(owner.AccAddr, math.NewInt(1e18))
+				return []interface{}{owner.Addr, toAddr, s.tokenDenom, big.NewInt(100)}
+			},
+			func() *vm.Contract {
+				return vm.NewContract(vm.AccountRef(spender.Addr), s.precompile, big.NewInt(0), 200_000)
+			},
+			false,
+			"authorization not found",
+			nil,
+		},
+		{
+			"fail - insufficient allowance",
+			func() []interface{} {
+				s.mintAndSendXMPLCoin(owner.AccAddr, math.NewInt(1e18))
+				s.approve(owner.Addr, spender.Addr, big.NewInt(50))
+				return []interface{}{owner.Addr, toAddr, s.tokenDenom, big.NewInt(100)}
+			},
+			func() *vm.Contract {
+				return vm.NewContract(vm.AccountRef(spender.Addr), s.precompile, big.NewInt(0), 200_000)
+			},
+			false,
+			"insufficient allowance",
+			nil,
+		},
+		{
+			"pass - spender transfers within the allowance",
+			func() []interface{} {
+				s.mintAndSendXMPLCoin(owner.AccAddr, math.NewInt(1e18))
+				s.approve(owner.Addr, spender.Addr, big.NewInt(300))
+				return []interface{}{owner.Addr, toAddr, s.tokenDenom, big.NewInt(100)}
+			},
+			func() *vm.Contract {
+				return vm.NewContract(vm.AccountRef(spender.Addr), s.precompile, big.NewInt(0), 200_000)
+			},
+			true,
+			"",
+			big.NewInt(200),
+		},
+		{
+			"pass - owner transfers without allowance",
+			func() []interface{} {
+				s.mintAndSendXMPLCoin(owner.AccAddr, math.NewInt(1e18))
+				return []interface{}{owner.Addr, toAddr, s.tokenDenom, big.NewInt(100)}
+			},
+			func() *vm.Contract {
+				return vm.NewContract(vm.AccountRef(owner.Addr), s.precompile, big.NewInt(0), 200_000)
+			},
+			true,
+			"",
+			big.NewInt(0),
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			bz, err := s.precompile.TransferFrom(
+				s.network.GetContext(),
+				tc.caller(),
+				s.network.GetStateDB(),
+				&method,
+				args,
+			)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().NotNil(bz)
+
+				balance := s.network.App.BankKeeper.GetBalance(s.network.GetContext(), toAddr.Bytes(), s.tokenDenom)
+				s.Require().Equal(int64(100), balance.Amount.Int64())
+				s.Require().Zero(tc.expAllowance.Cmp(s.allowance(owner.Addr, spender.Addr)), "expected different allowance")
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
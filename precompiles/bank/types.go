@@ -8,10 +8,22 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	cmn "github.com/evmos/evmos/v16/precompiles/common"
 )
 
+// AllowedBalanceModules defines the module accounts whose balances can be queried
+// through the bank ModuleBalance query.
+var AllowedBalanceModules = []string{
+	authtypes.FeeCollectorName,
+	distrtypes.ModuleName,
+	stakingtypes.BondedPoolName,
+	stakingtypes.NotBondedPoolName,
+}
+
 // Balance contains the amount for a corresponding ERC-20 contract address
 type Balance struct {
 	ContractAddress common.Address
@@ -45,3 +57,103 @@ func ParseSupplyOfArgs(args []interface{}) (common.Address, error) {
 
 	return erc20Address, nil
 }
+
+// ParseModuleBalanceArgs parses the call arguments for the bank ModuleBalance query.
+func ParseModuleBalanceArgs(args []interface{}) (moduleName, denom string, err error) {
+	if len(args) != 2 {
+		return "", "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	moduleName, ok := args[0].(string)
+	if !ok {
+		return "", "", fmt.Errorf(cmn.ErrInvalidType, "moduleName", "", args[0])
+	}
+
+	denom, ok = args[1].(string)
+	if !ok {
+		return "", "", fmt.Errorf(cmn.ErrInvalidType, "denom", "", args[1])
+	}
+
+	return moduleName, denom, nil
+}
+
+// ParseApproveArgs parses the call arguments for the bank Approve transaction.
+func ParseApproveArgs(args []interface{}) (spender common.Address, denom string, amount *big.Int, err error) {
+	if len(args) != 3 {
+		return common.Address{}, "", nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	spender, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, "", nil, fmt.Errorf(cmn.ErrInvalidType, "spender", common.Address{}, args[0])
+	}
+
+	denom, ok = args[1].(string)
+	if !ok {
+		return common.Address{}, "", nil, fmt.Errorf(cmn.ErrInvalidType, "denom", "", args[1])
+	}
+
+	amount, ok = args[2].(*big.Int)
+	if !ok || amount == nil {
+		return common.Address{}, "", nil, fmt.Errorf(cmn.ErrInvalidType, "amount", &big.Int{}, args[2])
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return common.Address{}, "", nil, err
+	}
+
+	return spender, denom, amount, nil
+}
+
+// ParseAllowanceArgs parses the call arguments for the bank Allowance query.
+func ParseAllowanceArgs(args []interface{}) (owner, spender common.Address, denom string, err error) {
+	if len(args) != 3 {
+		return common.Address{}, common.Address{}, "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, "", fmt.Errorf(cmn.ErrInvalidType, "owner", common.Address{}, args[0])
+	}
+
+	spender, ok = args[1].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, "", fmt.Errorf(cmn.ErrInvalidType, "spender", common.Address{}, args[1])
+	}
+
+	denom, ok = args[2].(string)
+	if !ok {
+		return common.Address{}, common.Address{}, "", fmt.Errorf(cmn.ErrInvalidType, "denom", "", args[2])
+	}
+
+	return owner, spender, denom, nil
+}
+
+// ParseTransferFromArgs parses the call arguments for the bank TransferFrom transaction.
+func ParseTransferFromArgs(args []interface{}) (from, to common.Address, denom string, amount *big.Int, err error) {
+	if len(args) != 4 {
+		return common.Address{}, common.Address{}, "", nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	from, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, "", nil, fmt.Errorf(cmn.ErrInvalidType, "from", common.Address{}, args[0])
+	}
+
+	to, ok = args[1].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, "", nil, fmt.Errorf(cmn.ErrInvalidType, "to", common.Address{}, args[1])
+	}
+
+	denom, ok = args[2].(string)
+	if !ok {
+		return common.Address{}, common.Address{}, "", nil, fmt.Errorf(cmn.ErrInvalidType, "denom", "", args[2])
+	}
+
+	amount, ok = args[3].(*big.Int)
+	if !ok || amount == nil {
+		return common.Address{}, common.Address{}, "", nil, fmt.Errorf(cmn.ErrInvalidType, "amount", &big.Int{}, args[3])
+	}
+
+	return from, to, denom, amount, nil
+}
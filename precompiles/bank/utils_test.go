@@ -1,11 +1,15 @@
 package bank_test
 
 import (
+	"math/big"
+
 	"cosmossdk.io/math"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v16/precompiles/authorization"
 	"github.com/evmos/evmos/v16/precompiles/bank"
 	"github.com/evmos/evmos/v16/testutil/integration/evmos/factory"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
@@ -19,8 +23,10 @@ import (
 // a given token denomination.
 func (s *PrecompileTestSuite) setupBankPrecompile() *bank.Precompile {
 	precompile, err := bank.NewPrecompile(
+		s.network.App.AccountKeeper,
 		s.network.App.BankKeeper,
 		s.network.App.Erc20Keeper,
+		s.network.App.AuthzKeeper,
 	)
 
 	s.Require().NoError(err, "failed to create bank precompile")
@@ -32,8 +38,10 @@ func (s *PrecompileTestSuite) setupBankPrecompile() *bank.Precompile {
 // a given token denomination.
 func (is *IntegrationTestSuite) setupBankPrecompile() *bank.Precompile {
 	precompile, err := bank.NewPrecompile(
+		is.network.App.AccountKeeper,
 		is.network.App.BankKeeper,
 		is.network.App.Erc20Keeper,
+		is.network.App.AuthzKeeper,
 	)
 	Expect(err).ToNot(HaveOccurred(), "failed to create bank precompile")
 	return precompile
@@ -57,6 +65,28 @@ func (is *IntegrationTestSuite) mintAndSendXMPLCoin(addr sdk.AccAddress, amount
 	Expect(err).ToNot(HaveOccurred())
 }
 
+// approve is a helper function to set the allowance of the spender over the owner's
+// XMPL coins through the bank precompile.
+func (s *PrecompileTestSuite) approve(owner, spender common.Address, amount *big.Int) {
+	method := s.precompile.Methods[authorization.ApproveMethod]
+	contract := vm.NewContract(vm.AccountRef(owner), s.precompile, big.NewInt(0), 200_000)
+	_, err := s.precompile.Approve(s.network.GetContext(), contract, s.network.GetStateDB(), &method, []interface{}{spender, s.tokenDenom, amount})
+	s.Require().NoError(err, "failed to approve allowance")
+}
+
+// allowance is a helper function to query the allowance of the spender over the
+// owner's XMPL coins through the bank precompile.
+func (s *PrecompileTestSuite) allowance(owner, spender common.Address) *big.Int {
+	method := s.precompile.Methods[authorization.AllowanceMethod]
+	bz, err := s.precompile.Allowance(s.network.GetContext(), nil, &method, []interface{}{owner, spender, s.tokenDenom})
+	s.Require().NoError(err, "failed to query allowance")
+
+	var allowance *big.Int
+	err = s.precompile.UnpackIntoInterface(&allowance, method.Name, bz)
+	s.Require().NoError(err, "failed to unpack allowance")
+	return allowance
+}
+
 // callType constants to differentiate between direct calls and calls through a contract.
 const (
 	directCall = iota + 1
@@ -8,6 +8,12 @@ const (
 	ErrNotRunInEvm = "not run in EVM"
 	// ErrDifferentOrigin is raised when an approval is set but the origin address is not the same as the spender.
 	ErrDifferentOrigin = "tx origin address %s does not match the delegator address %s"
+	// ErrDifferentOriginFromAccount is raised when the account of a precompile call is not the origin address.
+	ErrDifferentOriginFromAccount = "origin address %s is not the same as %s address %s"
+	// ErrReentrantCall is raised when a precompile is called again while executing a non-reentrant method.
+	ErrReentrantCall = "reentrant call to %s: precompile %s is already executing a non-reentrant method"
+	// ErrReentrancyGuardNotSupported is raised when the EVM keeper cannot lock the precompile.
+	ErrReentrancyGuardNotSupported = "reentrancy guard not supported for precompile %s"
 	// ErrInvalidABI is raised when the ABI cannot be parsed.
 	ErrInvalidABI = "invalid ABI: %w"
 	// ErrInvalidAmount is raised when the amount cannot be cast to a big.Int.
@@ -0,0 +1,99 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package common
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+)
+
+var _ vm.PrecompiledContract = &FreeReadsPrecompile{}
+
+// MethodsChecker defines the methods of the precompiled contracts used to tell
+// their view-only methods from their state-changing ones.
+type MethodsChecker interface {
+	MethodById(sigdata []byte) (*abi.Method, error)
+	IsTransaction(method string) bool
+}
+
+// freeReadsTracker defines the EVM keeper methods used to track the view-only
+// precompile calls made without gas charge by each account in a block.
+type freeReadsTracker interface {
+	GetFreePrecompileReads(ctx sdk.Context, account common.Address) uint64
+	IncrementFreePrecompileReads(ctx sdk.Context, account common.Address)
+}
+
+// FreeReadsPrecompile wraps a precompiled contract to waive the base gas cost of
+// the calls to its view-only methods, up to the given number of calls per block
+// for each tx sender. The gas consumed by the execution of the calls, such as
+// their store reads, is still charged. Once the allowance is exhausted, or for
+// the state-changing methods, the base gas cost is charged as usual.
+type FreeReadsPrecompile struct {
+	vm.PrecompiledContract
+	methods MethodsChecker
+	limit   uint64
+}
+
+// NewFreeReadsPrecompile returns the given precompiled contract wrapped to
+// execute up to limit calls to its view-only methods per block and per tx sender
+// without gas charge. The methods checker is usually the unwrapped contract.
+func NewFreeReadsPrecompile(precompile vm.PrecompiledContract, methods MethodsChecker, limit uint64) *FreeReadsPrecompile {
+	return &FreeReadsPrecompile{
+		PrecompiledContract: precompile,
+		methods:             methods,
+		limit:               limit,
+	}
+}
+
+// Run executes the wrapped precompiled contract. If the call is a free read,
+// the base gas cost charged before the execution is refunded.
+func (p FreeReadsPrecompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	if p.isViewMethod(contract.Input) && p.useFreeRead(evm) {
+		contract.Gas += p.RequiredGas(contract.Input)
+	}
+
+	return p.PrecompiledContract.Run(evm, contract, readOnly)
+}
+
+// isViewMethod returns true if the given input calls a view-only method of the
+// wrapped contract.
+func (p FreeReadsPrecompile) isViewMethod(input []byte) bool {
+	if len(input) < 4 {
+		return false
+	}
+
+	method, err := p.methods.MethodById(input[:4])
+	if err != nil {
+		return false
+	}
+
+	return !p.methods.IsTransaction(method.Name)
+}
+
+// useFreeRead consumes a free read of the tx sender and returns true if its
+// allowance for the current block is not exhausted yet.
+//
+// NOTE: the allowance is read and written with an infinite gas meter so that
+// the tracking is not charged to the call.
+func (p FreeReadsPrecompile) useFreeRead(evm *vm.EVM) bool {
+	stateDB, ok := evm.StateDB.(*statedb.StateDB)
+	if !ok {
+		return false
+	}
+
+	tracker, ok := stateDB.Keeper().(freeReadsTracker)
+	if !ok {
+		return false
+	}
+
+	ctx := stateDB.GetContext().WithGasMeter(sdk.NewInfiniteGasMeter())
+	if tracker.GetFreePrecompileReads(ctx, evm.Origin) >= p.limit {
+		return false
+	}
+
+	tracker.IncrementFreePrecompileReads(ctx, evm.Origin)
+	return true
+}
@@ -0,0 +1,38 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package common
+
+import (
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+var _ vm.PrecompiledContract = &GasCostsPrecompile{}
+
+// GasCostsPrecompile wraps a precompiled contract to charge the base gas costs set
+// through the EVM module parameters for some of its methods. The methods without an
+// overridden cost are charged the compiled default of the wrapped contract.
+type GasCostsPrecompile struct {
+	vm.PrecompiledContract
+	costs map[[4]byte]uint64
+}
+
+// NewGasCostsPrecompile returns the given precompiled contract wrapped to charge
+// the given base gas costs, indexed by method selector.
+func NewGasCostsPrecompile(precompile vm.PrecompiledContract, costs map[[4]byte]uint64) *GasCostsPrecompile {
+	return &GasCostsPrecompile{
+		PrecompiledContract: precompile,
+		costs:               costs,
+	}
+}
+
+// RequiredGas returns the overridden base gas cost of the called method, if any,
+// or the compiled default of the wrapped contract otherwise.
+func (p GasCostsPrecompile) RequiredGas(input []byte) uint64 {
+	if len(input) >= 4 {
+		if gas, ok := p.costs[[4]byte(input[:4])]; ok {
+			return gas
+		}
+	}
+
+	return p.PrecompiledContract.RequiredGas(input)
+}
@@ -0,0 +1,32 @@
+package common_test
+
+import (
+	"testing"
+
+	geth "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v16/precompiles/common"
+	"github.com/stretchr/testify/require"
+)
+
+// flatGasPrecompile is a precompiled contract charging a flat base gas cost
+type flatGasPrecompile struct {
+	vm.PrecompiledContract
+}
+
+func (flatGasPrecompile) RequiredGas([]byte) uint64 { return 1_000 }
+
+func (flatGasPrecompile) Address() geth.Address {
+	return geth.HexToAddress("0x0000000000000000000000000000000000000800")
+}
+
+func TestGasCostsPrecompile(t *testing.T) {
+	precompile := common.NewGasCostsPrecompile(flatGasPrecompile{}, map[[4]byte]uint64{
+		{0x02, 0x6e, 0x40, 0x2b}: 50_000,
+	})
+
+	require.Equal(t, flatGasPrecompile{}.Address(), precompile.Address())
+	require.Equal(t, uint64(50_000), precompile.RequiredGas([]byte{0x02, 0x6e, 0x40, 0x2b, 0x01}))
+	require.Equal(t, uint64(1_000), precompile.RequiredGas([]byte{0x6a, 0x62, 0x78, 0x42}))
+	require.Equal(t, uint64(1_000), precompile.RequiredGas([]byte{0x02, 0x6e}))
+}
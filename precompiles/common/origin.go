@@ -0,0 +1,49 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package common
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AuthorizedCall holds the result of the origin checks for a precompile method call.
+type AuthorizedCall struct {
+	// Account is the address on whose behalf the method is executed, which is
+	// always the transaction origin.
+	Account common.Address
+	// Delegated is true when the method is called by a contract on behalf of the
+	// origin, which requires an authorization grant from the origin to the caller.
+	Delegated bool
+}
+
+// GetAuthorizedCall computes the address on whose behalf a precompile method is
+// executed in a consistent way across precompiles. The account provided in the
+// method arguments must be the transaction origin. A caller that provides its
+// own address acts on behalf of the origin. The role of the account (e.g.
+// "delegator" or "sender") is only used in the error messages.
+//
+// NOTE: the returned call is delegated whenever the immediate caller is not the
+// origin. The precompile method must then check that the origin granted an
+// authorization to the caller, so that an intermediate contract can never act
+// on behalf of the origin without it.
+func GetAuthorizedCall(
+	origin, caller, account common.Address,
+	role string,
+) (AuthorizedCall, error) {
+	// the caller acts on behalf of the origin
+	if caller == account {
+		account = origin
+	}
+
+	if account != origin {
+		return AuthorizedCall{}, fmt.Errorf(ErrDifferentOriginFromAccount, origin.String(), role, account.String())
+	}
+
+	return AuthorizedCall{
+		Account:   account,
+		Delegated: caller != origin,
+	}, nil
+}
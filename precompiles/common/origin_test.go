@@ -0,0 +1,75 @@
+package common_test
+
+import (
+	"testing"
+
+	geth "github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v16/precompiles/common"
+	evmosutiltx "github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAuthorizedCall(t *testing.T) {
+	origin := evmosutiltx.GenerateAddress()
+	malicious := evmosutiltx.GenerateAddress()
+	other := evmosutiltx.GenerateAddress()
+
+	testCases := []struct {
+		name         string
+		caller       geth.Address
+		account      geth.Address
+		expDelegated bool
+		errContains  string
+	}{
+		{
+			name:    "pass - origin calls on its own behalf",
+			caller:  origin,
+			account: origin,
+		},
+		{
+			name:        "fail - origin calls on behalf of another account",
+			caller:      origin,
+			account:     other,
+			errContains: "is not the same as delegator address",
+		},
+		{
+			name:         "pass - contract calls on behalf of the origin",
+			caller:       malicious,
+			account:      origin,
+			expDelegated: true,
+		},
+		{
+			name:         "pass - contract provides its own address and acts on behalf of the origin",
+			caller:       malicious,
+			account:      malicious,
+			expDelegated: true,
+		},
+		{
+			name:        "fail - contract calls on behalf of another account",
+			caller:      malicious,
+			account:     other,
+			errContains: "is not the same as delegator address",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			call, err := common.GetAuthorizedCall(
+				origin,
+				tc.caller,
+				tc.account,
+				"delegator",
+			)
+
+			if tc.errContains != "" {
+				require.ErrorContains(t, err, tc.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, origin, call.Account, "expected call to be made on behalf of the origin")
+			require.Equal(t, tc.expDelegated, call.Delegated)
+		})
+	}
+}
@@ -22,6 +22,9 @@ type Precompile struct {
 	ApprovalExpiration   time.Duration
 	KvGasConfig          storetypes.GasConfig
 	TransientKVGasConfig storetypes.GasConfig
+	// NonReentrantMethods defines the state-changing methods that are guarded
+	// against reentrant calls to the precompile (see EnterNonReentrant).
+	NonReentrantMethods map[string]bool
 }
 
 // RequiredGas calculates the base minimum required gas for a transaction or a query.
@@ -108,11 +111,15 @@ func (p Precompile) RunSetup(
 
 // HandleGasError handles the out of gas panic by resetting the gas meter and returning an error.
 // This is used in order to avoid panics and to allow for the EVM to continue cleanup if the tx or query run out of gas.
+//
+// NOTE: the store reads and writes of a precompile are metered per byte by the gas meter set on RunSetup,
+// which is limited to the remaining gas of the contract. A gas overflow of the meter is also handled as out of gas,
+// so that a precompile can never panic the node while metering its store access.
 func HandleGasError(ctx sdk.Context, contract *vm.Contract, initialGas storetypes.Gas, err *error) func() {
 	return func() {
 		if r := recover(); r != nil {
 			switch r.(type) {
-			case sdk.ErrorOutOfGas:
+			case sdk.ErrorOutOfGas, storetypes.ErrorGasOverflow:
 				// update contract gas
 				usedGas := ctx.GasMeter().GasConsumed() - initialGas
 				_ = contract.UseGas(usedGas)
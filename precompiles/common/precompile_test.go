@@ -0,0 +1,71 @@
+package common_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v16/precompiles/common"
+	evmosutiltx "github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleGasError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		consume  func(ctx sdk.Context, key storetypes.StoreKey)
+		expPanic bool
+	}{
+		{
+			name: "store reads exceeding the contract gas return out of gas",
+			consume: func(ctx sdk.Context, key storetypes.StoreKey) {
+				ctx.KVStore(key).Get([]byte("key"))
+			},
+		},
+		{
+			name: "gas overflow returns out of gas",
+			consume: func(ctx sdk.Context, _ storetypes.StoreKey) {
+				ctx.GasMeter().ConsumeGas(1, "read")
+				ctx.GasMeter().ConsumeGas(math.MaxUint64, "overflow")
+			},
+		},
+		{
+			name: "other panics are not recovered",
+			consume: func(sdk.Context, storetypes.StoreKey) {
+				panic("unexpected")
+			},
+			expPanic: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			caller := vm.AccountRef(evmosutiltx.GenerateAddress())
+			contract := vm.NewContract(caller, caller, big.NewInt(0), 100)
+
+			// set the gas meter and KV gas configuration as done on the precompile setup
+			key := storetypes.NewKVStoreKey("test")
+			ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test")).
+				WithGasMeter(storetypes.NewGasMeter(contract.Gas)).
+				WithKVGasConfig(storetypes.KVGasConfig())
+
+			run := func() (err error) {
+				defer common.HandleGasError(ctx, contract, 0, &err)()
+
+				tc.consume(ctx, key)
+				return nil
+			}
+
+			if tc.expPanic {
+				require.Panics(t, func() { _ = run() })
+				return
+			}
+
+			require.ErrorIs(t, run(), vm.ErrOutOfGas)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package common
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+)
+
+// precompileLocker defines the EVM keeper methods used by the reentrancy guard
+// to track the precompiles that are executing a non-reentrant method.
+type precompileLocker interface {
+	IsPrecompileLocked(ctx sdk.Context, address common.Address) bool
+	LockPrecompile(ctx sdk.Context, address common.Address)
+	UnlockPrecompile(ctx sdk.Context, address common.Address)
+}
+
+// EnterNonReentrant guards the given method against reentrant calls to the
+// precompile if the method opted in through NonReentrantMethods. It sets the
+// precompile lock on the transient store and returns the function that
+// releases it, which must be deferred by the caller. A method that is not
+// guarded returns a no-op release function.
+//
+// NOTE: the lock is read and written with an infinite gas meter so that the
+// guard does not change the gas cost of the guarded methods.
+func (p Precompile) EnterNonReentrant(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	address common.Address,
+	methodName string,
+) (release func(), err error) {
+	if !p.NonReentrantMethods[methodName] {
+		return func() {}, nil
+	}
+
+	locker, ok := stateDB.Keeper().(precompileLocker)
+	if !ok {
+		return nil, fmt.Errorf(ErrReentrancyGuardNotSupported, address.String())
+	}
+
+	lockCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	if locker.IsPrecompileLocked(lockCtx, address) {
+		return nil, fmt.Errorf(ErrReentrantCall, methodName, address.String())
+	}
+
+	locker.LockPrecompile(lockCtx, address)
+	return func() { locker.UnlockPrecompile(lockCtx, address) }, nil
+}
@@ -0,0 +1,96 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package common
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// revertSelector is the 4-byte selector of the Solidity `Error(string)` function.
+var revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+// PrecompileError wraps the error returned by a precompile method with the
+// precompile and method names, so that the revert reason surfaced to the EVM
+// caller identifies where the underlying Cosmos error was raised.
+type PrecompileError struct {
+	// Precompile is the name of the precompile, e.g. "staking".
+	Precompile string
+	// Method is the name of the ABI method that failed, e.g. "delegate".
+	Method string
+	// Err is the underlying error returned by the method.
+	Err error
+}
+
+// NewPrecompileError creates a new PrecompileError instance.
+func NewPrecompileError(precompile, method string, err error) *PrecompileError {
+	return &PrecompileError{
+		Precompile: precompile,
+		Method:     method,
+		Err:        err,
+	}
+}
+
+// Error implements the error interface with the format "<precompile>/<method>: <error>".
+func (e *PrecompileError) Error() string {
+	return fmt.Sprintf("%s/%s: %s", e.Precompile, e.Method, e.Err)
+}
+
+// Unwrap returns the underlying error of the precompile method.
+func (e *PrecompileError) Unwrap() error {
+	return e.Err
+}
+
+// Revert ABI-encodes the error as a Solidity `Error(string)` revert reason.
+func (e *PrecompileError) Revert() ([]byte, error) {
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	packed, err := (abi.Arguments{{Type: stringType}}).Pack(e.Error())
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append([]byte{}, revertSelector...), packed...), nil
+}
+
+// HandleMethodError charges the gas consumed by a failed precompile method to
+// the contract and returns the method error as an EVM revert. The returned
+// bytes are the ABI-encoded PrecompileError, which is exposed as the revert
+// reason by eth_call and the transaction receipts.
+//
+// NOTE: errors raised by the EVM itself (e.g. out of gas or a nested revert)
+// are returned unchanged.
+func HandleMethodError(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	initialGas storetypes.Gas,
+	precompile, method string,
+	err error,
+) ([]byte, error) {
+	if errors.Is(err, vm.ErrOutOfGas) || errors.Is(err, vm.ErrExecutionReverted) {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	precompileErr := NewPrecompileError(precompile, method, err)
+	reason, packErr := precompileErr.Revert()
+	if packErr != nil {
+		return nil, precompileErr
+	}
+
+	return reason, vm.ErrExecutionReverted
+}
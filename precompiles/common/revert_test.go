@@ -0,0 +1,85 @@
+package common_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v16/precompiles/common"
+	evmosutiltx "github.com/evmos/evmos/v16/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrecompileError(t *testing.T) {
+	cosmosErr := errors.New("insufficient funds")
+	precompileErr := common.NewPrecompileError("staking", "delegate", cosmosErr)
+
+	require.Equal(t, "staking/delegate: insufficient funds", precompileErr.Error())
+	require.ErrorIs(t, precompileErr, cosmosErr)
+
+	bz, err := precompileErr.Revert()
+	require.NoError(t, err)
+
+	reason, err := evmtypes.UnpackRevertReason(bz)
+	require.NoError(t, err)
+	require.Equal(t, precompileErr.Error(), reason)
+}
+
+func TestHandleMethodError(t *testing.T) {
+	testCases := []struct {
+		name       string
+		err        error
+		gas        uint64
+		expErr     error
+		expReason  string
+		expGasLeft uint64
+	}{
+		{
+			name:       "method error is returned as revert",
+			err:        errors.New("insufficient funds"),
+			gas:        1000,
+			expErr:     vm.ErrExecutionReverted,
+			expReason:  "staking/delegate: insufficient funds",
+			expGasLeft: 900,
+		},
+		{
+			name:   "consumed gas exceeds the contract gas",
+			err:    errors.New("insufficient funds"),
+			gas:    50,
+			expErr: vm.ErrOutOfGas,
+		},
+		{
+			name:   "out of gas is returned unchanged",
+			err:    vm.ErrOutOfGas,
+			gas:    1000,
+			expErr: vm.ErrOutOfGas,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			ctx.GasMeter().ConsumeGas(100, "method execution")
+
+			caller := vm.AccountRef(evmosutiltx.GenerateAddress())
+			contract := vm.NewContract(caller, caller, big.NewInt(0), tc.gas)
+
+			bz, err := common.HandleMethodError(ctx, contract, 0, "staking", "delegate", tc.err)
+			require.ErrorIs(t, err, tc.expErr)
+
+			if tc.expReason == "" {
+				require.Nil(t, bz)
+				return
+			}
+
+			reason, err := evmtypes.UnpackRevertReason(bz)
+			require.NoError(t, err)
+			require.Equal(t, tc.expReason, reason)
+			require.Equal(t, tc.expGasLeft, contract.Gas)
+		})
+	}
+}
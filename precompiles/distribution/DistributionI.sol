@@ -92,6 +92,15 @@ interface DistributionI {
         string memory withdrawerAddress
     ) external returns (bool success);
 
+    /// @dev Withdraws the rewards of a delegator from all of its validators. The call
+    /// reverts if the delegator has delegations to more validators than the staking
+    /// max validators param.
+    /// @param delegatorAddress The address of the delegator
+    /// @return amount The aggregated amount of Coin withdrawn
+    function withdrawAllRewards(
+        address delegatorAddress
+    ) external returns (Coin[] calldata amount);
+
     /// @dev Withdraw the rewards of a delegator from a validator
     /// @param delegatorAddress The address of the delegator
     /// @param validatorAddress The address of the validator
@@ -57,6 +57,7 @@ func NewPrecompile(
 			KvGasConfig:          storetypes.KVGasConfig(),
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
+			NonReentrantMethods:  NonReentrantMethods,
 		},
 		stakingKeeper:      stakingKeeper,
 		distributionKeeper: distributionKeeper,
@@ -93,10 +94,19 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
+	// guard the state-changing methods against reentrant calls to the precompile
+	release, err := p.EnterNonReentrant(ctx, stateDB, p.Address(), method.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	switch method.Name {
 	// Custom transactions
 	case ClaimRewardsMethod:
 		bz, err = p.ClaimRewards(ctx, evm.Origin, contract, stateDB, method, args)
+	case WithdrawAllRewardsMethod:
+		bz, err = p.WithdrawAllRewards(ctx, evm.Origin, contract, stateDB, method, args)
 	// Distribution transactions
 	case SetWithdrawAddressMethod:
 		bz, err = p.SetWithdrawAddress(ctx, evm.Origin, contract, stateDB, method, args)
@@ -124,7 +134,7 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	}
 
 	if err != nil {
-		return nil, err
+		return cmn.HandleMethodError(ctx, contract, initialGas, "distribution", method.Name, err)
 	}
 
 	cost := ctx.GasMeter().GasConsumed() - initialGas
@@ -140,12 +150,14 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //
 // Available distribution transactions are:
 //   - ClaimRewards
+//   - WithdrawAllRewards
 //   - SetWithdrawAddress
 //   - WithdrawDelegatorRewards
 //   - WithdrawValidatorCommission
 func (Precompile) IsTransaction(methodName string) bool {
 	switch methodName {
 	case ClaimRewardsMethod,
+		WithdrawAllRewardsMethod,
 		SetWithdrawAddressMethod,
 		WithdrawDelegatorRewardsMethod,
 		WithdrawValidatorCommissionMethod:
@@ -11,4 +11,7 @@ const (
 	ErrWithdrawValCommissionAuth = "withdraw validator commission authorization for address %s does not exist"
 	// ErrDifferentValidator is raised when the origin address is not the same as the validator address.
 	ErrDifferentValidator = "origin address %s is not the same as validator address %s"
+	// ErrTooManyDelegatorValidators is raised when a delegator has delegations to more validators
+	// than can be withdrawn from in a single call.
+	ErrTooManyDelegatorValidators = "delegator %s has delegations to more than %d validators, use claimRewards instead"
 )
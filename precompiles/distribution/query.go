@@ -38,6 +38,10 @@ const (
 	DelegatorWithdrawAddressMethod = "delegatorWithdrawAddress"
 )
 
+// GasDelegationTotalRewardsPerValidator defines the gas charged for each validator
+// iterated on a DelegationTotalRewards query, on top of the store reads.
+const GasDelegationTotalRewardsPerValidator = 1_000
+
 // ValidatorDistributionInfo returns the distribution info for a validator.
 func (p Precompile) ValidatorDistributionInfo(
 	ctx sdk.Context,
@@ -170,6 +174,13 @@ func (p Precompile) DelegationTotalRewards(
 		return nil, err
 	}
 
+	// NOTE: the cost of the query grows with the number of validators the delegator
+	// is bonded to, so we charge for each of them
+	ctx.GasMeter().ConsumeGas(
+		GasDelegationTotalRewardsPerValidator*uint64(len(res.Rewards)),
+		"distribution extension delegationTotalRewards method",
+	)
+
 	out := new(DelegationTotalRewardsOutput).FromResponse(res)
 
 	return out.Pack(method.Outputs)
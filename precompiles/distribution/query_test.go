@@ -708,6 +708,26 @@ func (s *PrecompileTestSuite) TestDelegationTotalRewards() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestDelegationTotalRewardsGas() {
+	method := s.precompile.Methods[distribution.DelegationTotalRewardsMethod]
+	contract := vm.NewContract(vm.AccountRef(s.address), s.precompile, big.NewInt(0), 100000)
+
+	// no delegations, so no validators are charged for
+	newAddr, _ := testutiltx.NewAddrKey()
+	ctx := s.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err := s.precompile.DelegationTotalRewards(ctx, contract, &method, []interface{}{newAddr})
+	s.Require().NoError(err)
+	gasNoDelegations := ctx.GasMeter().GasConsumed()
+
+	// delegations to two validators
+	ctx = s.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err = s.precompile.DelegationTotalRewards(ctx, contract, &method, []interface{}{s.address})
+	s.Require().NoError(err)
+	gasDelegations := ctx.GasMeter().GasConsumed()
+
+	s.Require().GreaterOrEqual(gasDelegations-gasNoDelegations, uint64(2*distribution.GasDelegationTotalRewardsPerValidator))
+}
+
 func (s *PrecompileTestSuite) TestDelegatorValidators() {
 	method := s.precompile.Methods[distribution.DelegatorValidatorsMethod]
 
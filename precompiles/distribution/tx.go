@@ -30,8 +30,20 @@ const (
 	WithdrawValidatorCommissionMethod = "withdrawValidatorCommission"
 	// ClaimRewardsMethod defines the ABI method name for the custom ClaimRewards transaction
 	ClaimRewardsMethod = "claimRewards"
+	// WithdrawAllRewardsMethod defines the ABI method name for the custom WithdrawAllRewards transaction
+	WithdrawAllRewardsMethod = "withdrawAllRewards"
 )
 
+// NonReentrantMethods defines the distribution transactions that are guarded
+// against reentrant calls to the precompile.
+var NonReentrantMethods = map[string]bool{
+	ClaimRewardsMethod:                true,
+	SetWithdrawAddressMethod:          true,
+	WithdrawDelegatorRewardsMethod:    true,
+	WithdrawValidatorCommissionMethod: true,
+	WithdrawAllRewardsMethod:          true,
+}
+
 // ClaimRewards claims the rewards accumulated by a delegator from multiple or all validators.
 func (p Precompile) ClaimRewards(
 	ctx sdk.Context,
@@ -78,6 +90,67 @@ func (p Precompile) ClaimRewards(
 	return method.Outputs.Pack(true)
 }
 
+// WithdrawAllRewards withdraws the rewards accumulated by a delegator from all of its validators
+// and returns the aggregated coins. The number of validators is bounded by the staking max
+// validators param, and either all the withdrawals succeed or none of them is applied.
+func (p Precompile) WithdrawAllRewards(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegatorAddr, err := parseWithdrawAllRewardsArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the contract is the delegator, we don't need an origin check
+	// Otherwise check if the origin matches the delegator address
+	isContractDelegator := contract.CallerAddress == delegatorAddr
+	if !isContractDelegator && origin != delegatorAddr {
+		return nil, fmt.Errorf(cmn.ErrDifferentOrigin, origin.String(), delegatorAddr.String())
+	}
+
+	// retrieve one more validator than allowed to detect delegators over the limit
+	maxValidators := p.stakingKeeper.MaxValidators(ctx)
+	validators := p.stakingKeeper.GetDelegatorValidators(ctx, delegatorAddr.Bytes(), maxValidators+1)
+	if len(validators) > int(maxValidators) {
+		return nil, fmt.Errorf(ErrTooManyDelegatorValidators, delegatorAddr, maxValidators)
+	}
+
+	// withdraw on a cache context so that a failed withdrawal discards the previous ones
+	cacheCtx, writeCache := ctx.CacheContext()
+	totalCoins := sdk.Coins{}
+	for _, validator := range validators {
+		valAddr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		coins, err := p.distributionKeeper.WithdrawDelegationRewards(cacheCtx, delegatorAddr.Bytes(), valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		totalCoins = totalCoins.Add(coins...)
+	}
+	writeCache()
+
+	if err := p.EmitClaimRewardsEvent(ctx, stateDB, delegatorAddr, totalCoins); err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	if isContractDelegator {
+		stateDB.(*statedb.StateDB).AddBalance(contract.CallerAddress, totalCoins.AmountOf(p.stakingKeeper.BondDenom(ctx)).BigInt())
+	}
+
+	return method.Outputs.Pack(cmn.NewCoinsResponse(totalCoins))
+}
+
 // SetWithdrawAddress sets the withdrawal address for a delegator (or validator self-delegation).
 func (p Precompile) SetWithdrawAddress(
 	ctx sdk.Context,
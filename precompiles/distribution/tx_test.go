@@ -416,3 +416,97 @@ func (s *PrecompileTestSuite) TestClaimRewards() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestWithdrawAllRewards() {
+	method := s.precompile.Methods[distribution.WithdrawAllRewardsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(data []byte)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid delegator address",
+			func() []interface{} {
+				return []interface{}{nil}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"invalid delegator address",
+		},
+		{
+			"fail - delegations to more validators than the max validators param",
+			func() []interface{} {
+				params := s.app.StakingKeeper.GetParams(s.ctx)
+				params.MaxValidators = 1
+				err := s.app.StakingKeeper.SetParams(s.ctx, params)
+				s.Require().NoError(err)
+
+				return []interface{}{s.address}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"has delegations to more than 1 validators",
+		},
+		{
+			"success - withdraw from all validators",
+			func() []interface{} {
+				return []interface{}{s.address}
+			},
+			func(data []byte) {
+				var coins []cmn.Coin
+				err := s.precompile.UnpackIntoInterface(&coins, distribution.WithdrawAllRewardsMethod, data)
+				s.Require().NoError(err)
+				s.Require().Equal([]cmn.Coin{{Denom: utils.BaseDenom, Amount: big.NewInt(2e18)}}, coins)
+
+				balance := s.app.BankKeeper.GetBalance(s.ctx, s.address.Bytes(), utils.BaseDenom)
+				s.Require().Equal(balance.Amount.BigInt(), big.NewInt(7e18))
+			},
+			20000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, s.ctx = testutil.NewPrecompileContract(s.T(), s.ctx, s.address, s.precompile, tc.gas)
+
+			// Distribute rewards to the 2 validators, 1 EVMOS each
+			for _, val := range s.validators {
+				coins := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, math.NewInt(1e18)))
+				s.app.DistrKeeper.AllocateTokensToValidator(s.ctx, val, sdk.NewDecCoinsFromCoins(coins...))
+			}
+
+			bz, err := s.precompile.WithdrawAllRewards(s.ctx, s.address, contract, s.stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				// no rewards must have been withdrawn
+				balance := s.app.BankKeeper.GetBalance(s.ctx, s.address.Bytes(), utils.BaseDenom)
+				s.Require().Equal(balance.Amount.BigInt(), big.NewInt(5e18))
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
@@ -60,6 +60,20 @@ func parseClaimRewardsArgs(args []interface{}) (common.Address, uint32, error) {
 	return delegatorAddress, maxRetrieve, nil
 }
 
+// parseWithdrawAllRewardsArgs parses the arguments for the WithdrawAllRewards method.
+func parseWithdrawAllRewardsArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	delegatorAddress, ok := args[0].(common.Address)
+	if !ok || delegatorAddress == (common.Address{}) {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidDelegator, args[0])
+	}
+
+	return delegatorAddress, nil
+}
+
 // NewMsgSetWithdrawAddress creates a new MsgSetWithdrawAddress instance.
 func NewMsgSetWithdrawAddress(args []interface{}) (*distributiontypes.MsgSetWithdrawAddress, common.Address, error) {
 	if len(args) != 2 {
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The IEpochs contract's address.
+address constant IEPOCHS_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000805;
+
+/// @dev The IEpochs contract's instance.
+IEpochs constant IEPOCHS_CONTRACT = IEpochs(IEPOCHS_PRECOMPILE_ADDRESS);
+
+/**
+ * @author Evmos Team
+ * @title Epochs Interface
+ * @dev Interface for querying the epochs tracked by the Epochs module.
+ */
+interface IEpochs {
+  /// @dev epochInfo defines a method for retrieving the information of an epoch.
+  /// @param identifier the identifier of the epoch (e.g. "day" or "week")
+  /// @return startTime the unix timestamp in seconds at which the epoch counting started
+  /// @return duration the duration of the epoch in seconds
+  /// @return currentEpoch the number of the current epoch
+  /// @return currentEpochStartHeight the block height at which the current epoch started
+  function epochInfo(string calldata identifier)
+    external
+    view
+    returns (int64 startTime, int64 duration, int64 currentEpoch, int64 currentEpochStartHeight);
+
+  /// @dev currentEpoch defines a method for retrieving the number of the current epoch.
+  /// @param identifier the identifier of the epoch (e.g. "day" or "week")
+  /// @return currentEpoch the number of the current epoch
+  function currentEpoch(string calldata identifier) external view returns (int64 currentEpoch);
+}
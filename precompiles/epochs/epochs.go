@@ -0,0 +1,129 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package epochs
+
+import (
+	"embed"
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	epochskeeper "github.com/evmos/evmos/v16/x/epochs/keeper"
+)
+
+const (
+	// PrecompileAddress defines the epochs precompile address in Hex format
+	PrecompileAddress string = "0x0000000000000000000000000000000000000805"
+
+	// GasEpochInfo defines the flat gas cost for a single epochInfo query
+	GasEpochInfo = 2_000
+
+	// GasCurrentEpoch defines the flat gas cost for a single currentEpoch query
+	GasCurrentEpoch = 1_500
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the epochs precompile
+type Precompile struct {
+	cmn.Precompile
+	epochsKeeper epochskeeper.Keeper
+}
+
+// NewPrecompile creates a new epochs Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	epochsKeeper epochskeeper.Keeper,
+) (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: we set an empty gas configuration to only charge the flat gas
+	// cost of each query
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  newABI,
+			KvGasConfig:          storetypes.GasConfig{},
+			TransientKVGasConfig: storetypes.GasConfig{},
+		},
+		epochsKeeper: epochsKeeper,
+	}, nil
+}
+
+// Address defines the address of the epochs compile contract.
+// address: 0x0000000000000000000000000000000000000805
+func (Precompile) Address() common.Address {
+	return common.HexToAddress(PrecompileAddress)
+}
+
+// RequiredGas returns the flat gas cost of the called epochs query.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	switch method.Name {
+	case EpochInfoMethod:
+		return GasEpochInfo
+	case CurrentEpochMethod:
+		return GasCurrentEpoch
+	}
+
+	return 0
+}
+
+// Run executes the precompiled contract epochs methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, _, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// Epochs queries
+	case EpochInfoMethod:
+		bz, err = p.EpochInfo(ctx, contract, method, args)
+	case CurrentEpochMethod:
+		bz, err = p.CurrentEpoch(ctx, contract, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return cmn.HandleMethodError(ctx, contract, initialGas, "epochs", method.Name, err)
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+// The epochs precompile only exposes queries.
+func (Precompile) IsTransaction(_ string) bool {
+	return false
+}
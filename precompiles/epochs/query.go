@@ -0,0 +1,67 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package epochs
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	// EpochInfoMethod defines the ABI method name for the epochs EpochInfo
+	// query.
+	EpochInfoMethod = "epochInfo"
+	// CurrentEpochMethod defines the ABI method name for the epochs CurrentEpoch
+	// query.
+	CurrentEpochMethod = "currentEpoch"
+)
+
+// EpochInfo returns the start time and duration in seconds, the current epoch
+// number and the start height of the current epoch for the given epoch identifier.
+func (p Precompile) EpochInfo(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	identifier, err := ParseIdentifierArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	info, found := p.epochsKeeper.GetEpochInfo(ctx, identifier)
+	if !found {
+		return nil, fmt.Errorf(ErrEpochNotFound, identifier)
+	}
+
+	return method.Outputs.Pack(
+		info.StartTime.Unix(),
+		int64(info.Duration.Seconds()),
+		info.CurrentEpoch,
+		info.CurrentEpochStartHeight,
+	)
+}
+
+// CurrentEpoch returns the current epoch number for the given epoch identifier.
+func (p Precompile) CurrentEpoch(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	identifier, err := ParseIdentifierArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	info, found := p.epochsKeeper.GetEpochInfo(ctx, identifier)
+	if !found {
+		return nil, fmt.Errorf(ErrEpochNotFound, identifier)
+	}
+
+	return method.Outputs.Pack(info.CurrentEpoch)
+}
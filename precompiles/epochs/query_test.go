@@ -0,0 +1,147 @@
+package epochs_test
+
+import (
+	"time"
+
+	"github.com/evmos/evmos/v16/precompiles/epochs"
+	epochstypes "github.com/evmos/evmos/v16/x/epochs/types"
+)
+
+func (s *PrecompileTestSuite) TestEpochInfo() {
+	method := s.precompile.Methods[epochs.EpochInfoMethod]
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{"", ""}
+			},
+			false,
+			"invalid number of arguments",
+		},
+		{
+			"fail - invalid identifier type",
+			func() []interface{} {
+				return []interface{}{1}
+			},
+			false,
+			"invalid type for identifier",
+		},
+		{
+			"fail - blank identifier",
+			func() []interface{} {
+				return []interface{}{" "}
+			},
+			false,
+			"epoch identifier cannot be blank",
+		},
+		{
+			"fail - epoch not found",
+			func() []interface{} {
+				return []interface{}{"month"}
+			},
+			false,
+			"epoch with identifier month not found",
+		},
+		{
+			"pass - week epoch",
+			func() []interface{} {
+				return []interface{}{epochstypes.WeekEpochID}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+
+			info, found := s.network.App.EpochsKeeper.GetEpochInfo(ctx, epochstypes.WeekEpochID)
+			s.Require().True(found, "expected week epoch to be found")
+			info.CurrentEpoch = 3
+			info.CurrentEpochStartHeight = 42
+			s.network.App.EpochsKeeper.SetEpochInfo(ctx, info)
+
+			bz, err := s.precompile.EpochInfo(ctx, nil, &method, tc.malleate())
+
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err, "failed to unpack output")
+			s.Require().Equal(info.StartTime.Unix(), out[0])
+			s.Require().Equal(int64((7 * 24 * time.Hour).Seconds()), out[1])
+			s.Require().Equal(int64(3), out[2])
+			s.Require().Equal(int64(42), out[3])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCurrentEpoch() {
+	method := s.precompile.Methods[epochs.CurrentEpochMethod]
+
+	testcases := []struct {
+		name        string
+		identifier  string
+		expPass     bool
+		errContains string
+		expEpoch    int64
+	}{
+		{
+			"fail - epoch not found",
+			"month",
+			false,
+			"epoch with identifier month not found",
+			0,
+		},
+		{
+			"pass - day epoch",
+			epochstypes.DayEpochID,
+			true,
+			"",
+			5,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+
+			info, found := s.network.App.EpochsKeeper.GetEpochInfo(ctx, epochstypes.DayEpochID)
+			s.Require().True(found, "expected day epoch to be found")
+			info.CurrentEpoch = 5
+			s.network.App.EpochsKeeper.SetEpochInfo(ctx, info)
+
+			bz, err := s.precompile.CurrentEpoch(ctx, nil, &method, []interface{}{tc.identifier})
+
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err, "failed to unpack output")
+			s.Require().Equal(tc.expEpoch, out[0])
+		})
+	}
+}
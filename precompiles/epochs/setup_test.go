@@ -0,0 +1,31 @@
+package epochs_test
+
+import (
+	"testing"
+
+	"github.com/evmos/evmos/v16/precompiles/epochs"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	"github.com/stretchr/testify/suite"
+)
+
+// PrecompileTestSuite is the implementation of the TestSuite interface for the
+// epochs precompile unit tests.
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network    *network.UnitTestNetwork
+	precompile *epochs.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	s.network = network.NewUnitTestNetwork()
+
+	precompile, err := epochs.NewPrecompile(s.network.App.EpochsKeeper)
+	s.Require().NoError(err, "failed to create epochs precompile")
+
+	s.precompile = precompile
+}
@@ -0,0 +1,30 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package epochs
+
+import (
+	"fmt"
+	"strings"
+
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+)
+
+// ParseIdentifierArgs parses the call arguments for the epochs EpochInfo and
+// CurrentEpoch queries.
+func ParseIdentifierArgs(args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	identifier, ok := args[0].(string)
+	if !ok {
+		return "", fmt.Errorf(cmn.ErrInvalidType, "identifier", "", args[0])
+	}
+
+	if strings.TrimSpace(identifier) == "" {
+		return "", fmt.Errorf("epoch identifier cannot be blank")
+	}
+
+	return identifier, nil
+}
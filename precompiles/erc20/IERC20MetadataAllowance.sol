@@ -2,13 +2,14 @@
 pragma solidity >=0.8.18;
 
 import "./IERC20Metadata.sol";
+import "./IERC20Permit.sol";
 
 /**
  * @author Evmos Team
  * @title ERC20 Metadata Allowance Interface
- * @dev Interface for the optional metadata and allowance functions from the ERC20 standard.
+ * @dev Interface for the optional metadata, allowance and permit functions from the ERC20 standard.
  */
-interface IERC20MetadataAllowance is IERC20Metadata {	
+interface IERC20MetadataAllowance is IERC20Metadata, IERC20Permit {	
     /** @dev Atomically increases the allowance granted to spender by the caller.
       * This is an alternative to approve that can be used as a mitigation for problems described in
       * IERC20.approve.
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/**
+ * @author Evmos Team
+ * @title ERC20 Permit Interface
+ * @dev Interface for the permit extension of the ERC20 standard as defined in EIP-2612.
+ */
+interface IERC20Permit {
+    /** @dev Sets value as the allowance of spender over owner's tokens, given owner's signed approval.
+      * Emits an Approval event.
+      * @param owner The address of the token owner that signed the approval.
+      * @param spender The address which will spend the funds.
+      * @param value The amount of tokens to be approved.
+      * @param deadline The timestamp until which the signature is valid.
+      * @param v The recovery byte of the signature.
+      * @param r Half of the ECDSA signature pair.
+      * @param s Half of the ECDSA signature pair.
+    */
+    function permit(
+        address owner,
+        address spender,
+        uint256 value,
+        uint256 deadline,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+
+    /** @dev Returns the current nonce for owner. This value must be included whenever
+      * a signature is generated for permit.
+      * @param owner The address of the token owner.
+      * @return The current nonce of the owner.
+    */
+    function nonces(address owner) external view returns (uint256);
+
+    /** @dev Returns the domain separator used in the encoding of the signature for permit,
+      * as defined by EIP-712.
+      * @return The domain separator of the token.
+    */
+    // solhint-disable-next-line func-name-mixedcase
+    function DOMAIN_SEPARATOR() external view returns (bytes32);
+}
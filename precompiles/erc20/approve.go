@@ -55,30 +55,7 @@ func (p Precompile) Approve(
 		return nil, ErrSpenderIsOwner
 	}
 
-	// TODO: owner should be the owner of the contract
-	authorization, expiration, _ := auth.CheckAuthzExists(ctx, p.AuthzKeeper, grantee, granter, SendMsgURL) //#nosec:G703 -- we are handling the error case (authorization == nil) in the switch statement below
-
-	switch {
-	case authorization == nil && amount != nil && amount.Sign() < 0:
-		// case 1: no authorization, amount 0 or negative -> error
-		err = ErrNegativeAmount
-	case authorization == nil && amount != nil && amount.Sign() > 0:
-		// case 2: no authorization, amount positive -> create a new authorization
-		err = p.createAuthorization(ctx, grantee, granter, amount)
-	case authorization != nil && amount != nil && amount.Sign() <= 0:
-		// case 3: authorization exists, amount 0 or negative -> remove from spend limit and delete authorization if no spend limit left
-		err = p.removeSpendLimitOrDeleteAuthorization(ctx, grantee, granter, authorization, expiration)
-	case authorization != nil && amount != nil && amount.Sign() > 0:
-		// case 4: authorization exists, amount positive -> update authorization
-		sendAuthz, ok := authorization.(*banktypes.SendAuthorization)
-		if !ok {
-			return nil, authz.ErrUnknownAuthorizationType
-		}
-
-		err = p.updateAuthorization(ctx, grantee, granter, amount, sendAuthz, expiration)
-	}
-
-	if err != nil {
+	if err := p.setAllowance(ctx, grantee, granter, amount); err != nil {
 		return nil, err
 	}
 
@@ -220,6 +197,36 @@ func (p Precompile) DecreaseAllowance(
 	return method.Outputs.Pack(true)
 }
 
+// setAllowance sets the given amount as the allowance of the grantee over the
+// granter's tokens, creating, updating or deleting the underlying authorization.
+func (p Precompile) setAllowance(ctx sdk.Context, grantee, granter common.Address, amount *big.Int) error {
+	// TODO: owner should be the owner of the contract
+	authorization, expiration, _ := auth.CheckAuthzExists(ctx, p.AuthzKeeper, grantee, granter, SendMsgURL) //#nosec:G703 -- we are handling the error case (authorization == nil) in the switch statement below
+
+	var err error
+	switch {
+	case authorization == nil && amount != nil && amount.Sign() < 0:
+		// case 1: no authorization, amount 0 or negative -> error
+		err = ErrNegativeAmount
+	case authorization == nil && amount != nil && amount.Sign() > 0:
+		// case 2: no authorization, amount positive -> create a new authorization
+		err = p.createAuthorization(ctx, grantee, granter, amount)
+	case authorization != nil && amount != nil && amount.Sign() <= 0:
+		// case 3: authorization exists, amount 0 or negative -> remove from spend limit and delete authorization if no spend limit left
+		err = p.removeSpendLimitOrDeleteAuthorization(ctx, grantee, granter, authorization, expiration)
+	case authorization != nil && amount != nil && amount.Sign() > 0:
+		// case 4: authorization exists, amount positive -> update authorization
+		sendAuthz, ok := authorization.(*banktypes.SendAuthorization)
+		if !ok {
+			return authz.ErrUnknownAuthorizationType
+		}
+
+		err = p.updateAuthorization(ctx, grantee, granter, amount, sendAuthz, expiration)
+	}
+
+	return err
+}
+
 func (p Precompile) createAuthorization(ctx sdk.Context, grantee, granter common.Address, amount *big.Int) error {
 	if amount.BitLen() > sdkmath.MaxBitLen {
 		return fmt.Errorf(ErrIntegerOverflow, amount)
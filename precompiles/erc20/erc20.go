@@ -36,6 +36,9 @@ const (
 	GasTotalSupply       = 2_477
 	GasBalanceOf         = 2_851
 	GasAllowance         = 3_246
+	GasPermit            = 37_000
+	GasNonces            = 2_500
+	GasDomainSeparator   = 1_000
 )
 
 // Embed abi json file to the executable binary. Needed when importing as dependency.
@@ -45,12 +48,20 @@ var f embed.FS
 
 var _ vm.PrecompiledContract = &Precompile{}
 
+// Erc20Keeper defines the expected interface of the erc20 module keeper, used to
+// persist the EIP-2612 permit nonces.
+type Erc20Keeper interface {
+	GetPermitNonce(ctx sdk.Context, contract, owner common.Address) uint64
+	SetPermitNonce(ctx sdk.Context, contract, owner common.Address, nonce uint64)
+}
+
 // Precompile defines the precompiled contract for ERC-20.
 type Precompile struct {
 	cmn.Precompile
 	tokenPair      erc20types.TokenPair
 	bankKeeper     bankkeeper.Keeper
 	transferKeeper transferkeeper.Keeper
+	erc20Keeper    Erc20Keeper
 }
 
 // NewPrecompile creates a new ERC-20 Precompile instance as a
@@ -60,6 +71,7 @@ func NewPrecompile(
 	bankKeeper bankkeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
+	erc20Keeper Erc20Keeper,
 ) (*Precompile, error) {
 	newABI, err := cmn.LoadABI(f, abiPath)
 	if err != nil {
@@ -77,6 +89,7 @@ func NewPrecompile(
 		tokenPair:      tokenPair,
 		bankKeeper:     bankKeeper,
 		transferKeeper: transferKeeper,
+		erc20Keeper:    erc20Keeper,
 	}, nil
 }
 
@@ -113,6 +126,8 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasIncreaseAllowance
 	case auth.DecreaseAllowanceMethod:
 		return GasDecreaseAllowance
+	case PermitMethod:
+		return GasPermit
 	// ERC-20 queries
 	case NameMethod:
 		return GasName
@@ -126,6 +141,10 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasBalanceOf
 	case auth.AllowanceMethod:
 		return GasAllowance
+	case NoncesMethod:
+		return GasNonces
+	case DomainSeparatorMethod:
+		return GasDomainSeparator
 	default:
 		return 0
 	}
@@ -163,7 +182,8 @@ func (Precompile) IsTransaction(methodName string) bool {
 		TransferFromMethod,
 		auth.ApproveMethod,
 		auth.IncreaseAllowanceMethod,
-		auth.DecreaseAllowanceMethod:
+		auth.DecreaseAllowanceMethod,
+		PermitMethod:
 		return true
 	default:
 		return false
@@ -190,6 +210,8 @@ func (p Precompile) HandleMethod(
 		bz, err = p.IncreaseAllowance(ctx, contract, stateDB, method, args)
 	case auth.DecreaseAllowanceMethod:
 		bz, err = p.DecreaseAllowance(ctx, contract, stateDB, method, args)
+	case PermitMethod:
+		bz, err = p.Permit(ctx, contract, stateDB, method, args)
 	// ERC-20 queries
 	case NameMethod:
 		bz, err = p.Name(ctx, contract, stateDB, method, args)
@@ -203,6 +225,10 @@ func (p Precompile) HandleMethod(
 		bz, err = p.BalanceOf(ctx, contract, stateDB, method, args)
 	case auth.AllowanceMethod:
 		bz, err = p.Allowance(ctx, contract, stateDB, method, args)
+	case NoncesMethod:
+		bz, err = p.Nonces(ctx, contract, stateDB, method, args)
+	case DomainSeparatorMethod:
+		bz, err = p.DomainSeparator(ctx, contract, stateDB, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
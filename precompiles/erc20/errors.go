@@ -33,6 +33,10 @@ var (
 	ErrNegativeAmount           = errors.New("cannot approve negative values")
 	ErrSpenderIsOwner           = errors.New("spender cannot be the owner")
 
+	// ERC20Permit errors
+	ErrPermitExpiredDeadline  = errors.New("ERC20Permit: expired deadline")
+	ErrPermitInvalidSignature = errors.New("ERC20Permit: invalid signature")
+
 	// ERC20 errors
 	ErrDecreasedAllowanceBelowZero  = errors.New("ERC20: decreased allowance below zero")
 	ErrInsufficientAllowance        = errors.New("ERC20: insufficient allowance")
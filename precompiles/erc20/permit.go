@@ -0,0 +1,175 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20
+
+import (
+	"bytes"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmostypes "github.com/evmos/evmos/v16/types"
+)
+
+const (
+	// PermitMethod defines the ABI method name for the ERC-20 Permit
+	// transaction.
+	PermitMethod = "permit"
+	// NoncesMethod defines the ABI method name for the ERC-20 Nonces
+	// query.
+	NoncesMethod = "nonces"
+	// DomainSeparatorMethod defines the ABI method name for the ERC-20
+	// DOMAIN_SEPARATOR query.
+	DomainSeparatorMethod = "DOMAIN_SEPARATOR"
+
+	// permitVersion is the version of the EIP-712 signing domain.
+	permitVersion = "1"
+)
+
+var (
+	// domainTypeHash is the EIP-712 type hash of the signing domain.
+	domainTypeHash = crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+	// permitTypeHash is the EIP-712 type hash of the permit message.
+	permitTypeHash = crypto.Keccak256([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"))
+)
+
+// Permit sets the given value as the allowance of the spender over the owner's
+// tokens, given the owner's EIP-712 signed approval as defined in EIP-2612.
+// It increments the owner's nonce and emits the Approval event on success.
+func (p Precompile) Permit(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, spender, value, deadline, v, r, s, err := ParsePermitArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if deadline.Cmp(big.NewInt(ctx.BlockTime().Unix())) < 0 {
+		return nil, ErrPermitExpiredDeadline
+	}
+
+	if bytes.Equal(owner.Bytes(), spender.Bytes()) {
+		return nil, ErrSpenderIsOwner
+	}
+
+	domainSeparator, err := p.domainSeparator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := p.erc20Keeper.GetPermitNonce(ctx, p.Address(), owner)
+	structHash := crypto.Keccak256(
+		permitTypeHash,
+		common.LeftPadBytes(owner.Bytes(), 32),
+		common.LeftPadBytes(spender.Bytes(), 32),
+		common.LeftPadBytes(value.Bytes(), 32),
+		common.LeftPadBytes(new(big.Int).SetUint64(nonce).Bytes(), 32),
+		common.LeftPadBytes(deadline.Bytes(), 32),
+	)
+	digest := crypto.Keccak256([]byte("\x19\x01"), domainSeparator, structHash)
+
+	signer, err := recoverPermitSigner(digest, v, r, s)
+	if err != nil || signer != owner {
+		return nil, ErrPermitInvalidSignature
+	}
+
+	p.erc20Keeper.SetPermitNonce(ctx, p.Address(), owner, nonce+1)
+
+	if err := p.setAllowance(ctx, spender, owner, value); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, owner, spender, value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Nonces returns the current permit nonce of the given owner.
+func (p Precompile) Nonces(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, err := ParseBalanceOfArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := p.erc20Keeper.GetPermitNonce(ctx, p.Address(), owner)
+	return method.Outputs.Pack(new(big.Int).SetUint64(nonce))
+}
+
+// DomainSeparator returns the EIP-712 domain separator used to sign permits.
+func (p Precompile) DomainSeparator(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	domainSeparator, err := p.domainSeparator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(common.BytesToHash(domainSeparator))
+}
+
+// domainSeparator computes the EIP-712 domain separator of the token, using its
+// name, the chain ID and the precompile address as the verifying contract.
+func (p Precompile) domainSeparator(ctx sdk.Context) ([]byte, error) {
+	name, err := p.tokenName(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := evmostypes.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.Keccak256(
+		domainTypeHash,
+		crypto.Keccak256([]byte(name)),
+		crypto.Keccak256([]byte(permitVersion)),
+		common.LeftPadBytes(chainID.Bytes(), 32),
+		common.LeftPadBytes(p.Address().Bytes(), 32),
+	), nil
+}
+
+// recoverPermitSigner recovers the address that signed the permit digest. Only
+// signatures with a recovery value of 27 or 28 and a lower-half s value are accepted.
+func recoverPermitSigner(digest []byte, v uint8, r, s [32]byte) (common.Address, error) {
+	if v != 27 && v != 28 {
+		return common.Address{}, ErrPermitInvalidSignature
+	}
+
+	recoveryID := v - 27
+	if !crypto.ValidateSignatureValues(recoveryID, new(big.Int).SetBytes(r[:]), new(big.Int).SetBytes(s[:]), true) {
+		return common.Address{}, ErrPermitInvalidSignature
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig[:32], r[:])
+	copy(sig[32:64], s[:])
+	sig[64] = recoveryID
+
+	pubKey, err := crypto.SigToPub(digest, sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
@@ -0,0 +1,249 @@
+package erc20_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v16/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v16/precompiles/erc20"
+	"github.com/evmos/evmos/v16/precompiles/testutil"
+	evmostypes "github.com/evmos/evmos/v16/types"
+)
+
+// permitTokenName is the name registered in the bank metadata of the token
+// used in the permit tests, which is part of the EIP-712 signing domain.
+const permitTokenName = "Example Token"
+
+func (s *PrecompileTestSuite) TestPermit() {
+	method := s.precompile.Methods[erc20.PermitMethod]
+	amount := big.NewInt(100)
+
+	testcases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context) []interface{}
+		postCheck   func(ctx sdk.Context)
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:        "fail - empty args",
+			malleate:    func(sdk.Context) []interface{} { return nil },
+			errContains: "invalid number of arguments",
+		},
+		{
+			name: "fail - expired deadline",
+			malleate: func(ctx sdk.Context) []interface{} {
+				deadline := big.NewInt(ctx.BlockTime().Unix() - 1)
+				return s.signPermit(ctx, 0, s.keyring.GetAddr(1), amount, 0, deadline)
+			},
+			errContains: erc20.ErrPermitExpiredDeadline.Error(),
+		},
+		{
+			name: "fail - spender is owner",
+			malleate: func(ctx sdk.Context) []interface{} {
+				deadline := big.NewInt(ctx.BlockTime().Unix() + 100)
+				return s.signPermit(ctx, 0, s.keyring.GetAddr(0), amount, 0, deadline)
+			},
+			errContains: erc20.ErrSpenderIsOwner.Error(),
+		},
+		{
+			name: "fail - signed by other account",
+			malleate: func(ctx sdk.Context) []interface{} {
+				deadline := big.NewInt(ctx.BlockTime().Unix() + 100)
+				args := s.signPermit(ctx, 1, s.keyring.GetAddr(1), amount, 0, deadline)
+				args[0] = s.keyring.GetAddr(0)
+				return args
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "fail - invalid recovery value",
+			malleate: func(ctx sdk.Context) []interface{} {
+				deadline := big.NewInt(ctx.BlockTime().Unix() + 100)
+				args := s.signPermit(ctx, 0, s.keyring.GetAddr(1), amount, 0, deadline)
+				args[4] = uint8(1)
+				return args
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "fail - replayed nonce",
+			malleate: func(ctx sdk.Context) []interface{} {
+				s.network.App.Erc20Keeper.SetPermitNonce(ctx, s.precompile.Address(), s.keyring.GetAddr(0), 1)
+
+				deadline := big.NewInt(ctx.BlockTime().Unix() + 100)
+				return s.signPermit(ctx, 0, s.keyring.GetAddr(1), amount, 0, deadline)
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "pass - permit sets allowance and increments nonce",
+			malleate: func(ctx sdk.Context) []interface{} {
+				deadline := big.NewInt(ctx.BlockTime().Unix() + 100)
+				return s.signPermit(ctx, 0, s.keyring.GetAddr(1), amount, 0, deadline)
+			},
+			expPass: true,
+			postCheck: func(ctx sdk.Context) {
+				s.requireSendAuthz(
+					s.keyring.GetAccAddr(1),
+					s.keyring.GetAccAddr(0),
+					sdk.NewCoins(sdk.NewCoin(s.tokenDenom, sdk.NewIntFromBigInt(amount))),
+					[]string{},
+				)
+
+				nonce := s.network.App.Erc20Keeper.GetPermitNonce(ctx, s.precompile.Address(), s.keyring.GetAddr(0))
+				s.Require().Equal(uint64(1), nonce, "expected nonce to be incremented")
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.setupPermitTokenMetadata()
+
+			ctx := s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(
+				s.T(),
+				ctx,
+				s.keyring.GetAddr(1),
+				s.precompile,
+				200_000,
+			)
+
+			var args []interface{}
+			if tc.malleate != nil {
+				args = tc.malleate(ctx)
+			}
+
+			bz, err := s.precompile.Permit(
+				ctx,
+				contract,
+				s.network.GetStateDB(),
+				&method,
+				args,
+			)
+
+			if tc.expPass {
+				s.Require().NoError(err, "expected no error")
+				s.Require().Empty(bz, "expected empty bytes since permit has no outputs")
+			} else {
+				s.Require().Error(err, "expected error")
+				s.Require().ErrorContains(err, tc.errContains, "expected different error message")
+				s.Require().Empty(bz, "expected empty bytes")
+			}
+
+			if tc.postCheck != nil {
+				tc.postCheck(ctx)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestNonces() {
+	method := s.precompile.Methods[erc20.NoncesMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+
+	bz, err := s.precompile.Nonces(ctx, nil, nil, &method, []interface{}{s.keyring.GetAddr(0)})
+	s.requireOut(bz, err, method, true, "", common.Big0)
+
+	s.network.App.Erc20Keeper.SetPermitNonce(ctx, s.precompile.Address(), s.keyring.GetAddr(0), 3)
+
+	bz, err = s.precompile.Nonces(ctx, nil, nil, &method, []interface{}{s.keyring.GetAddr(0)})
+	s.requireOut(bz, err, method, true, "", big.NewInt(3))
+
+	_, err = s.precompile.Nonces(ctx, nil, nil, &method, []interface{}{"invalid address"})
+	s.Require().ErrorContains(err, "invalid account address")
+}
+
+func (s *PrecompileTestSuite) TestDomainSeparator() {
+	method := s.precompile.Methods[erc20.DomainSeparatorMethod]
+
+	s.SetupTest()
+	s.setupPermitTokenMetadata()
+	ctx := s.network.GetContext()
+
+	bz, err := s.precompile.DomainSeparator(ctx, nil, nil, &method, nil)
+	s.Require().NoError(err, "expected no error")
+
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err, "expected no error unpacking the output")
+	s.Require().Len(out, 1, "expected one output")
+
+	separator, ok := out[0].([32]byte)
+	s.Require().True(ok, "expected bytes32 output")
+	s.Require().Equal(s.expectedDomainSeparator(ctx), common.Hash(separator))
+}
+
+// setupPermitTokenMetadata registers the bank metadata of the test token, so that
+// the token name used in the EIP-712 signing domain is available.
+func (s *PrecompileTestSuite) setupPermitTokenMetadata() {
+	s.network.App.BankKeeper.SetDenomMetaData(s.network.GetContext(), banktypes.Metadata{
+		Description: "An exemplary token",
+		Base:        s.tokenDenom,
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: s.tokenDenom, Exponent: 0}},
+		Display:     s.tokenDenom,
+		Name:        permitTokenName,
+		Symbol:      "XMPL",
+	})
+}
+
+// expectedDomainSeparator computes the EIP-712 domain separator of the test token.
+func (s *PrecompileTestSuite) expectedDomainSeparator(ctx sdk.Context) common.Hash {
+	chainID, err := evmostypes.ParseChainID(ctx.ChainID())
+	s.Require().NoError(err, "expected no error parsing the chain ID")
+
+	return crypto.Keccak256Hash(
+		crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)")),
+		crypto.Keccak256([]byte(permitTokenName)),
+		crypto.Keccak256([]byte("1")),
+		common.LeftPadBytes(chainID.Bytes(), 32),
+		common.LeftPadBytes(s.precompile.Address().Bytes(), 32),
+	)
+}
+
+// signPermit signs an EIP-2612 permit with the key at the given keyring index and
+// returns the arguments for the permit method.
+func (s *PrecompileTestSuite) signPermit(
+	ctx sdk.Context,
+	ownerIdx int,
+	spender common.Address,
+	value *big.Int,
+	nonce uint64,
+	deadline *big.Int,
+) []interface{} {
+	owner := s.keyring.GetAddr(ownerIdx)
+
+	structHash := crypto.Keccak256(
+		crypto.Keccak256([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)")),
+		common.LeftPadBytes(owner.Bytes(), 32),
+		common.LeftPadBytes(spender.Bytes(), 32),
+		common.LeftPadBytes(value.Bytes(), 32),
+		common.LeftPadBytes(new(big.Int).SetUint64(nonce).Bytes(), 32),
+		common.LeftPadBytes(deadline.Bytes(), 32),
+	)
+	domainSeparator := s.expectedDomainSeparator(ctx)
+	digest := crypto.Keccak256([]byte("\x19\x01"), domainSeparator.Bytes(), structHash)
+
+	privKey, ok := s.keyring.GetPrivKey(ownerIdx).(*ethsecp256k1.PrivKey)
+	s.Require().True(ok, "expected ethsecp256k1 private key")
+	key, err := privKey.ToECDSA()
+	s.Require().NoError(err, "expected no error converting the private key")
+
+	sig, err := crypto.Sign(digest, key)
+	s.Require().NoError(err, "expected no error signing the permit")
+
+	var r, sigS [32]byte
+	copy(r[:], sig[:32])
+	copy(sigS[:], sig[32:64])
+
+	return []interface{}{owner, spender, value, deadline, sig[64] + 27, r, sigS}
+}
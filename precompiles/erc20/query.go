@@ -51,18 +51,28 @@ func (p Precompile) Name(
 	method *abi.Method,
 	_ []interface{},
 ) ([]byte, error) {
+	name, err := p.tokenName(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(name)
+}
+
+// tokenName returns the name of the token from its bank metadata or, if not
+// registered, from the capitalized base denomination of the token.
+func (p Precompile) tokenName(ctx sdk.Context) (string, error) {
 	metadata, found := p.bankKeeper.GetDenomMetaData(ctx, p.tokenPair.Denom)
 	if found {
-		return method.Outputs.Pack(metadata.Name)
+		return metadata.Name, nil
 	}
 
 	baseDenom, err := p.getBaseDenomFromIBCVoucher(ctx, p.tokenPair.Denom)
 	if err != nil {
-		return nil, ConvertErrToERC20Error(err)
+		return "", ConvertErrToERC20Error(err)
 	}
 
-	name := strings.ToUpper(string(baseDenom[1])) + baseDenom[2:]
-	return method.Outputs.Pack(name)
+	return strings.ToUpper(string(baseDenom[1])) + baseDenom[2:], nil
 }
 
 // Symbol returns the symbol of the token. If the token metadata is registered in the
@@ -140,6 +140,54 @@ func ParseBalanceOfArgs(args []interface{}) (common.Address, error) {
 	return account, nil
 }
 
+// ParsePermitArgs parses the arguments from the permit method and returns the
+// owner and spender addresses, the approved value, the deadline and the
+// signature values.
+func ParsePermitArgs(args []interface{}) (
+	owner, spender common.Address, value, deadline *big.Int, v uint8, r, s [32]byte, err error,
+) {
+	if len(args) != 7 {
+		return common.Address{}, common.Address{}, nil, nil, 0, r, s, fmt.Errorf("invalid number of arguments; expected 7; got: %d", len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, 0, r, s, fmt.Errorf("invalid owner address: %v", args[0])
+	}
+
+	spender, ok = args[1].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, 0, r, s, fmt.Errorf("invalid spender address: %v", args[1])
+	}
+
+	value, ok = args[2].(*big.Int)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, 0, r, s, fmt.Errorf("invalid value: %v", args[2])
+	}
+
+	deadline, ok = args[3].(*big.Int)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, 0, r, s, fmt.Errorf("invalid deadline: %v", args[3])
+	}
+
+	v, ok = args[4].(uint8)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, 0, r, s, fmt.Errorf("invalid signature v value: %v", args[4])
+	}
+
+	r, ok = args[5].([32]byte)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, 0, r, s, fmt.Errorf("invalid signature r value: %v", args[5])
+	}
+
+	s, ok = args[6].([32]byte)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, nil, 0, r, s, fmt.Errorf("invalid signature s value: %v", args[6])
+	}
+
+	return owner, spender, value, deadline, v, r, s, nil
+}
+
 // updateOrAddCoin replaces the coin of the given denomination in the coins slice or adds it if it
 // does not exist yet.
 //
@@ -43,6 +43,22 @@ interface ICS20I is IICS20Authorization {
         string memo
     );
 
+    /// @dev Emitted right after the IBCTransfer event with the timeouts of the IBC packet.
+    /// @param sender The address of the sender.
+    /// @param receiver The address of the receiver.
+    /// @param sourcePort The source port of the IBC transaction.
+    /// @param sourceChannel The source channel of the IBC transaction.
+    /// @param timeoutHeight The timeout height of the IBC packet.
+    /// @param timeoutTimestamp The timeout timestamp of the IBC packet.
+    event IBCTransferTimeout(
+        address indexed sender,
+        string indexed receiver,
+        string sourcePort,
+        string sourceChannel,
+        Height timeoutHeight,
+        uint64 timeoutTimestamp
+    );
+
     /// @dev Transfer defines a method for performing an IBC transfer.
     /// @param sourcePort the port on which the packet will be sent
     /// @param sourceChannel the channel by which the packet will be sent
@@ -68,6 +84,31 @@ interface ICS20I is IICS20Authorization {
         string memory memo
     ) external returns (uint64 nextSequence);
 
+    /// @dev BatchTransfer defines a method for performing several IBC transfers in a
+    /// single transaction. Each token is sent in its own packet with the same timeout
+    /// parameters, and the transaction reverts if any of the packets fails to be sent.
+    /// @param sourcePort the port on which the packets will be sent
+    /// @param sourceChannel the channel by which the packets will be sent
+    /// @param tokens the Coins to be transferred to the receiver
+    /// @param sender the hex address of the sender
+    /// @param receiver the bech32 address of the receiver
+    /// @param timeoutHeight the timeout height relative to the current block height.
+    /// The timeout is disabled when set to 0
+    /// @param timeoutTimestamp the timeout timestamp in absolute nanoseconds since unix epoch.
+    /// The timeout is disabled when set to 0
+    /// @param memo optional memo
+    /// @return nextSequences sequence numbers of the transfer packets sent
+    function batchTransfer(
+        string memory sourcePort,
+        string memory sourceChannel,
+        Coin[] memory tokens,
+        address sender,
+        string memory receiver,
+        Height memory timeoutHeight,
+        uint64 timeoutTimestamp,
+        string memory memo
+    ) external returns (uint64[] memory nextSequences);
+
     /// @dev DenomTraces Defines a method for returning all denom traces.
     /// @param pageRequest Defines the pagination parameters to for the request.
     function denomTraces(
@@ -90,4 +131,38 @@ interface ICS20I is IICS20Authorization {
         string memory trace
     ) external view returns (string memory hash);
 
+    /// @dev PacketCommitment defines a method for returning the commitment of a sent packet.
+    /// The commitment is deleted once the packet is acknowledged or timed out.
+    /// @param portId the port identifier of the packet
+    /// @param channelId the channel identifier of the packet
+    /// @param sequence the sequence number of the packet
+    /// @return commitment the packet commitment bytes, empty if the packet is not pending
+    function packetCommitment(
+        string memory portId,
+        string memory channelId,
+        uint64 sequence
+    ) external view returns (bytes memory commitment);
+
+    /// @dev Channel defines a method for returning the state of a channel. Contracts can
+    /// use it to refuse transfers over channels that are not open.
+    /// @param portId the port identifier of the channel
+    /// @param channelId the channel identifier
+    /// @return state the channel state (0: UNINITIALIZED, 1: INIT, 2: TRYOPEN, 3: OPEN, 4: CLOSED),
+    /// UNINITIALIZED if the channel does not exist
+    /// @return ordering the channel ordering (0: NONE, 1: UNORDERED, 2: ORDERED)
+    /// @return counterpartyChannelId the channel identifier on the counterparty chain
+    /// @return connectionHops the connection identifiers the channel runs on
+    function channel(
+        string memory portId,
+        string memory channelId
+    )
+        external
+        view
+        returns (
+            uint8 state,
+            uint8 ordering,
+            string memory counterpartyChannelId,
+            string[] memory connectionHops
+        );
+
 }
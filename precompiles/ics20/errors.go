@@ -14,8 +14,12 @@ const (
 	ErrInvalidReceiver = "invalid receiver: %s"
 	// ErrInvalidTimeoutTimestamp is raised when the timeout timestamp is invalid.
 	ErrInvalidTimeoutTimestamp = "invalid timeout timestamp: %d"
+	// ErrZeroTimeout is raised when both the timeout height and timeout timestamp are zero.
+	ErrZeroTimeout = "timeout height and timeout timestamp cannot both be zero"
 	// ErrInvalidMemo is raised when the memo is invalid.
 	ErrInvalidMemo = "invalid memo: %s"
+	// ErrInvalidSequence is raised when the packet sequence is invalid.
+	ErrInvalidSequence = "invalid packet sequence: %v"
 	// ErrInvalidHash is raised when the hash is invalid.
 	ErrInvalidHash = "invalid hash: %s"
 	// ErrNoMatchingAllocation is raised when no matching allocation is found.
@@ -24,4 +28,8 @@ const (
 	ErrDifferentOriginFromSender = "origin address %s is not the same as sender address %s"
 	// ErrTraceNotFound is raised when the denom trace for the specified request does not exist.
 	ErrTraceNotFound = "denomination trace not found"
+	// ErrInvalidTokens is raised when the tokens of a batch transfer are invalid.
+	ErrInvalidTokens = "invalid tokens: %v"
+	// ErrEmptyTokens is raised when a batch transfer has no tokens to transfer.
+	ErrEmptyTokens = "tokens cannot be empty"
 )
@@ -5,6 +5,7 @@ package ics20
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -15,6 +16,9 @@ import (
 const (
 	// EventTypeIBCTransfer defines the event type for the ICS20 Transfer transaction.
 	EventTypeIBCTransfer = "IBCTransfer"
+	// EventTypeIBCTransferTimeout defines the event type emitted along with the
+	// IBCTransfer event, with the timeouts of the IBC packet.
+	EventTypeIBCTransferTimeout = "IBCTransferTimeout"
 )
 
 // EmitIBCTransferEvent creates a new IBC transfer event emitted on a Transfer transaction.
@@ -61,3 +65,49 @@ func EmitIBCTransferEvent(
 
 	return nil
 }
+
+// EmitIBCTransferTimeoutEvent creates a new IBC transfer timeout event, which is
+// emitted right after the IBC transfer event with the timeouts of the packet.
+func EmitIBCTransferTimeoutEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	event abi.Event,
+	precompileAddr, senderAddr common.Address,
+	receiver string,
+	sourcePort, sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) error {
+	// Prepare the event topics
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	// sender and receiver are indexed
+	topics[1], err = cmn.MakeTopic(senderAddr)
+	if err != nil {
+		return err
+	}
+	topics[2], err = cmn.MakeTopic(receiver)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data: sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3], event.Inputs[4], event.Inputs[5]}
+	packed, err := arguments.Pack(sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     precompileAddr,
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
@@ -61,6 +61,22 @@ func (s *PrecompileTestSuite) TestTransferEvent() {
 				s.Require().Equal(big.NewInt(1e18), ibcTransferEvent.Amount)
 				s.Require().Equal(utils.BaseDenom, ibcTransferEvent.Denom)
 				s.Require().Equal("memo", ibcTransferEvent.Memo)
+
+				// the timeouts are emitted in a separate event, right after the transfer event
+				timeoutLog := s.stateDB.Logs()[1]
+				s.Require().Equal(timeoutLog.Address, s.precompile.Address())
+				timeoutEvent := s.precompile.ABI.Events[ics20.EventTypeIBCTransferTimeout]
+				s.Require().Equal(timeoutEvent.ID, common.HexToHash(timeoutLog.Topics[0].Hex()))
+
+				var ibcTransferTimeoutEvent ics20.EventIBCTransferTimeout
+				err = cmn.UnpackLog(s.precompile.ABI, &ibcTransferTimeoutEvent, ics20.EventTypeIBCTransferTimeout, *timeoutLog)
+				s.Require().NoError(err)
+				s.Require().Equal(common.BytesToAddress(sender.Bytes()), ibcTransferTimeoutEvent.Sender)
+				s.Require().Equal(crypto.Keccak256Hash([]byte(receiver.String())), ibcTransferTimeoutEvent.Receiver)
+				s.Require().Equal("transfer", ibcTransferTimeoutEvent.SourcePort)
+				s.Require().Equal("channel-0", ibcTransferTimeoutEvent.SourceChannel)
+				s.Require().Equal(s.chainB.GetTimeoutHeight(), ibcTransferTimeoutEvent.TimeoutHeight)
+				s.Require().Equal(uint64(0), ibcTransferTimeoutEvent.TimeoutTimestamp)
 			},
 		},
 	}
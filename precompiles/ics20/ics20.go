@@ -56,6 +56,7 @@ func NewPrecompile(
 			KvGasConfig:          storetypes.KVGasConfig(),
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
+			NonReentrantMethods:  NonReentrantMethods,
 		},
 		transferKeeper: transferKeeper,
 		channelKeeper:  channelKeeper,
@@ -92,6 +93,13 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
+	// guard the state-changing methods against reentrant calls to the precompile
+	release, err := p.EnterNonReentrant(ctx, stateDB, p.Address(), method.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	switch method.Name {
 	// TODO Approval transactions => need cosmos-sdk v0.46 & ibc-go v6.2.0
 	// Authorization Methods:
@@ -106,6 +114,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// ICS20 transactions
 	case TransferMethod:
 		bz, err = p.Transfer(ctx, evm.Origin, contract, stateDB, method, args)
+	case BatchTransferMethod:
+		bz, err = p.BatchTransfer(ctx, evm.Origin, contract, stateDB, method, args)
 	// ICS20 queries
 	case DenomTraceMethod:
 		bz, err = p.DenomTrace(ctx, contract, method, args)
@@ -113,6 +123,10 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.DenomTraces(ctx, contract, method, args)
 	case DenomHashMethod:
 		bz, err = p.DenomHash(ctx, contract, method, args)
+	case PacketCommitmentMethod:
+		bz, err = p.PacketCommitment(ctx, contract, method, args)
+	case ChannelMethod:
+		bz, err = p.Channel(ctx, contract, method, args)
 	case authorization.AllowanceMethod:
 		bz, err = p.Allowance(ctx, method, args)
 	default:
@@ -120,7 +134,7 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	}
 
 	if err != nil {
-		return nil, err
+		return cmn.HandleMethodError(ctx, contract, initialGas, "ics20", method.Name, err)
 	}
 
 	cost := ctx.GasMeter().GasConsumed() - initialGas
@@ -136,6 +150,7 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //
 // Available ics20 transactions are:
 //   - Transfer
+//   - BatchTransfer
 //
 // Available authorization transactions are:
 //   - Approve
@@ -145,6 +160,7 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 func (Precompile) IsTransaction(method string) bool {
 	switch method {
 	case TransferMethod,
+		BatchTransferMethod,
 		authorization.ApproveMethod,
 		authorization.RevokeMethod,
 		authorization.IncreaseAllowanceMethod,
@@ -407,7 +407,7 @@ var _ = Describe("IBCTransfer Precompile", func() {
 			It("owner should transfer without authorization", func() {
 				initialBalance := s.app.BankKeeper.GetBalance(s.chainA.GetContext(), s.address.Bytes(), s.bondDenom)
 
-				logCheckArgs := passCheck.WithExpEvents(ics20.EventTypeIBCTransfer)
+				logCheckArgs := passCheck.WithExpEvents(ics20.EventTypeIBCTransfer, ics20.EventTypeIBCTransferTimeout)
 
 				res, _, err := contracts.CallContractAndCheckLogs(s.chainA.GetContext(), s.app, defaultTransferArgs, logCheckArgs)
 				Expect(err).To(BeNil(), "error while calling the smart contract: %v", err)
@@ -421,7 +421,7 @@ var _ = Describe("IBCTransfer Precompile", func() {
 			It("should succeed in transfer transaction but should timeout and refund sender", func() {
 				initialBalance := s.app.BankKeeper.GetBalance(s.chainA.GetContext(), s.address.Bytes(), s.bondDenom)
 
-				logCheckArgs := passCheck.WithExpEvents(ics20.EventTypeIBCTransfer)
+				logCheckArgs := passCheck.WithExpEvents(ics20.EventTypeIBCTransfer, ics20.EventTypeIBCTransferTimeout)
 				timeoutHeight := clienttypes.NewHeight(clienttypes.ParseChainID(s.chainB.ChainID), uint64(s.chainB.GetContext().BlockHeight())+1)
 
 				transferArgs := defaultTransferArgs.WithArgs(
@@ -629,7 +629,7 @@ var _ = Describe("IBCTransfer Precompile", func() {
 				It("should transfer registered ERC20s", func() {
 					preBalance := s.app.BankKeeper.GetBalance(s.chainA.GetContext(), s.address.Bytes(), s.bondDenom)
 
-					logCheckArgs := passCheck.WithExpEvents(ics20.EventTypeIBCTransfer)
+					logCheckArgs := passCheck.WithExpEvents(ics20.EventTypeIBCTransfer, ics20.EventTypeIBCTransferTimeout)
 
 					res, ethRes, err := contracts.CallContractAndCheckLogs(s.chainA.GetContext(), s.app, defaultErc20TransferArgs, logCheckArgs)
 					Expect(err).To(BeNil(), "error while calling the smart contract: %v", err)
@@ -723,7 +723,7 @@ var _ = Describe("IBCTransfer Precompile", func() {
 						"memo",
 					)
 
-					logCheckArgs := passCheck.WithExpEvents(ics20.EventTypeIBCTransfer)
+					logCheckArgs := passCheck.WithExpEvents(ics20.EventTypeIBCTransfer, ics20.EventTypeIBCTransferTimeout)
 
 					res, ethRes, err := contracts.CallContractAndCheckLogs(s.chainA.GetContext(), s.app, transferArgs, logCheckArgs)
 					Expect(err).To(BeNil(), "error while calling the smart contract: %v", err)
@@ -787,7 +787,7 @@ var _ = Describe("IBCTransfer Precompile", func() {
 				It("should succeed in transfer transaction but should timeout", func() {
 					preBalance := s.app.BankKeeper.GetBalance(s.chainA.GetContext(), s.address.Bytes(), s.bondDenom)
 
-					logCheckArgs := passCheck.WithExpEvents(ics20.EventTypeIBCTransfer)
+					logCheckArgs := passCheck.WithExpEvents(ics20.EventTypeIBCTransfer, ics20.EventTypeIBCTransferTimeout)
 
 					timeoutHeight := clienttypes.NewHeight(clienttypes.ParseChainID(s.chainB.ChainID), uint64(s.chainB.GetContext().BlockHeight())+1)
 
@@ -1231,7 +1231,7 @@ var _ = Describe("Calling ICS20 precompile from another contract", func() {
 						"memo",
 					)
 
-					logCheckArgs := passCheck.WithExpEvents(ics20.EventTypeIBCTransfer)
+					logCheckArgs := passCheck.WithExpEvents(ics20.EventTypeIBCTransfer, ics20.EventTypeIBCTransferTimeout)
 
 					res, ethRes, err := contracts.CallContractAndCheckLogs(s.chainA.GetContext(), s.app, transferArgs, logCheckArgs)
 					Expect(err).To(BeNil(), "error while calling the smart contract: %v", err)
@@ -1332,7 +1332,7 @@ var _ = Describe("Calling ICS20 precompile from another contract", func() {
 				It("should transfer IBC coin", func() {
 					initialEvmosBalance := s.app.BankKeeper.GetBalance(s.chainA.GetContext(), s.address.Bytes(), s.bondDenom)
 
-					logCheckArgs := passCheck.WithExpEvents(ics20.EventTypeIBCTransfer)
+					logCheckArgs := passCheck.WithExpEvents(ics20.EventTypeIBCTransfer, ics20.EventTypeIBCTransferTimeout)
 
 					res, ethRes, err := contracts.CallContractAndCheckLogs(s.chainA.GetContext(), s.app, defaultTransferIbcCoinArgs, logCheckArgs)
 					Expect(err).To(BeNil(), "error while calling the smart contract: %v", err)
@@ -1459,7 +1459,7 @@ var _ = Describe("Calling ICS20 precompile from another contract", func() {
 				It("should transfer registered ERC-20 token", func() {
 					initialBalance := s.app.BankKeeper.GetBalance(s.chainA.GetContext(), s.address.Bytes(), s.bondDenom)
 
-					logCheckArgs := passCheck.WithExpEvents(ics20.EventTypeIBCTransfer)
+					logCheckArgs := passCheck.WithExpEvents(ics20.EventTypeIBCTransfer, ics20.EventTypeIBCTransferTimeout)
 
 					res, ethRes, err := contracts.CallContractAndCheckLogs(s.chainA.GetContext(), s.app, defaultTransferERC20Args, logCheckArgs)
 					Expect(err).To(BeNil(), "error while calling the smart contract: %v", err)
@@ -1545,7 +1545,7 @@ var _ = Describe("Calling ICS20 precompile from another contract", func() {
 				It("should transfer funds", func() {
 					initialSignerBalance := s.app.BankKeeper.GetBalance(s.chainA.GetContext(), s.address.Bytes(), s.bondDenom)
 
-					logCheckArgs := passCheck.WithExpEvents(ics20.EventTypeIBCTransfer)
+					logCheckArgs := passCheck.WithExpEvents(ics20.EventTypeIBCTransfer, ics20.EventTypeIBCTransferTimeout)
 
 					res, ethRes, err := contracts.CallContractAndCheckLogs(s.chainA.GetContext(), s.app, defaultTransferEvmosArgs, logCheckArgs)
 					Expect(err).To(BeNil(), "error while calling the smart contract: %v", err)
@@ -13,6 +13,8 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/evmos/evmos/v16/precompiles/authorization"
 	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -25,6 +27,12 @@ const (
 	// DenomHashMethod defines the ABI method name for the ICS20 DenomHash
 	// query.
 	DenomHashMethod = "denomHash"
+	// PacketCommitmentMethod defines the ABI method name for the ICS20 PacketCommitment
+	// query.
+	PacketCommitmentMethod = "packetCommitment"
+	// ChannelMethod defines the ABI method name for the ICS20 Channel
+	// query.
+	ChannelMethod = "channel"
 )
 
 // DenomTrace returns the requested denomination trace information.
@@ -95,6 +103,64 @@ func (p Precompile) DenomHash(
 	return method.Outputs.Pack(res.Hash)
 }
 
+// PacketCommitment returns the commitment of the packet sent on the given port and
+// channel with the given sequence. The commitment is empty if the packet was never
+// sent or if it was already acknowledged or timed out.
+func (p Precompile) PacketCommitment(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewPacketCommitmentRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.channelKeeper.PacketCommitment(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		// if the commitment does not exist, return empty bytes
+		if status.Code(err) == codes.NotFound {
+			return method.Outputs.Pack([]byte{})
+		}
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Commitment)
+}
+
+// Channel returns the state, ordering, counterparty channel identifier and connection
+// hops of the channel with the given port and channel identifiers. The state is
+// UNINITIALIZED (0) and the other values are empty if the channel does not exist.
+func (p Precompile) Channel(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewChannelRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.channelKeeper.Channel(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		// if the channel does not exist, return empty values
+		if status.Code(err) == codes.NotFound {
+			return method.Outputs.Pack(uint8(0), uint8(0), "", []string{})
+		}
+		return nil, err
+	}
+
+	channel := res.Channel
+	return method.Outputs.Pack(
+		uint8(channel.State),
+		uint8(channel.Ordering),
+		channel.Counterparty.ChannelId,
+		channel.ConnectionHops,
+	)
+}
+
 // Allowance returns the remaining allowance of for a combination of grantee - granter.
 // The grantee is the smart contract that was authorized by the granter to spend.
 func (p Precompile) Allowance(
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 	"github.com/evmos/evmos/v16/precompiles/authorization"
 	cmn "github.com/evmos/evmos/v16/precompiles/common"
@@ -238,6 +239,171 @@ func (s *PrecompileTestSuite) TestDenomHash() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestPacketCommitment() {
+	commitment := []byte("commitment")
+	method := s.precompile.Methods[ics20.PacketCommitmentMethod]
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(data []byte, inputArgs []interface{})
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} { return []interface{}{"transfer", "channel-0"} },
+			func([]byte, []interface{}) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 2),
+		},
+		{
+			"fail - invalid sequence",
+			func() []interface{} { return []interface{}{"transfer", "channel-0", "1"} },
+			func([]byte, []interface{}) {},
+			200000,
+			true,
+			fmt.Sprintf(ics20.ErrInvalidSequence, "1"),
+		},
+		{
+			"success - commitment not found, returns empty bytes",
+			func() []interface{} { return []interface{}{"transfer", "channel-0", uint64(1)} },
+			func(data []byte, _ []interface{}) {
+				var out []byte
+				err := s.precompile.UnpackIntoInterface(&out, ics20.PacketCommitmentMethod, data)
+				s.Require().NoError(err, "failed to unpack output", err)
+				s.Require().Empty(out)
+			},
+			200000,
+			false,
+			"",
+		},
+		{
+			"success - get the commitment of a pending packet",
+			func() []interface{} {
+				s.app.IBCKeeper.ChannelKeeper.SetPacketCommitment(s.ctx, "transfer", "channel-0", 1, commitment)
+				return []interface{}{"transfer", "channel-0", uint64(1)}
+			},
+			func(data []byte, _ []interface{}) {
+				var out []byte
+				err := s.precompile.UnpackIntoInterface(&out, ics20.PacketCommitmentMethod, data)
+				s.Require().NoError(err, "failed to unpack output", err)
+				s.Require().Equal(commitment, out)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			contract := s.NewPrecompileContract(tc.gas)
+			args := tc.malleate()
+
+			bz, err := s.precompile.PacketCommitment(s.ctx, contract, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz, args)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestChannel() {
+	method := s.precompile.Methods[ics20.ChannelMethod]
+
+	type channelOutput struct {
+		State                 uint8
+		Ordering              uint8
+		CounterpartyChannelId string //nolint:revive,stylecheck // matches the ABI output name
+		ConnectionHops        []string
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(data []byte)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} { return []interface{}{"transfer"} },
+			func([]byte) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1),
+		},
+		{
+			"success - channel not found, returns uninitialized state",
+			func() []interface{} { return []interface{}{"transfer", "channel-10"} },
+			func(data []byte) {
+				var out channelOutput
+				err := s.precompile.UnpackIntoInterface(&out, ics20.ChannelMethod, data)
+				s.Require().NoError(err, "failed to unpack output", err)
+				s.Require().Equal(uint8(channeltypes.UNINITIALIZED), out.State)
+				s.Require().Empty(out.CounterpartyChannelId)
+				s.Require().Empty(out.ConnectionHops)
+			},
+			200000,
+			false,
+			"",
+		},
+		{
+			"success - get an open channel",
+			func() []interface{} {
+				channel := channeltypes.NewChannel(
+					channeltypes.OPEN,
+					channeltypes.UNORDERED,
+					channeltypes.NewCounterparty("transfer", "channel-7"),
+					[]string{"connection-3"},
+					types.Version,
+				)
+				s.app.IBCKeeper.ChannelKeeper.SetChannel(s.ctx, "transfer", "channel-10", channel)
+				return []interface{}{"transfer", "channel-10"}
+			},
+			func(data []byte) {
+				var out channelOutput
+				err := s.precompile.UnpackIntoInterface(&out, ics20.ChannelMethod, data)
+				s.Require().NoError(err, "failed to unpack output", err)
+				s.Require().Equal(uint8(channeltypes.OPEN), out.State)
+				s.Require().Equal(uint8(channeltypes.UNORDERED), out.Ordering)
+				s.Require().Equal("channel-7", out.CounterpartyChannelId)
+				s.Require().Equal([]string{"connection-3"}, out.ConnectionHops)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			contract := s.NewPrecompileContract(tc.gas)
+			args := tc.malleate()
+
+			bz, err := s.precompile.Channel(s.ctx, contract, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
 func (s *PrecompileTestSuite) TestAllowance() {
 	var (
 		path   = NewTransferPath(s.chainA, s.chainB)
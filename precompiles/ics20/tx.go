@@ -6,18 +6,30 @@ package ics20
 import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
 )
 
 const (
 	// TransferMethod defines the ABI method name for the ICS20 Transfer
 	// transaction.
 	TransferMethod = "transfer"
+	// BatchTransferMethod defines the ABI method name for the ICS20 BatchTransfer
+	// transaction.
+	BatchTransferMethod = "batchTransfer"
 )
 
+// NonReentrantMethods defines the ICS20 transactions that are guarded
+// against reentrant calls to the precompile.
+var NonReentrantMethods = map[string]bool{
+	TransferMethod:      true,
+	BatchTransferMethod: true,
+}
+
 // Transfer implements the ICS20 transfer transactions.
 func (p Precompile) Transfer(
 	ctx sdk.Context,
@@ -32,35 +44,84 @@ func (p Precompile) Transfer(
 		return nil, err
 	}
 
+	sequence, err := p.transfer(ctx, origin, contract, stateDB, method, msg, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(sequence)
+}
+
+// BatchTransfer implements the ICS20 batch transfer transactions. Each token is sent
+// in its own packet, and either all the packets are sent or none of them.
+func (p Precompile) BatchTransfer(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msgs, sender, err := NewMsgBatchTransfer(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// send the packets on a cache context so that a failed transfer discards the previous ones
+	cacheCtx, writeCache := ctx.CacheContext()
+	sequences := make([]uint64, 0, len(msgs))
+	for _, msg := range msgs {
+		sequence, err := p.transfer(cacheCtx, origin, contract, stateDB, method, msg, sender)
+		if err != nil {
+			return nil, err
+		}
+		sequences = append(sequences, sequence)
+	}
+	writeCache()
+
+	return method.Outputs.Pack(sequences)
+}
+
+// transfer sends the given transfer message, checking and updating the authorization
+// of the contract caller if needed, and returns the sequence of the packet sent.
+func (p Precompile) transfer(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *transfertypes.MsgTransfer,
+	sender common.Address,
+) (uint64, error) {
 	// check if channel exists and is open
 	if !p.channelKeeper.HasChannel(ctx, msg.SourcePort, msg.SourceChannel) {
-		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.SourcePort, msg.SourceChannel)
+		return 0, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.SourcePort, msg.SourceChannel)
 	}
 
 	// The provided sender address should always be equal to the origin address.
 	// In case the contract caller address is the same as the sender address provided,
-	// update the sender address to be equal to the origin address.
-	// Otherwise, if the provided delegator address is different from the origin address,
-	// return an error because is a forbidden operation
-	sender, err = CheckOriginAndSender(contract, origin, sender)
+	// the caller acts on behalf of the origin. Calls that are not made by the origin are
+	// delegated and require an authorization grant from the origin.
+	call, err := cmn.GetAuthorizedCall(origin, contract.CallerAddress, sender, "sender")
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
+	sender = call.Account
 
 	// no need to have authorization when the contract caller is the same as origin (owner of funds)
 	// and the sender is the origin
 	resp, expiration, err := CheckAndAcceptAuthorizationIfNeeded(ctx, contract, origin, p.AuthzKeeper, msg)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	res, err := p.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	if err := UpdateGrantIfNeeded(ctx, contract, p.AuthzKeeper, origin, expiration, resp); err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	if err = EmitIBCTransferEvent(
@@ -75,8 +136,23 @@ func (p Precompile) Transfer(
 		msg.Token,
 		msg.Memo,
 	); err != nil {
-		return nil, err
+		return 0, err
+	}
+
+	if err = EmitIBCTransferTimeoutEvent(
+		ctx,
+		stateDB,
+		p.ABI.Events[EventTypeIBCTransferTimeout],
+		p.Address(),
+		sender,
+		msg.Receiver,
+		msg.SourcePort,
+		msg.SourceChannel,
+		msg.TimeoutHeight,
+		msg.TimeoutTimestamp,
+	); err != nil {
+		return 0, err
 	}
 
-	return method.Outputs.Pack(res.Sequence)
+	return res.Sequence, nil
 }
@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -88,6 +89,29 @@ func (s *PrecompileTestSuite) TestTransfer() {
 			true,
 			channeltypes.ErrChannelNotFound.Error(),
 		},
+		{
+			"fail - zero timeout height and timestamp",
+			func(sdk.AccAddress, sdk.AccAddress) []interface{} {
+				path := NewTransferPath(s.chainA, s.chainB)
+				s.coordinator.Setup(path)
+				return []interface{}{
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					utils.BaseDenom,
+					big.NewInt(1e18),
+					common.BytesToAddress(s.chainA.SenderAccount.GetAddress().Bytes()),
+					s.chainB.SenderAccount.GetAddress().String(),
+					clienttypes.ZeroHeight(),
+					uint64(0),
+					"memo",
+				}
+			},
+			func(sdk.AccAddress, sdk.AccAddress, []byte, []interface{}) {
+			},
+			200000,
+			true,
+			ics20.ErrZeroTimeout,
+		},
 		{
 			"fail - non authorized denom",
 			func(sender, _ sdk.AccAddress) []interface{} {
@@ -358,3 +382,164 @@ func (s *PrecompileTestSuite) TestTransfer() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestBatchTransfer() {
+	callingContractAddr := differentAddress
+	method := s.precompile.Methods[ics20.BatchTransferMethod]
+	twoTokens := []cmn.Coin{
+		{Denom: utils.BaseDenom, Amount: big.NewInt(1e18)},
+		{Denom: utils.BaseDenom, Amount: big.NewInt(1e18)},
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func(sender, receiver sdk.AccAddress) []interface{}
+		postCheck   func(sender, receiver sdk.AccAddress, data []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty args",
+			func(sdk.AccAddress, sdk.AccAddress) []interface{} {
+				return []interface{}{}
+			},
+			nil,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 8, 0),
+		},
+		{
+			"fail - empty tokens",
+			func(sender, receiver sdk.AccAddress) []interface{} {
+				path := NewTransferPath(s.chainA, s.chainB)
+				s.coordinator.Setup(path)
+				return []interface{}{
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					[]cmn.Coin{},
+					common.BytesToAddress(sender.Bytes()),
+					receiver.String(),
+					s.chainB.GetTimeoutHeight(),
+					uint64(0),
+					"memo",
+				}
+			},
+			nil,
+			true,
+			ics20.ErrEmptyTokens,
+		},
+		{
+			"fail - channel does not exist",
+			func(sender, receiver sdk.AccAddress) []interface{} {
+				return []interface{}{
+					"port",
+					"channel-01",
+					twoTokens,
+					common.BytesToAddress(sender.Bytes()),
+					receiver.String(),
+					s.chainB.GetTimeoutHeight(),
+					uint64(0),
+					"memo",
+				}
+			},
+			nil,
+			true,
+			channeltypes.ErrChannelNotFound.Error(),
+		},
+		{
+			"fail - allowance only covers the first transfer, no packet is sent",
+			func(sender, receiver sdk.AccAddress) []interface{} {
+				path := NewTransferPath(s.chainA, s.chainB)
+				s.coordinator.Setup(path)
+				err := s.NewTransferAuthorization(s.ctx, s.app, callingContractAddr, common.BytesToAddress(sender), path, defaultCoins, nil)
+				s.Require().NoError(err)
+				return []interface{}{
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					twoTokens,
+					common.BytesToAddress(sender.Bytes()),
+					receiver.String(),
+					s.chainB.GetTimeoutHeight(),
+					uint64(0),
+					"memo",
+				}
+			},
+			func(sender, _ sdk.AccAddress, _ []byte) {
+				// the first transfer spends the entire allowance, which must be restored
+				authz, _ := s.app.AuthzKeeper.GetAuthorization(s.ctx, callingContractAddr.Bytes(), sender, ics20.TransferMsgURL)
+				s.Require().NotNil(authz)
+				transferAuthz := authz.(*transfertypes.TransferAuthorization)
+				s.Require().Equal(transferAuthz.Allocations[0].SpendLimit, defaultCoins)
+
+				balance := s.app.BankKeeper.GetBalance(s.ctx, s.chainA.SenderAccount.GetAddress(), utils.BaseDenom)
+				s.Require().Equal(balance.Amount, math.NewInt(5e18))
+			},
+			true,
+			"does not exist or is expired",
+		},
+		{
+			"pass - transfer 2 Evmos from chainA to chainB in two packets",
+			func(sender, receiver sdk.AccAddress) []interface{} {
+				path := NewTransferPath(s.chainA, s.chainB)
+				s.coordinator.Setup(path)
+				err := s.NewTransferAuthorization(s.ctx, s.app, callingContractAddr, common.BytesToAddress(sender), path, maxUint256Coins, nil)
+				s.Require().NoError(err)
+				return []interface{}{
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					twoTokens,
+					common.BytesToAddress(sender.Bytes()),
+					receiver.String(),
+					s.chainB.GetTimeoutHeight(),
+					uint64(0),
+					"memo",
+				}
+			},
+			func(_, _ sdk.AccAddress, data []byte) {
+				var sequences []uint64
+				err := s.precompile.UnpackIntoInterface(&sequences, ics20.BatchTransferMethod, data)
+				s.Require().NoError(err)
+				s.Require().Equal([]uint64{1, 2}, sequences)
+
+				balance := s.app.BankKeeper.GetBalance(s.ctx, s.chainA.SenderAccount.GetAddress(), utils.BaseDenom)
+				s.Require().Equal(balance.Amount, math.NewInt(3e18))
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			sender := s.chainA.SenderAccount.GetAddress()
+			receiver := s.chainB.SenderAccount.GetAddress()
+
+			contract := vm.NewContract(vm.AccountRef(common.BytesToAddress(sender)), s.precompile, big.NewInt(0), 200000)
+			s.ctx = s.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+			args := tc.malleate(sender, receiver)
+			if len(args) == len(method.Inputs) {
+				// use the argument types produced by the ABI decoding of a call
+				input, err := method.Inputs.Pack(args...)
+				s.Require().NoError(err)
+				args, err = method.Inputs.Unpack(input)
+				s.Require().NoError(err)
+			}
+
+			// set the caller address to be another address (so we can test the authorization logic)
+			contract.CallerAddress = callingContractAddr
+			bz, err := s.precompile.BatchTransfer(s.ctx, common.BytesToAddress(sender), contract, s.stateDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+			}
+			if tc.postCheck != nil {
+				tc.postCheck(sender, receiver, bz)
+			}
+		})
+	}
+}
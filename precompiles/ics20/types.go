@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v16/precompiles/authorization"
@@ -49,6 +50,17 @@ type EventIBCTransfer struct {
 	Memo          string
 }
 
+// EventIBCTransferTimeout is the event type emitted with the timeouts of the
+// packet when a transfer is executed.
+type EventIBCTransferTimeout struct {
+	Sender           common.Address
+	Receiver         common.Hash
+	SourcePort       string
+	SourceChannel    string
+	TimeoutHeight    clienttypes.Height
+	TimeoutTimestamp uint64
+}
+
 // EventTransferAuthorization is the event type emitted when a transfer authorization is created.
 type EventTransferAuthorization struct {
 	Grantee     common.Address
@@ -165,6 +177,80 @@ func NewMsgTransfer(method *abi.Method, args []interface{}) (*transfertypes.MsgT
 	return msg, sender, nil
 }
 
+// NewMsgBatchTransfer returns a new transfer message for each of the tokens of a batch
+// transfer from the given arguments.
+func NewMsgBatchTransfer(method *abi.Method, args []interface{}) ([]*transfertypes.MsgTransfer, common.Address, error) {
+	if len(args) != 8 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 8, len(args))
+	}
+
+	sourcePort, ok := args[0].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidSourcePort)
+	}
+
+	sourceChannel, ok := args[1].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidSourceChannel)
+	}
+
+	var tokens []cmn.Coin
+	tokensArg := abi.Arguments{method.Inputs[2]}
+	if err := tokensArg.Copy(&tokens, []interface{}{args[2]}); err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidTokens, err)
+	}
+	if len(tokens) == 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrEmptyTokens)
+	}
+
+	sender, ok := args[3].(common.Address)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidSender, args[3])
+	}
+
+	receiver, ok := args[4].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidReceiver, args[4])
+	}
+
+	var input height
+	heightArg := abi.Arguments{method.Inputs[5]}
+	if err := heightArg.Copy(&input, []interface{}{args[5]}); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to TransferInput struct: %s", err)
+	}
+
+	timeoutTimestamp, ok := args[6].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidTimeoutTimestamp, args[6])
+	}
+
+	memo, ok := args[7].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMemo, args[7])
+	}
+
+	msgs := make([]*transfertypes.MsgTransfer, 0, len(tokens))
+	for _, token := range tokens {
+		if token.Amount == nil {
+			return nil, common.Address{}, errorsmod.Wrapf(transfertypes.ErrInvalidAmount, cmn.ErrInvalidAmount, token.Amount)
+		}
+
+		// Use instance to prevent errors on denom or amount
+		coin := sdk.Coin{
+			Denom:  token.Denom,
+			Amount: math.NewIntFromBigInt(token.Amount),
+		}
+
+		msg, err := CreateAndValidateMsgTransfer(sourcePort, sourceChannel, coin, sdk.AccAddress(sender.Bytes()).String(), receiver, input.TimeoutHeight, timeoutTimestamp, memo)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, sender, nil
+}
+
 // CreateAndValidateMsgTransfer creates a new MsgTransfer message and run validate basic.
 func CreateAndValidateMsgTransfer(
 	sourcePort, sourceChannel string,
@@ -173,6 +259,12 @@ func CreateAndValidateMsgTransfer(
 	timeoutTimestamp uint64,
 	memo string,
 ) (*transfertypes.MsgTransfer, error) {
+	// NOTE: a packet without timeout can never be timed out, so the funds could be
+	// locked forever if the packet is never relayed.
+	if timeoutHeight.IsZero() && timeoutTimestamp == 0 {
+		return nil, fmt.Errorf(ErrZeroTimeout)
+	}
+
 	msg := transfertypes.NewMsgTransfer(
 		sourcePort,
 		sourceChannel,
@@ -245,6 +337,60 @@ func NewDenomHashRequest(args []interface{}) (*transfertypes.QueryDenomHashReque
 	return req, nil
 }
 
+// NewPacketCommitmentRequest returns a new packet commitment request from the given arguments.
+func NewPacketCommitmentRequest(args []interface{}) (*channeltypes.QueryPacketCommitmentRequest, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	portID, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidSourcePort)
+	}
+
+	channelID, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidSourceChannel)
+	}
+
+	sequence, ok := args[2].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidSequence, args[2])
+	}
+
+	req := &channeltypes.QueryPacketCommitmentRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  sequence,
+	}
+
+	return req, nil
+}
+
+// NewChannelRequest returns a new channel request from the given arguments.
+func NewChannelRequest(args []interface{}) (*channeltypes.QueryChannelRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	portID, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidSourcePort)
+	}
+
+	channelID, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidSourceChannel)
+	}
+
+	req := &channeltypes.QueryChannelRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+
+	return req, nil
+}
+
 // checkRevokeArgs checks if the given arguments are valid for the Revoke tx.
 func checkRevokeArgs(args []interface{}) (common.Address, error) {
 	if len(args) != 1 {
@@ -375,14 +521,14 @@ func convertToAllocation(allocs []transfertypes.Allocation) []cmn.ICS20Allocatio
 	return allocations
 }
 
-// CheckOriginAndSender ensures the correct sender is being used.
+// CheckOriginAndSender ensures the correct sender is being used. Contracts are
+// allowed to call on behalf of the origin, as for the ICS20 transfer.
 func CheckOriginAndSender(contract *vm.Contract, origin common.Address, sender common.Address) (common.Address, error) {
-	if contract.CallerAddress == sender {
-		return origin, nil
-	} else if origin != sender {
-		return common.Address{}, fmt.Errorf(ErrDifferentOriginFromSender, origin.String(), sender.String())
+	call, err := cmn.GetAuthorizedCall(origin, contract.CallerAddress, sender, "sender")
+	if err != nil {
+		return common.Address{}, err
 	}
-	return sender, nil
+	return call.Account, nil
 }
 
 // CheckAndAcceptAuthorizationIfNeeded checks if authorization exists and accepts the grant.
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The IInflation contract's address.
+address constant IINFLATION_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000806;
+
+/// @dev The IInflation contract's instance.
+IInflation constant IINFLATION_CONTRACT = IInflation(IINFLATION_PRECOMPILE_ADDRESS);
+
+/// @dev ExponentialCalculation holds the factors of the exponential inflation
+/// formula. All values are fixed-point numbers scaled by 1e18.
+struct ExponentialCalculation {
+  /// a defines the initial value
+  uint256 a;
+  /// r defines the reduction factor
+  uint256 r;
+  /// c defines the parameter for long term inflation
+  uint256 c;
+  /// bondingTarget defines the target bonding ratio
+  uint256 bondingTarget;
+  /// maxVariance defines the max variance of the inflation
+  uint256 maxVariance;
+}
+
+/// @dev InflationDistribution defines the proportions of the minted coins that are
+/// distributed to each recipient. All values are fixed-point numbers scaled by 1e18.
+struct InflationDistribution {
+  /// stakingRewards defines the proportion allocated to staking rewards
+  uint256 stakingRewards;
+  /// communityPool defines the proportion allocated to the community pool
+  uint256 communityPool;
+}
+
+/// @dev Params defines the parameters of the Inflation module.
+struct Params {
+  /// mintDenom defines the denomination of the minted coins
+  string mintDenom;
+  /// exponentialCalculation defines the factors of the exponential inflation formula
+  ExponentialCalculation exponentialCalculation;
+  /// inflationDistribution defines the distribution of the minted coins
+  InflationDistribution inflationDistribution;
+  /// enableInflation defines whether inflation is enabled
+  bool enableInflation;
+}
+
+/**
+ * @author Evmos Team
+ * @title Inflation Interface
+ * @dev Interface for querying the state of the Inflation module.
+ * Decimal values are returned as fixed-point numbers scaled by 1e18,
+ * i.e. a returned value of 1e18 represents 1.0.
+ */
+interface IInflation {
+  /// @dev inflationRate defines a method for retrieving the current annual
+  /// inflation rate.
+  /// @return inflationRate the inflation rate in percent, scaled by 1e18
+  function inflationRate() external view returns (int256 inflationRate);
+
+  /// @dev epochMintProvision defines a method for retrieving the amount of coins
+  /// minted at the end of the current epoch.
+  /// @return epochMintProvision the amount in the mint denomination, scaled by 1e18
+  function epochMintProvision() external view returns (int256 epochMintProvision);
+
+  /// @dev params defines a method for retrieving the parameters of the Inflation module.
+  /// @return params the inflation parameters
+  function params() external view returns (Params memory params);
+}
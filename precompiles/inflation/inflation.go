@@ -0,0 +1,136 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package inflation
+
+import (
+	"embed"
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	inflationkeeper "github.com/evmos/evmos/v16/x/inflation/v1/keeper"
+)
+
+const (
+	// PrecompileAddress defines the inflation precompile address in Hex format
+	PrecompileAddress string = "0x0000000000000000000000000000000000000806"
+
+	// GasInflationRate defines the flat gas cost for a single inflationRate query
+	GasInflationRate = 4_000
+
+	// GasEpochMintProvision defines the flat gas cost for a single epochMintProvision query
+	GasEpochMintProvision = 3_000
+
+	// GasParams defines the flat gas cost for a single params query
+	GasParams = 2_000
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the inflation precompile
+type Precompile struct {
+	cmn.Precompile
+	inflationKeeper inflationkeeper.Keeper
+}
+
+// NewPrecompile creates a new inflation Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	inflationKeeper inflationkeeper.Keeper,
+) (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: we set an empty gas configuration to only charge the flat gas
+	// cost of each query
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  newABI,
+			KvGasConfig:          storetypes.GasConfig{},
+			TransientKVGasConfig: storetypes.GasConfig{},
+		},
+		inflationKeeper: inflationKeeper,
+	}, nil
+}
+
+// Address defines the address of the inflation compile contract.
+// address: 0x0000000000000000000000000000000000000806
+func (Precompile) Address() common.Address {
+	return common.HexToAddress(PrecompileAddress)
+}
+
+// RequiredGas returns the flat gas cost of the called inflation query.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	switch method.Name {
+	case InflationRateMethod:
+		return GasInflationRate
+	case EpochMintProvisionMethod:
+		return GasEpochMintProvision
+	case ParamsMethod:
+		return GasParams
+	}
+
+	return 0
+}
+
+// Run executes the precompiled contract inflation methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, _, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// Inflation queries
+	case InflationRateMethod:
+		bz, err = p.InflationRate(ctx, contract, method, args)
+	case EpochMintProvisionMethod:
+		bz, err = p.EpochMintProvision(ctx, contract, method, args)
+	case ParamsMethod:
+		bz, err = p.Params(ctx, contract, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return cmn.HandleMethodError(ctx, contract, initialGas, "inflation", method.Name, err)
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+// The inflation precompile only exposes queries.
+func (Precompile) IsTransaction(_ string) bool {
+	return false
+}
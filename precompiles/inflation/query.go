@@ -0,0 +1,75 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package inflation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+)
+
+const (
+	// InflationRateMethod defines the ABI method name for the inflation
+	// InflationRate query.
+	InflationRateMethod = "inflationRate"
+	// EpochMintProvisionMethod defines the ABI method name for the inflation
+	// EpochMintProvision query.
+	EpochMintProvisionMethod = "epochMintProvision"
+	// ParamsMethod defines the ABI method name for the inflation Params query.
+	ParamsMethod = "params"
+)
+
+// InflationRate returns the current annual inflation rate in percent, as a
+// fixed-point number scaled by 1e18.
+func (p Precompile) InflationRate(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	params := p.inflationKeeper.GetParams(ctx)
+	rate := p.inflationKeeper.GetInflationRate(ctx, params.MintDenom)
+
+	return method.Outputs.Pack(rate.BigInt())
+}
+
+// EpochMintProvision returns the amount of coins minted at the end of the
+// current epoch, as a fixed-point number scaled by 1e18.
+func (p Precompile) EpochMintProvision(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	provision := p.inflationKeeper.GetEpochMintProvision(ctx)
+
+	return method.Outputs.Pack(provision.BigInt())
+}
+
+// Params returns the inflation module parameters.
+func (p Precompile) Params(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	params := p.inflationKeeper.GetParams(ctx)
+
+	return method.Outputs.Pack(NewParamsFromModule(params))
+}
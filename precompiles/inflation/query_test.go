@@ -0,0 +1,80 @@
+package inflation_test
+
+import (
+	"math/big"
+
+	"github.com/evmos/evmos/v16/precompiles/inflation"
+)
+
+func (s *PrecompileTestSuite) TestInflationRate() {
+	method := s.precompile.Methods[inflation.InflationRateMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+
+	_, err := s.precompile.InflationRate(ctx, nil, &method, []interface{}{"invalid"})
+	s.Require().ErrorContains(err, "invalid number of arguments")
+
+	bz, err := s.precompile.InflationRate(ctx, nil, &method, nil)
+	s.Require().NoError(err)
+
+	params := s.network.App.InflationKeeper.GetParams(ctx)
+	expRate := s.network.App.InflationKeeper.GetInflationRate(ctx, params.MintDenom)
+
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err, "failed to unpack output")
+	s.Require().Equal(expRate.BigInt(), out[0])
+}
+
+func (s *PrecompileTestSuite) TestEpochMintProvision() {
+	method := s.precompile.Methods[inflation.EpochMintProvisionMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+
+	_, err := s.precompile.EpochMintProvision(ctx, nil, &method, []interface{}{"invalid"})
+	s.Require().ErrorContains(err, "invalid number of arguments")
+
+	bz, err := s.precompile.EpochMintProvision(ctx, nil, &method, nil)
+	s.Require().NoError(err)
+
+	expProvision := s.network.App.InflationKeeper.GetEpochMintProvision(ctx)
+
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err, "failed to unpack output")
+	s.Require().Equal(expProvision.BigInt(), out[0])
+}
+
+func (s *PrecompileTestSuite) TestParams() {
+	method := s.precompile.Methods[inflation.ParamsMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+
+	bz, err := s.precompile.Params(ctx, nil, &method, nil)
+	s.Require().NoError(err)
+
+	var out struct {
+		Params inflation.Params
+	}
+	err = method.Outputs.Copy(&out, mustUnpack(method.Outputs.Unpack(bz)))
+	s.Require().NoError(err, "failed to copy output")
+
+	params := s.network.App.InflationKeeper.GetParams(ctx)
+	s.Require().Equal(params.MintDenom, out.Params.MintDenom)
+	s.Require().Equal(params.EnableInflation, out.Params.EnableInflation)
+	s.Require().Equal(params.ExponentialCalculation.A.BigInt(), out.Params.ExponentialCalculation.A)
+	s.Require().Equal(params.ExponentialCalculation.BondingTarget.BigInt(), out.Params.ExponentialCalculation.BondingTarget)
+
+	// 1e18 represents 1.0
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+	total := new(big.Int).Add(out.Params.InflationDistribution.StakingRewards, out.Params.InflationDistribution.CommunityPool)
+	s.Require().Equal(scale, total, "expected distribution proportions to add up to 1.0")
+}
+
+func mustUnpack(out []interface{}, err error) []interface{} {
+	if err != nil {
+		panic(err)
+	}
+	return out
+}
@@ -0,0 +1,31 @@
+package inflation_test
+
+import (
+	"testing"
+
+	"github.com/evmos/evmos/v16/precompiles/inflation"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	"github.com/stretchr/testify/suite"
+)
+
+// PrecompileTestSuite is the implementation of the TestSuite interface for the
+// inflation precompile unit tests.
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network    *network.UnitTestNetwork
+	precompile *inflation.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	s.network = network.NewUnitTestNetwork()
+
+	precompile, err := inflation.NewPrecompile(s.network.App.InflationKeeper)
+	s.Require().NoError(err, "failed to create inflation precompile")
+
+	s.precompile = precompile
+}
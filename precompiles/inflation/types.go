@@ -0,0 +1,61 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package inflation
+
+import (
+	"math/big"
+
+	inflationtypes "github.com/evmos/evmos/v16/x/inflation/v1/types"
+)
+
+// NOTE: all decimal values are returned as fixed-point numbers scaled by 1e18,
+// which corresponds to the internal representation of math.LegacyDec.
+
+// ExponentialCalculation is the ABI representation of the factors of the
+// exponential inflation formula.
+type ExponentialCalculation struct {
+	A             *big.Int
+	R             *big.Int
+	C             *big.Int
+	BondingTarget *big.Int
+	MaxVariance   *big.Int
+}
+
+// InflationDistribution is the ABI representation of the inflation distribution
+// proportions.
+type InflationDistribution struct {
+	StakingRewards *big.Int
+	CommunityPool  *big.Int
+}
+
+// Params is the ABI representation of the inflation module parameters.
+type Params struct {
+	MintDenom              string
+	ExponentialCalculation ExponentialCalculation
+	InflationDistribution  InflationDistribution
+	EnableInflation        bool
+}
+
+// NewParamsFromModule converts the inflation module parameters into their ABI
+// representation.
+func NewParamsFromModule(params inflationtypes.Params) Params {
+	ec := params.ExponentialCalculation
+	id := params.InflationDistribution
+
+	return Params{
+		MintDenom: params.MintDenom,
+		ExponentialCalculation: ExponentialCalculation{
+			A:             ec.A.BigInt(),
+			R:             ec.R.BigInt(),
+			C:             ec.C.BigInt(),
+			BondingTarget: ec.BondingTarget.BigInt(),
+			MaxVariance:   ec.MaxVariance.BigInt(),
+		},
+		InflationDistribution: InflationDistribution{
+			StakingRewards: id.StakingRewards.BigInt(),
+			CommunityPool:  id.CommunityPool.BigInt(),
+		},
+		EnableInflation: params.EnableInflation,
+	}
+}
@@ -1,6 +1,8 @@
 /// SPDX-License-Identifier: LGPL-3.0-only
 pragma solidity >=0.8.18;
 
+import "../../common/Types.sol";
+
 /// @dev The Osmosis Outpost contract's address.
 address constant OSMOSIS_OUTPOST_ADDRESS = 0x0000000000000000000000000000000000000901;
 
@@ -58,6 +60,22 @@ interface IOsmosisOutpost {
         string memo
     );
 
+    /// @dev Emitted right after the IBCTransfer event with the timeouts of the IBC packet.
+    /// @param sender The address of the sender.
+    /// @param receiver The address of the receiver.
+    /// @param sourcePort The source port of the IBC transaction.
+    /// @param sourceChannel The source channel of the IBC transaction.
+    /// @param timeoutHeight The timeout height of the IBC packet.
+    /// @param timeoutTimestamp The timeout timestamp of the IBC packet.
+    event IBCTransferTimeout(
+        address indexed sender,
+        string indexed receiver,
+        string sourcePort,
+        string sourceChannel,
+        Height timeoutHeight,
+        uint64 timeoutTimestamp
+    );
+
     /// @dev Emitted when a user executes a swap.
     /// @param sender The address of the sender.
     /// @param input The ERC-20 token contract address to be swapped for.
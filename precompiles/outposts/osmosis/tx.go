@@ -184,6 +184,21 @@ func (p Precompile) Swap(
 		return nil, err
 	}
 
+	if err := ics20.EmitIBCTransferTimeoutEvent(
+		ctx,
+		stateDB,
+		p.Events[ics20.EventTypeIBCTransferTimeout],
+		p.Address(),
+		sender,
+		msg.Receiver,
+		msg.SourcePort,
+		msg.SourceChannel,
+		msg.TimeoutHeight,
+		msg.TimeoutTimestamp,
+	); err != nil {
+		return nil, err
+	}
+
 	// Emit the custom Swap Event.
 	if err := p.EmitSwapEvent(ctx, stateDB, sender, input, output, amount, swapReceiver); err != nil {
 		return nil, err
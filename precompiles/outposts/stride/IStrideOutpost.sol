@@ -52,6 +52,22 @@ interface IStrideOutpost {
         string memo
     );
 
+    /// @dev Emitted right after the IBCTransfer event with the timeouts of the IBC packet.
+    /// @param sender The address of the sender.
+    /// @param receiver The address of the receiver.
+    /// @param sourcePort The source port of the IBC transaction.
+    /// @param sourceChannel The source channel of the IBC transaction.
+    /// @param timeoutHeight The timeout height of the IBC packet.
+    /// @param timeoutTimestamp The timeout timestamp of the IBC packet.
+    event IBCTransferTimeout(
+        address indexed sender,
+        string indexed receiver,
+        string sourcePort,
+        string sourceChannel,
+        Height timeoutHeight,
+        uint64 timeoutTimestamp
+    );
+
     /// @dev Emitted on a LiquidStake transaction.
     /// @param sender The address of the sender.
     /// @param token The address of the ERC-20 token pair.
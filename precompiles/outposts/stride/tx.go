@@ -125,6 +125,21 @@ func (p Precompile) LiquidStake(
 		return nil, err
 	}
 
+	if err := ics20.EmitIBCTransferTimeoutEvent(
+		ctx,
+		stateDB,
+		p.ABI.Events[ics20.EventTypeIBCTransferTimeout],
+		p.Address(),
+		sender,
+		msg.Receiver,
+		msg.SourcePort,
+		msg.SourceChannel,
+		msg.TimeoutHeight,
+		msg.TimeoutTimestamp,
+	); err != nil {
+		return nil, err
+	}
+
 	// Emit the custom LiquidStake Event
 	if err := p.EmitLiquidStakeEvent(ctx, stateDB, sender, token, amount); err != nil {
 		return nil, err
@@ -239,6 +254,21 @@ func (p Precompile) RedeemStake(
 		return nil, err
 	}
 
+	if err := ics20.EmitIBCTransferTimeoutEvent(
+		ctx,
+		stateDB,
+		p.ABI.Events[ics20.EventTypeIBCTransferTimeout],
+		p.Address(),
+		sender,
+		msg.Receiver,
+		msg.SourcePort,
+		msg.SourceChannel,
+		msg.TimeoutHeight,
+		msg.TimeoutTimestamp,
+	); err != nil {
+		return nil, err
+	}
+
 	// Emit the custom RedeemStake Event
 	if err := p.EmitRedeemStakeEvent(ctx, stateDB, sender, token, receiver, strideForwarder, amount); err != nil {
 		return nil, err
@@ -0,0 +1,89 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package p256
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+var _ vm.PrecompiledContract = &BatchPrecompile{}
+
+// MaxBatchSize defines the maximum number of signatures that can be verified
+// in a single batch, bounded by the 256 bits of the result bitmap.
+const MaxBatchSize = 256
+
+// BatchBaseGas is the fixed gas cost of a batch verification call, charged on
+// top of VerifyGas per signature. It is the only cost of an invalid input.
+const BatchBaseGas uint64 = 1000
+
+// BatchPrecompileAddress defines the hex address of the p256 batch verification
+// precompiled contract.
+const BatchPrecompileAddress = "0x0000000000000000000000000000000000000101"
+
+// BatchPrecompile verifies several secp256r1 (P256) signatures in a single call.
+// It is kept apart from the EIP-7212 precompile so that the behaviour of the
+// latter is left unchanged.
+type BatchPrecompile struct{}
+
+// Address defines the address of the p256 batch precompiled contract.
+func (BatchPrecompile) Address() common.Address {
+	return common.HexToAddress(BatchPrecompileAddress)
+}
+
+// RequiredGas returns BatchBaseGas plus VerifyGas for each signature in the
+// batch. Inputs with an invalid length only pay BatchBaseGas, as no signature
+// is verified.
+func (p BatchPrecompile) RequiredGas(input []byte) uint64 {
+	n, ok := batchSize(input)
+	if !ok {
+		return BatchBaseGas
+	}
+
+	return BatchBaseGas + uint64(n)*VerifyGas
+}
+
+// Run executes the batch p256 signature verification using ECDSA.
+//
+// Input data: between 1 and MaxBatchSize concatenated 160 bytes entries, each
+// with the same format as the input of the EIP-7212 precompile.
+//
+// Output data: 32 bytes bitmap where the i-th least significant bit is set if
+// the i-th signature is valid. An invalid entry doesn't affect the result of
+// the others. The output is empty if the input length is invalid.
+func (p *BatchPrecompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, err error) {
+	input := contract.Input
+
+	n, ok := batchSize(input)
+	if !ok {
+		// Input length is invalid
+		return nil, nil
+	}
+
+	bitmap := new(big.Int)
+	for i := 0; i < n; i++ {
+		if verify(input[i*VerifyInputLength : (i+1)*VerifyInputLength]) {
+			bitmap.SetBit(bitmap, i, 1)
+		}
+	}
+
+	return common.LeftPadBytes(bitmap.Bytes(), 32), nil
+}
+
+// batchSize returns the number of entries of a batch verification input, and
+// false if the input is not a valid batch.
+func batchSize(input []byte) (int, bool) {
+	if len(input) == 0 || len(input)%VerifyInputLength != 0 {
+		return 0, false
+	}
+
+	n := len(input) / VerifyInputLength
+	if n > MaxBatchSize {
+		return 0, false
+	}
+
+	return n, true
+}
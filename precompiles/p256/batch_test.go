@@ -0,0 +1,117 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package p256_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v16/precompiles/p256"
+)
+
+func (s *PrecompileTestSuite) TestBatchAddress() {
+	s.Require().Equal(p256.BatchPrecompileAddress, p256.BatchPrecompile{}.Address().String())
+	s.Require().NotEqual(s.precompile.Address(), p256.BatchPrecompile{}.Address())
+}
+
+func (s *PrecompileTestSuite) TestBatchRequiredGas() {
+	batchPrecompile := &p256.BatchPrecompile{}
+	// invalid inputs only pay the base cost
+	s.Require().Equal(p256.BatchBaseGas, batchPrecompile.RequiredGas(nil))
+	s.Require().Equal(p256.BatchBaseGas, batchPrecompile.RequiredGas([]byte{}))
+	s.Require().Equal(p256.BatchBaseGas, batchPrecompile.RequiredGas(make([]byte, p256.VerifyInputLength+1)))
+	s.Require().Equal(p256.BatchBaseGas, batchPrecompile.RequiredGas(make([]byte, (p256.MaxBatchSize+1)*p256.VerifyInputLength)))
+	s.Require().Equal(p256.BatchBaseGas+p256.VerifyGas, batchPrecompile.RequiredGas(make([]byte, p256.VerifyInputLength)))
+	// each signature costs the same as verifying it separately
+	s.Require().Equal(p256.BatchBaseGas+3*s.precompile.RequiredGas(make([]byte, p256.VerifyInputLength)), batchPrecompile.RequiredGas(make([]byte, 3*p256.VerifyInputLength)))
+}
+
+func (s *PrecompileTestSuite) TestRunBatch() {
+	testCases := []struct {
+		name      string
+		input     func() []byte
+		expBitmap []byte
+	}{
+		{
+			"pass - all signatures valid",
+			func() []byte {
+				input := signMsg([]byte("hello world"), s.p256Priv)
+				input = append(input, signMsg([]byte("hello evmos"), s.p256Priv)...)
+				return append(input, signMsg([]byte("hello p256"), s.p256Priv)...)
+			},
+			common.LeftPadBytes([]byte{0b111}, 32),
+		},
+		{
+			"pass - invalid signature only flags its own entry",
+			func() []byte {
+				invalid := signMsg([]byte("hello evmos"), s.p256Priv)
+				// corrupt the signed hash
+				invalid[0] ^= 0xff
+
+				input := signMsg([]byte("hello world"), s.p256Priv)
+				input = append(input, invalid...)
+				return append(input, signMsg([]byte("hello p256"), s.p256Priv)...)
+			},
+			common.LeftPadBytes([]byte{0b101}, 32),
+		},
+		{
+			"pass - malformed public key only flags its own entry",
+			func() []byte {
+				malformed := make([]byte, p256.VerifyInputLength)
+				copy(malformed, signMsg([]byte("hello world"), s.p256Priv)[:96])
+
+				input := signMsg([]byte("hello evmos"), s.p256Priv)
+				return append(malformed, input...)
+			},
+			common.LeftPadBytes([]byte{0b10}, 32),
+		},
+		{
+			"pass - no valid signature",
+			func() []byte {
+				return make([]byte, 2*p256.VerifyInputLength)
+			},
+			make([]byte, 32),
+		},
+		{
+			"pass - single signature",
+			func() []byte {
+				return signMsg([]byte("hello world"), s.p256Priv)
+			},
+			common.LeftPadBytes([]byte{0b1}, 32),
+		},
+		{
+			"fail - empty input",
+			func() []byte {
+				return nil
+			},
+			nil,
+		},
+		{
+			"fail - input length is not a multiple of the entry length",
+			func() []byte {
+				return append(signMsg([]byte("hello world"), s.p256Priv), make([]byte, 32)...)
+			},
+			nil,
+		},
+		{
+			"fail - batch size exceeded",
+			func() []byte {
+				return make([]byte, (p256.MaxBatchSize+1)*p256.VerifyInputLength)
+			},
+			nil,
+		},
+	}
+
+	batchPrecompile := &p256.BatchPrecompile{}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			bz, err := batchPrecompile.Run(nil, &vm.Contract{Input: tc.input()}, false)
+			s.Require().NoError(err)
+			if tc.expBitmap == nil {
+				s.Require().Empty(bz)
+			} else {
+				s.Require().Equal(tc.expBitmap, bz)
+			}
+		})
+	}
+}
@@ -107,6 +107,41 @@ var _ = Describe("Calling p256 precompile directly", Label("P256 Precompile"), O
 				},
 			),
 		)
+
+		DescribeTable("execute batch contract call", func(inputFn func() (input, expOutput []byte)) {
+			senderKey := s.keyring.GetKey(0)
+			batchAddress := p256.BatchPrecompile{}.Address()
+
+			input, expOutput := inputFn()
+			args := evmtypes.EvmTxArgs{
+				To:    &batchAddress,
+				Input: input,
+			}
+
+			resDeliverTx, err := s.factory.ExecuteEthTx(senderKey.Priv, args)
+			Expect(err).To(BeNil())
+			Expect(resDeliverTx.IsOK()).To(Equal(true), "transaction should have succeeded", resDeliverTx.GetLog())
+
+			res, err := utils.DecodeResponseDeliverTx(resDeliverTx)
+			Expect(err).To(BeNil())
+			Expect(res.VmError).To(BeEmpty())
+			Expect(res.Ret).To(Equal(expOutput))
+		},
+			Entry(
+				"valid signatures",
+				func() (input, expOutput []byte) {
+					input = signMsg([]byte("hello world"), s.p256Priv)
+					input = append(input, signMsg([]byte("hello evmos"), s.p256Priv)...)
+					return input, common.LeftPadBytes([]byte{0b11}, 32)
+				},
+			),
+			Entry(
+				"empty input",
+				func() (input, expOutput []byte) {
+					return nil, nil
+				},
+			),
+		)
 	})
 
 	When("the precompile is not enabled in the EVM params", func() {
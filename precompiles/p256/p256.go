@@ -70,13 +70,8 @@ func (p *Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, e
 		return nil, nil
 	}
 
-	// Extract the hash, r, s, x, y from the input
-	hash := input[0:32]
-	r, s := new(big.Int).SetBytes(input[32:64]), new(big.Int).SetBytes(input[64:96])
-	x, y := new(big.Int).SetBytes(input[96:128]), new(big.Int).SetBytes(input[128:160])
-
 	// Verify the secp256r1 signature
-	if secp256r1.Verify(hash, r, s, x, y) {
+	if verify(input) {
 		// Signature is valid
 		return common.LeftPadBytes(common.Big1.Bytes(), 32), nil
 	}
@@ -84,3 +79,13 @@ func (p *Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, e
 	// Signature is invalid
 	return nil, nil
 }
+
+// verify verifies the secp256r1 signature of a single 160 bytes input entry.
+func verify(input []byte) bool {
+	// Extract the hash, r, s, x, y from the input
+	hash := input[0:32]
+	r, s := new(big.Int).SetBytes(input[32:64]), new(big.Int).SetBytes(input[64:96])
+	x, y := new(big.Int).SetBytes(input[96:128]), new(big.Int).SetBytes(input[128:160])
+
+	return secp256r1.Verify(hash, r, s, x, y)
+}
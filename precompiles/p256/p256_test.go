@@ -21,6 +21,7 @@ func (s *PrecompileTestSuite) TestAddress() {
 
 func (s *PrecompileTestSuite) TestRequiredGas() {
 	s.Require().Equal(p256.VerifyGas, s.precompile.RequiredGas(nil))
+	s.Require().Equal(p256.VerifyGas, s.precompile.RequiredGas(make([]byte, 3*p256.VerifyInputLength)))
 }
 
 func (s *PrecompileTestSuite) TestRun() {
@@ -111,6 +112,14 @@ func (s *PrecompileTestSuite) TestRun() {
 			},
 			false,
 		},
+		{
+			"fail - multiple signatures are not verified",
+			func() []byte {
+				input := signMsg([]byte("hello world"), s.p256Priv)
+				return append(input, signMsg([]byte("hello evmos"), s.p256Priv)...)
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -53,10 +53,10 @@ func signMsg(msg []byte, priv *ecdsa.PrivateKey) []byte {
 
 	input := make([]byte, p256.VerifyInputLength)
 	copy(input[0:32], hash)
-	copy(input[32:64], rInt.Bytes())
-	copy(input[64:96], sInt.Bytes())
-	copy(input[96:128], priv.PublicKey.X.Bytes())
-	copy(input[128:160], priv.PublicKey.Y.Bytes())
+	rInt.FillBytes(input[32:64])
+	sInt.FillBytes(input[64:96])
+	priv.PublicKey.X.FillBytes(input[96:128])
+	priv.PublicKey.Y.FillBytes(input[128:160])
 
 	return input
 }
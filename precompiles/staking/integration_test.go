@@ -2483,6 +2483,57 @@ var _ = Describe("Calling staking precompile via Solidity", func() {
 			Expect(undelegations).To(HaveLen(1), "expected one unbonding delegation")
 			Expect(undelegations[0].ValidatorAddress).To(Equal(valAddr.String()), "expected different validator address")
 		})
+
+		It("should release the reentrancy guard between the calls of a contract", func() {
+			// the contract calls the guarded approve and undelegate methods one after
+			// the other, so the second call only succeeds if the first one released
+			// the precompile lock
+			cArgs := defaultCallArgs.
+				WithMethodName("testApproveAndThenUndelegate").
+				WithGasLimit(1e8).
+				WithArgs(contractAddr, big.NewInt(1000), big.NewInt(500), valAddr.String())
+
+			logCheckArgs := passCheck.
+				WithExpEvents(authorization.EventTypeApproval, staking.EventTypeUnbond)
+
+			_, _, err := contracts.CallContractAndCheckLogs(s.ctx, s.app, cArgs, logCheckArgs)
+			Expect(err).To(BeNil(), "error while calling the smart contract: %v", err)
+
+			locked := s.app.EvmKeeper.IsPrecompileLocked(s.ctx, s.precompile.Address())
+			Expect(locked).To(BeFalse(), "expected the precompile lock to be released")
+		})
+
+		It("should reject a reentrant call from a contract", func() {
+			approveArgs := defaultApproveArgs.WithArgs(
+				contractAddr, []string{staking.UndelegateMsg}, big.NewInt(1e18),
+			)
+			s.SetupApprovalWithContractCalls(approveArgs)
+
+			// none of the guarded methods calls back into the EVM, so the lock is
+			// set by hand to mimic a contract re-entering the precompile while it
+			// is executing a guarded method
+			s.app.EvmKeeper.LockPrecompile(s.ctx, s.precompile.Address())
+
+			cArgs := defaultCallArgs.
+				WithMethodName("testUndelegate").
+				WithArgs(s.address, valAddr.String(), big.NewInt(1e18))
+
+			_, _, err := contracts.CallContractAndCheckLogs(s.ctx, s.app, cArgs, execRevertedCheck)
+			Expect(err).To(HaveOccurred(), "error while calling the smart contract: %v", err)
+
+			undelegations := s.app.StakingKeeper.GetAllUnbondingDelegations(s.ctx, s.address.Bytes())
+			Expect(undelegations).To(HaveLen(0), "expected no unbonding delegations")
+
+			// the same call succeeds once the precompile is not executing
+			s.app.EvmKeeper.UnlockPrecompile(s.ctx, s.precompile.Address())
+
+			logCheckArgs := passCheck.WithExpEvents(staking.EventTypeUnbond)
+			_, _, err = contracts.CallContractAndCheckLogs(s.ctx, s.app, cArgs, logCheckArgs)
+			Expect(err).To(BeNil(), "error while calling the smart contract: %v", err)
+
+			undelegations = s.app.StakingKeeper.GetAllUnbondingDelegations(s.ctx, s.address.Bytes())
+			Expect(undelegations).To(HaveLen(1), "expected one unbonding delegation")
+		})
 	})
 
 	Context("when using special call opcodes", func() {
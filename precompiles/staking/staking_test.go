@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/evmos/evmos/v16/app"
 	"github.com/evmos/evmos/v16/precompiles/authorization"
@@ -376,6 +377,22 @@ func (s *PrecompileTestSuite) TestRun() {
 			true,
 			"",
 		},
+		{
+			"fail - validators query runs out of gas while reading the store",
+			func() []byte {
+				input, err := s.precompile.Pack(
+					staking.ValidatorsMethod,
+					stakingtypes.Bonded.String(),
+					query.PageRequest{Limit: 100, CountTotal: true},
+				)
+				s.Require().NoError(err, "failed to pack input")
+				return input
+			},
+			2000,
+			true,
+			false,
+			"out of gas",
+		},
 		{
 			"fail - delegate method - read only",
 			func() []byte {
@@ -475,3 +492,97 @@ func (s *PrecompileTestSuite) TestRun() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestRunReentrancy() {
+	testcases := []struct {
+		name        string
+		method      string
+		locked      bool
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - contract re-enters undelegate while the precompile is executing",
+			staking.UndelegateMethod,
+			true,
+			false,
+			"reentrant call to undelegate",
+		},
+		{
+			"pass - query is not guarded while the precompile is executing",
+			staking.DelegationMethod,
+			true,
+			true,
+			"",
+		},
+		{
+			"pass - undelegate when the precompile is not executing",
+			staking.UndelegateMethod,
+			false,
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			err := s.CreateAuthorization(s.address, staking.UndelegateAuthz, nil)
+			s.Require().NoError(err)
+
+			args := []interface{}{s.address, s.validators[0].GetOperator().String()}
+			if tc.method == staking.UndelegateMethod {
+				args = append(args, big.NewInt(1))
+			}
+			input, err := s.precompile.Pack(tc.method, args...)
+			s.Require().NoError(err, "failed to pack input")
+
+			// mimic a contract call to the precompile from within one of its
+			// non-reentrant methods, e.g. through a hook that calls back into the EVM
+			if tc.locked {
+				s.app.EvmKeeper.LockPrecompile(s.ctx, s.precompile.Address())
+			}
+
+			contract := vm.NewPrecompile(vm.AccountRef(s.address), s.precompile, big.NewInt(0), 1000000)
+			contract.Input = input
+
+			baseFee := s.app.FeeMarketKeeper.GetBaseFee(s.ctx)
+			proposerAddress := s.ctx.BlockHeader().ProposerAddress
+			cfg, err := s.app.EvmKeeper.EVMConfig(s.ctx, proposerAddress, s.app.EvmKeeper.ChainID())
+			s.Require().NoError(err, "failed to instantiate EVM config")
+
+			contractAddr := contract.Address()
+			msgEthereumTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+				ChainID:   s.app.EvmKeeper.ChainID(),
+				To:        &contractAddr,
+				GasLimit:  1000000,
+				GasFeeCap: baseFee,
+				GasTipCap: big.NewInt(1),
+				Accesses:  &ethtypes.AccessList{},
+			})
+			msgEthereumTx.From = s.address.String()
+			err = msgEthereumTx.Sign(s.ethSigner, s.signer)
+			s.Require().NoError(err, "failed to sign Ethereum message")
+
+			msg, err := msgEthereumTx.AsMessage(s.ethSigner, baseFee)
+			s.Require().NoError(err, "failed to instantiate Ethereum message")
+
+			evm := s.app.EvmKeeper.NewEVM(s.ctx, msg, cfg, nil, s.stateDB)
+
+			bz, err := s.precompile.Run(evm, contract, false)
+
+			if tc.expPass {
+				s.Require().NoError(err, "expected no error when running the precompile")
+				s.Require().NotNil(bz, "expected returned bytes not to be nil")
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Nil(bz, "expected returned bytes to be nil")
+			}
+
+			// the lock is only released by the call that acquired it
+			locked := s.app.EvmKeeper.IsPrecompileLocked(s.ctx, s.precompile.Address())
+			s.Require().Equal(tc.locked, locked, "expected different precompile lock")
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/evmos/evmos/v16/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
 	"github.com/evmos/evmos/v16/x/evm/statedb"
 )
 
@@ -34,6 +35,16 @@ const (
 	CancelUnbondingDelegationMethod = "cancelUnbondingDelegation"
 )
 
+// NonReentrantMethods defines the staking transactions that are guarded
+// against reentrant calls to the precompile.
+var NonReentrantMethods = map[string]bool{
+	CreateValidatorMethod:           true,
+	DelegateMethod:                  true,
+	UndelegateMethod:                true,
+	RedelegateMethod:                true,
+	CancelUnbondingDelegationMethod: true,
+}
+
 const (
 	// DelegateAuthz defines the authorization type for the staking Delegate
 	DelegateAuthz = stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE
@@ -119,25 +130,22 @@ func (p Precompile) Delegate(
 		// expiration is the expiration time of the authorization grant
 		expiration *time.Time
 
-		// isCallerOrigin is true when the contract caller is the same as the origin
-		isCallerOrigin = contract.CallerAddress == origin
 		// isCallerDelegator is true when the contract caller is the same as the delegator
 		isCallerDelegator = contract.CallerAddress == delegatorHexAddr
 	)
 
 	// The provided delegator address should always be equal to the origin address.
 	// In case the contract caller address is the same as the delegator address provided,
-	// update the delegator address to be equal to the origin address.
-	// Otherwise, if the provided delegator address is different from the origin address,
-	// return an error because is a forbidden operation
-	if isCallerDelegator {
-		delegatorHexAddr = origin
-	} else if origin != delegatorHexAddr {
-		return nil, fmt.Errorf(ErrDifferentOriginFromDelegator, origin.String(), delegatorHexAddr.String())
+	// the caller acts on behalf of the origin. Calls that are not made by the origin are
+	// delegated and require an authorization grant from the origin.
+	call, err := cmn.GetAuthorizedCall(origin, contract.CallerAddress, delegatorHexAddr, "delegator")
+	if err != nil {
+		return nil, err
 	}
+	delegatorHexAddr = call.Account
 
 	// no need to have authorization when the contract caller is the same as origin (owner of funds)
-	if !isCallerOrigin {
+	if call.Delegated {
 		// Check if the authorization grant exists for the caller and the origin
 		stakeAuthz, expiration, err = authorization.CheckAuthzAndAllowanceForGranter(ctx, p.AuthzKeeper, contract.CallerAddress, delegatorHexAddr, &msg.Amount, DelegateMsg)
 		if err != nil {
@@ -152,7 +160,7 @@ func (p Precompile) Delegate(
 	}
 
 	// Only update the authorization if the contract caller is different from the origin
-	if !isCallerOrigin {
+	if call.Delegated {
 		if err := p.UpdateStakingAuthorization(ctx, contract.CallerAddress, delegatorHexAddr, stakeAuthz, expiration, DelegateMsg, msg); err != nil {
 			return nil, err
 		}
@@ -203,26 +211,20 @@ func (p Precompile) Undelegate(
 		stakeAuthz *stakingtypes.StakeAuthorization
 		// expiration is the expiration time of the authorization grant
 		expiration *time.Time
-
-		// isCallerOrigin is true when the contract caller is the same as the origin
-		isCallerOrigin = contract.CallerAddress == origin
-		// isCallerDelegator is true when the contract caller is the same as the delegator
-		isCallerDelegator = contract.CallerAddress == delegatorHexAddr
 	)
 
 	// The provided delegator address should always be equal to the origin address.
 	// In case the contract caller address is the same as the delegator address provided,
-	// update the delegator address to be equal to the origin address.
-	// Otherwise, if the provided delegator address is different from the origin address,
-	// return an error because is a forbidden operation
-	if isCallerDelegator {
-		delegatorHexAddr = origin
-	} else if origin != delegatorHexAddr {
-		return nil, fmt.Errorf(ErrDifferentOriginFromDelegator, origin.String(), delegatorHexAddr.String())
+	// the caller acts on behalf of the origin. Calls that are not made by the origin are
+	// delegated and require an authorization grant from the origin.
+	call, err := cmn.GetAuthorizedCall(origin, contract.CallerAddress, delegatorHexAddr, "delegator")
+	if err != nil {
+		return nil, err
 	}
+	delegatorHexAddr = call.Account
 
 	// no need to have authorization when the contract caller is the same as origin (owner of funds)
-	if !isCallerOrigin {
+	if call.Delegated {
 		// Check if the authorization grant exists for the caller and the origin
 		stakeAuthz, expiration, err = authorization.CheckAuthzAndAllowanceForGranter(ctx, p.AuthzKeeper, contract.CallerAddress, delegatorHexAddr, &msg.Amount, UndelegateMsg)
 		if err != nil {
@@ -238,7 +240,7 @@ func (p Precompile) Undelegate(
 	}
 
 	// Only update the authorization if the contract caller is different from the origin
-	if !isCallerOrigin {
+	if call.Delegated {
 		if err := p.UpdateStakingAuthorization(ctx, contract.CallerAddress, delegatorHexAddr, stakeAuthz, expiration, UndelegateMsg, msg); err != nil {
 			return nil, err
 		}
@@ -285,26 +287,20 @@ func (p Precompile) Redelegate(
 		stakeAuthz *stakingtypes.StakeAuthorization
 		// expiration is the expiration time of the authorization grant
 		expiration *time.Time
-
-		// isCallerOrigin is true when the contract caller is the same as the origin
-		isCallerOrigin = contract.CallerAddress == origin
-		// isCallerDelegator is true when the contract caller is the same as the delegator
-		isCallerDelegator = contract.CallerAddress == delegatorHexAddr
 	)
 
 	// The provided delegator address should always be equal to the origin address.
 	// In case the contract caller address is the same as the delegator address provided,
-	// update the delegator address to be equal to the origin address.
-	// Otherwise, if the provided delegator address is different from the origin address,
-	// return an error because is a forbidden operation
-	if isCallerDelegator {
-		delegatorHexAddr = origin
-	} else if origin != delegatorHexAddr {
-		return nil, fmt.Errorf(ErrDifferentOriginFromDelegator, origin.String(), delegatorHexAddr.String())
+	// the caller acts on behalf of the origin. Calls that are not made by the origin are
+	// delegated and require an authorization grant from the origin.
+	call, err := cmn.GetAuthorizedCall(origin, contract.CallerAddress, delegatorHexAddr, "delegator")
+	if err != nil {
+		return nil, err
 	}
+	delegatorHexAddr = call.Account
 
 	// no need to have authorization when the contract caller is the same as origin (owner of funds)
-	if !isCallerOrigin {
+	if call.Delegated {
 		// Check if the authorization grant exists for the caller and the origin
 		stakeAuthz, expiration, err = authorization.CheckAuthzAndAllowanceForGranter(ctx, p.AuthzKeeper, contract.CallerAddress, delegatorHexAddr, &msg.Amount, RedelegateMsg)
 		if err != nil {
@@ -319,7 +315,7 @@ func (p Precompile) Redelegate(
 	}
 
 	// Only update the authorization if the contract caller is different from the origin
-	if !isCallerOrigin {
+	if call.Delegated {
 		if err := p.UpdateStakingAuthorization(ctx, contract.CallerAddress, delegatorHexAddr, stakeAuthz, expiration, RedelegateMsg, msg); err != nil {
 			return nil, err
 		}
@@ -365,26 +361,20 @@ func (p Precompile) CancelUnbondingDelegation(
 		stakeAuthz *stakingtypes.StakeAuthorization
 		// expiration is the expiration time of the authorization grant
 		expiration *time.Time
-
-		// isCallerOrigin is true when the contract caller is the same as the origin
-		isCallerOrigin = contract.CallerAddress == origin
-		// isCallerDelegator is true when the contract caller is the same as the delegator
-		isCallerDelegator = contract.CallerAddress == delegatorHexAddr
 	)
 
 	// The provided delegator address should always be equal to the origin address.
 	// In case the contract caller address is the same as the delegator address provided,
-	// update the delegator address to be equal to the origin address.
-	// Otherwise, if the provided delegator address is different from the origin address,
-	// return an error because is a forbidden operation
-	if isCallerDelegator {
-		delegatorHexAddr = origin
-	} else if origin != delegatorHexAddr {
-		return nil, fmt.Errorf(ErrDifferentOriginFromDelegator, origin.String(), delegatorHexAddr.String())
+	// the caller acts on behalf of the origin. Calls that are not made by the origin are
+	// delegated and require an authorization grant from the origin.
+	call, err := cmn.GetAuthorizedCall(origin, contract.CallerAddress, delegatorHexAddr, "delegator")
+	if err != nil {
+		return nil, err
 	}
+	delegatorHexAddr = call.Account
 
 	// no need to have authorization when the contract caller is the same as origin (owner of funds)
-	if !isCallerOrigin {
+	if call.Delegated {
 		// Check if the authorization grant exists for the caller and the origin
 		stakeAuthz, expiration, err = authorization.CheckAuthzAndAllowanceForGranter(ctx, p.AuthzKeeper, contract.CallerAddress, delegatorHexAddr, &msg.Amount, CancelUnbondingDelegationMsg)
 		if err != nil {
@@ -398,7 +388,7 @@ func (p Precompile) CancelUnbondingDelegation(
 	}
 
 	// Only update the authorization if the contract caller is different from the origin
-	if !isCallerOrigin {
+	if call.Delegated {
 		if err := p.UpdateStakingAuthorization(ctx, contract.CallerAddress, delegatorHexAddr, stakeAuthz, expiration, CancelUnbondingDelegationMsg, msg); err != nil {
 			return nil, err
 		}
@@ -507,6 +507,50 @@ func (s *PrecompileTestSuite) TestDelegate() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestDelegateFromIntermediateContract() {
+	method := s.precompile.Methods[staking.DelegateMethod]
+	malicious := evmosutiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		delegator   func() geth.Address
+		errContains string
+	}{
+		{
+			"fail - contract delegates the origin funds without authorization",
+			func() geth.Address { return s.address },
+			"authorization",
+		},
+		{
+			"fail - contract provides its own address to delegate the origin funds without authorization",
+			func() geth.Address { return malicious },
+			"authorization",
+		},
+		{
+			"fail - contract delegates on behalf of another account",
+			func() geth.Address { return evmosutiltx.GenerateAddress() },
+			"is not the same as delegator address",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, s.ctx = testutil.NewPrecompileContract(s.T(), s.ctx, malicious, s.precompile, 200000)
+
+			args := []interface{}{tc.delegator(), s.validators[0].OperatorAddress, big.NewInt(1e18)}
+			bz, err := s.precompile.Delegate(s.ctx, s.address, contract, s.stateDB, &method, args)
+			s.Require().ErrorContains(err, tc.errContains)
+			s.Require().Empty(bz)
+
+			delegation := s.app.StakingKeeper.Delegation(s.ctx, s.address.Bytes(), s.validators[0].GetOperator())
+			s.Require().Equal(s.validators[0].DelegatorShares, delegation.GetShares(), "expected delegation to be unchanged")
+		})
+	}
+}
+
 func (s *PrecompileTestSuite) TestUndelegate() {
 	method := s.precompile.Methods[staking.UndelegateMethod]
 
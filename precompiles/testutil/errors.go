@@ -12,7 +12,8 @@ import (
 )
 
 // CheckVMError is a helper function used to check if the transaction is reverted with the expected error message
-// in the VmError field of the MsgEthereumResponse struct.
+// in the VmError field of the MsgEthereumResponse struct. The revert reason returned by a precompile is appended
+// to the VmError, so that the underlying precompile error can be checked as well.
 func CheckVMError(res abci.ResponseDeliverTx, expErrMsg string, args ...interface{}) error {
 	if !res.IsOK() {
 		return fmt.Errorf("code 0 was expected on response but got code %d", res.Code)
@@ -21,9 +22,13 @@ func CheckVMError(res abci.ResponseDeliverTx, expErrMsg string, args ...interfac
 	if err != nil {
 		return fmt.Errorf("error occurred while decoding the TxResponse. %s", err)
 	}
+	vmError := ethRes.VmError
+	if reason, err := evmtypes.UnpackRevertReason(ethRes.Revert()); err == nil {
+		vmError = fmt.Sprintf("%s: %s", vmError, reason)
+	}
 	expMsg := fmt.Sprintf(expErrMsg, args...)
-	if !strings.Contains(ethRes.VmError, expMsg) {
-		return fmt.Errorf("unexpected VmError on response. expected error to contain: %s, received: %s", expMsg, ethRes.VmError)
+	if !strings.Contains(vmError, expMsg) {
+		return fmt.Errorf("unexpected VmError on response. expected error to contain: %s, received: %s", expMsg, vmError)
 	}
 	return nil
 }
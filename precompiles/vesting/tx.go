@@ -26,6 +26,16 @@ const (
 	ConvertVestingAccountMethod = "convertVestingAccount"
 )
 
+// NonReentrantMethods defines the vesting transactions that are guarded
+// against reentrant calls to the precompile.
+var NonReentrantMethods = map[string]bool{
+	CreateClawbackVestingAccountMethod: true,
+	FundVestingAccountMethod:           true,
+	ClawbackMethod:                     true,
+	UpdateVestingFunderMethod:          true,
+	ConvertVestingAccountMethod:        true,
+}
+
 // CreateClawbackVestingAccount creates a new clawback vesting account
 func (p Precompile) CreateClawbackVestingAccount(
 	ctx sdk.Context,
@@ -71,6 +71,7 @@ func NewPrecompile(
 			KvGasConfig:          storetypes.KVGasConfig(),
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
+			NonReentrantMethods:  NonReentrantMethods,
 		},
 		vestingKeeper: vestingKeeper,
 	}, nil
@@ -93,6 +94,13 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
+	// guard the state-changing methods against reentrant calls to the precompile
+	release, err := p.EnterNonReentrant(ctx, stateDB, p.Address(), method.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	if err := stateDB.Commit(); err != nil {
 		return nil, err
 	}
@@ -118,7 +126,7 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	}
 
 	if err != nil {
-		return nil, err
+		return cmn.HandleMethodError(ctx, contract, initialGas, "vesting", method.Name, err)
 	}
 
 	cost := ctx.GasMeter().GasConsumed() - initialGas
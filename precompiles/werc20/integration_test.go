@@ -69,6 +69,7 @@ var _ = Describe("WEVMOS Extension -", func() {
 			s.network.App.BankKeeper,
 			s.network.App.AuthzKeeper,
 			s.network.App.TransferKeeper,
+			s.network.App.Erc20Keeper,
 		)
 
 		Expect(err).ToNot(HaveOccurred(), "failed to create wevmos extension")
@@ -122,6 +122,8 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 }
 
 // GetStorageAt returns the contract storage at the given address, block number, and key.
+// The storage of the "pending" block reflects the modifications of the
+// transactions in the mempool.
 func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
 	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
 	if err != nil {
@@ -133,7 +135,15 @@ func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash
 		Key:     key,
 	}
 
-	res, err := b.queryClient.Storage(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	height := blockNum.Int64()
+	if blockNum == rpctypes.EthPendingBlockNumber {
+		// read the latest state with the mempool txs applied on top of it
+		height = 0
+		req.GasCap = b.RPCGasCap()
+		req.PendingTxs = b.pendingEthereumMsgs(req.GasCap)
+	}
+
+	res, err := b.queryClient.Storage(rpctypes.ContextWithHeight(height), req)
 	if err != nil {
 		return nil, err
 	}
@@ -5,12 +5,16 @@ import (
 	"math/big"
 
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/evmos/evmos/v16/app"
+	"github.com/evmos/evmos/v16/encoding"
 	"github.com/evmos/evmos/v16/rpc/backend/mocks"
 	rpctypes "github.com/evmos/evmos/v16/rpc/types"
 	utiltx "github.com/evmos/evmos/v16/testutil/tx"
@@ -185,6 +189,7 @@ func (suite *BackendTestSuite) TestGetProof() {
 
 func (suite *BackendTestSuite) TestGetStorageAt() {
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+	pendingBlockNr := rpctypes.EthPendingBlockNumber
 
 	testCases := []struct {
 		name          string
@@ -228,6 +233,55 @@ func (suite *BackendTestSuite) TestGetStorageAt() {
 			true,
 			hexutil.Bytes{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
 		},
+		{
+			"pass - pending applies the queued txs on top of the latest state",
+			utiltx.GenerateAddress(),
+			"0x0",
+			rpctypes.BlockNumberOrHash{BlockNumber: &pendingBlockNr},
+			func(addr common.Address, key string, storage string) {
+				suite.backend.cfg.JSONRPC.GasCap = 100000
+				_, bz := suite.buildEthereumTx()
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, types.Txs{bz})
+
+				tx, err := suite.backend.clientCtx.TxConfig.TxDecoder()(bz)
+				suite.Require().NoError(err)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterPendingStorageAt(queryClient, addr, key, []*evmtypes.MsgEthereumTx{tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)}, 100000, storage)
+			},
+			true,
+			common.HexToHash("0x1").Bytes(),
+		},
+		{
+			"pass - pending skips the queued txs above the gas cap",
+			utiltx.GenerateAddress(),
+			"0x0",
+			rpctypes.BlockNumberOrHash{BlockNumber: &pendingBlockNr},
+			func(addr common.Address, key string, storage string) {
+				suite.backend.cfg.JSONRPC.GasCap = 99999
+				_, bz := suite.buildEthereumTx()
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, types.Txs{bz})
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterPendingStorageAt(queryClient, addr, key, nil, 99999, storage)
+			},
+			true,
+			common.HexToHash("0x1").Bytes(),
+		},
+		{
+			"pass - pending falls back to the latest state if the mempool is unavailable",
+			utiltx.GenerateAddress(),
+			"0x0",
+			rpctypes.BlockNumberOrHash{BlockNumber: &pendingBlockNr},
+			func(addr common.Address, key string, storage string) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsError(client, nil)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterPendingStorageAt(queryClient, addr, key, nil, 0, storage)
+			},
+			true,
+			common.HexToHash("0x1").Bytes(),
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -429,3 +483,89 @@ func (suite *BackendTestSuite) TestGetTransactionCount() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestGetTransactionCountPending() {
+	sender, senderKey := utiltx.NewAddrKey()
+	_, otherKey := utiltx.NewAddrKey()
+	committedNonce := uint64(3)
+
+	testCases := []struct {
+		name         string
+		blockNum     rpctypes.BlockNumber
+		registerMock func()
+		expTxCount   hexutil.Uint64
+	}{
+		{
+			"pass - latest returns the committed nonce",
+			rpctypes.EthLatestBlockNumber,
+			func() {},
+			hexutil.Uint64(committedNonce),
+		},
+		{
+			"pass - pending includes the queued txs of the sender",
+			rpctypes.EthPendingBlockNumber,
+			func() {
+				// queued txs of the sender and of another account in the local mempool
+				queuedTxs := types.Txs{
+					suite.signAndEncodeEthTxWithKey(senderKey, committedNonce),
+					suite.signAndEncodeEthTxWithKey(otherKey, 0),
+					suite.signAndEncodeEthTxWithKey(senderKey, committedNonce+1),
+				}
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, queuedTxs)
+			},
+			hexutil.Uint64(committedNonce + 2),
+		},
+		{
+			"pass - pending without queued txs returns the committed nonce",
+			rpctypes.EthPendingBlockNumber,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, nil)
+			},
+			hexutil.Uint64(committedNonce),
+		},
+		{
+			"pass - pending falls back to the committed nonce if the mempool is unavailable",
+			rpctypes.EthPendingBlockNumber,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsError(client, nil)
+			},
+			hexutil.Uint64(committedNonce),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			senderAcc := sdk.AccAddress(sender.Bytes())
+			encCfg := encoding.MakeConfig(app.ModuleBasics)
+			suite.backend.clientCtx = suite.backend.clientCtx.
+				WithInterfaceRegistry(encCfg.InterfaceRegistry).
+				WithAccountRetriever(client.TestAccountRetriever{Accounts: map[string]client.TestAccount{
+					senderAcc.String(): {Address: senderAcc, Num: 1, Seq: committedNonce},
+				}})
+
+			var header metadata.MD
+			queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+			RegisterParams(queryClient, &header, 1)
+
+			request := &authtypes.QueryAccountRequest{Address: senderAcc.String()}
+			requestMarshal, err := request.Marshal()
+			suite.Require().NoError(err)
+			RegisterABCIQueryAccount(
+				suite.backend.clientCtx.Client.(*mocks.Client),
+				requestMarshal,
+				tmrpcclient.ABCIQueryOptions{Height: int64(1), Prove: false},
+				client.TestAccount{Address: senderAcc, Num: 1, Seq: committedNonce},
+			)
+
+			tc.registerMock()
+
+			txCount, err := suite.backend.GetTransactionCount(sender, tc.blockNum)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expTxCount, *txCount)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -172,6 +173,35 @@ func (suite *BackendTestSuite) generateTestKeyring(clientDir string) (keyring.Ke
 	return keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, clientDir, buf, encCfg.Codec, []keyring.Option{hd.EthSecp256k1Option()}...)
 }
 
+// signAndEncodeEthTxWithKey builds an Ethereum tx with the given nonce, signs it with
+// the given key and returns the encoded Cosmos tx.
+func (suite *BackendTestSuite) signAndEncodeEthTxWithKey(priv cryptotypes.PrivKey, nonce uint64) []byte {
+	msgEthereumTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  suite.backend.chainID,
+		Nonce:    nonce,
+		To:       &common.Address{},
+		Amount:   big.NewInt(0),
+		GasLimit: 100000,
+		GasPrice: big.NewInt(1),
+	})
+
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterParamsWithoutHeader(queryClient, 1)
+
+	ethSigner := ethtypes.LatestSigner(suite.backend.ChainConfig())
+	msgEthereumTx.From = common.BytesToAddress(priv.PubKey().Address()).String()
+	err := msgEthereumTx.Sign(ethSigner, utiltx.NewSigner(priv))
+	suite.Require().NoError(err)
+
+	tx, err := msgEthereumTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom)
+	suite.Require().NoError(err)
+
+	txBz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+
+	return txBz
+}
+
 func (suite *BackendTestSuite) signAndEncodeEthTx(msgEthereumTx *evmtypes.MsgEthereumTx) []byte {
 	from, priv := utiltx.NewAddrKey()
 	signer := utiltx.NewSigner(priv)
@@ -10,8 +10,10 @@ import (
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -104,63 +106,158 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 
 // SendRawTransaction send a raw Ethereum transaction.
 func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
+	ethereumTx, txBytes, err := b.encodeRawTransaction(data)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	txHash := ethereumTx.AsTransaction().Hash()
+
+	syncCtx := b.clientCtx.WithBroadcastMode(flags.BroadcastSync)
+	rsp, err := syncCtx.BroadcastTx(txBytes)
+	if rsp != nil && rsp.Code != 0 {
+		err = errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
+	}
+	if err != nil {
+		b.logger.Error("failed to broadcast tx", "error", err.Error())
+		return txHash, err
+	}
+
+	return txHash, nil
+}
+
+// DryRunRawTransaction runs CheckTx on a raw Ethereum transaction without broadcasting
+// it and, if simulate is set, executes it against the pending state. No state is
+// committed. The transaction is decoded and validated exactly as in SendRawTransaction.
+func (b *Backend) DryRunRawTransaction(data hexutil.Bytes, simulate bool) (*rpctypes.DryRunResult, error) {
+	ethereumTx, txBytes, err := b.encodeRawTransaction(data)
+	if err != nil {
+		return nil, err
+	}
+
+	mc, ok := b.clientCtx.Client.(tmrpcclient.MempoolClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+
+	rsp, err := mc.CheckTx(b.ctx, txBytes)
+	if err != nil {
+		b.logger.Error("failed to check tx", "error", err.Error())
+		return nil, err
+	}
+	if rsp.Code != 0 {
+		return nil, errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.Log)
+	}
+
+	tx := ethereumTx.AsTransaction()
+	from, err := ethereumTx.GetSender(tx.ChainId())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &rpctypes.DryRunResult{
+		TransactionHash: tx.Hash(),
+		From:            from,
+		To:              tx.To(),
+		Status:          hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+		Logs:            []*ethtypes.Log{},
+	}
+
+	if !simulate {
+		return result, nil
+	}
+
+	gas := hexutil.Uint64(tx.Gas())
+	nonce := hexutil.Uint64(tx.Nonce())
+	input := hexutil.Bytes(tx.Data())
+	accessList := tx.AccessList()
+	args := evmtypes.TransactionArgs{
+		From:       &from,
+		To:         tx.To(),
+		Gas:        &gas,
+		Value:      (*hexutil.Big)(tx.Value()),
+		Nonce:      &nonce,
+		Input:      &input,
+		AccessList: &accessList,
+		ChainID:    (*hexutil.Big)(b.chainID),
+	}
+	if tx.Type() == ethtypes.DynamicFeeTxType {
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	} else {
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	}
+
+	res, err := b.ethCall(args, rpctypes.EthPendingBlockNumber, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result.GasUsed = hexutil.Uint64(res.GasUsed)
+	result.ReturnData = res.Ret
+	if res.Failed() {
+		result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+		result.Error = res.VmError
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			if reason, err := abi.UnpackRevert(res.Ret); err == nil {
+				result.RevertReason = reason
+			}
+		}
+		return result, nil
+	}
+
+	result.Logs = evmtypes.LogsToEthereum(res.Logs)
+	return result, nil
+}
+
+// encodeRawTransaction decodes and validates the RLP encoded Ethereum transaction and
+// returns it together with the encoded Cosmos transaction that wraps it.
+func (b *Backend) encodeRawTransaction(data hexutil.Bytes) (*evmtypes.MsgEthereumTx, []byte, error) {
 	// RLP decode raw transaction bytes
 	tx := &ethtypes.Transaction{}
 	if err := tx.UnmarshalBinary(data); err != nil {
 		b.logger.Error("transaction decoding failed", "error", err.Error())
-		return common.Hash{}, err
+		return nil, nil, err
 	}
 
 	// check the local node config in case unprotected txs are disabled
 	if !b.UnprotectedAllowed() && !tx.Protected() {
 		// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
-		return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+		return nil, nil, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
 	}
 
 	ethereumTx := &evmtypes.MsgEthereumTx{}
 	if err := ethereumTx.FromEthereumTx(tx); err != nil {
 		b.logger.Error("transaction converting failed", "error", err.Error())
-		return common.Hash{}, err
+		return nil, nil, err
 	}
 
 	if err := ethereumTx.ValidateBasic(); err != nil {
 		b.logger.Debug("tx failed basic validation", "error", err.Error())
-		return common.Hash{}, err
+		return nil, nil, err
 	}
 
 	// Query params to use the EVM denomination
 	res, err := b.queryClient.QueryClient.Params(b.ctx, &evmtypes.QueryParamsRequest{})
 	if err != nil {
 		b.logger.Error("failed to query evm params", "error", err.Error())
-		return common.Hash{}, err
+		return nil, nil, err
 	}
 
 	cosmosTx, err := ethereumTx.BuildTx(b.clientCtx.TxConfig.NewTxBuilder(), res.Params.EvmDenom)
 	if err != nil {
 		b.logger.Error("failed to build cosmos tx", "error", err.Error())
-		return common.Hash{}, err
+		return nil, nil, err
 	}
 
 	// Encode transaction by default Tx encoder
 	txBytes, err := b.clientCtx.TxConfig.TxEncoder()(cosmosTx)
 	if err != nil {
 		b.logger.Error("failed to encode eth tx using default encoder", "error", err.Error())
-		return common.Hash{}, err
-	}
-
-	txHash := ethereumTx.AsTransaction().Hash()
-
-	syncCtx := b.clientCtx.WithBroadcastMode(flags.BroadcastSync)
-	rsp, err := syncCtx.BroadcastTx(txBytes)
-	if rsp != nil && rsp.Code != 0 {
-		err = errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
-	}
-	if err != nil {
-		b.logger.Error("failed to broadcast tx", "error", err.Error())
-		return txHash, err
+		return nil, nil, err
 	}
 
-	return txHash, nil
+	return ethereumTx, txBytes, nil
 }
 
 // SetTxDefaults populates tx message with default values in case they are not
@@ -327,9 +424,30 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 }
 
 // DoCall performs a simulated call operation through the evmtypes. It returns the
-// estimated gas used on the operation or an error if fails.
+// estimated gas used on the operation or an error if fails. If block overrides are
+// provided, the call is executed with the overridden block header fields.
 func (b *Backend) DoCall(
-	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, blockOverrides *evmtypes.BlockOverrides,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	res, err := b.ethCall(args, blockNr, blockOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Failed() {
+		if res.VmError != vm.ErrExecutionReverted.Error() {
+			return nil, status.Error(codes.Internal, res.VmError)
+		}
+		return nil, evmtypes.NewExecErrorWithReason(res.Ret)
+	}
+
+	return res, nil
+}
+
+// ethCall executes the given call arguments on the provided block through the EthCall
+// gRPC query and returns the raw execution response, including failed executions.
+func (b *Backend) ethCall(
+	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, blockOverrides *evmtypes.BlockOverrides,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
@@ -348,6 +466,13 @@ func (b *Backend) DoCall(
 		ChainId:         b.chainID.Int64(),
 	}
 
+	if blockOverrides != nil {
+		req.BlockOverrides, err = json.Marshal(blockOverrides)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
@@ -368,18 +493,10 @@ func (b *Backend) DoCall(
 	defer cancel()
 
 	res, err := b.queryClient.EthCall(ctx, &req)
-	if err != nil {
-		return nil, err
-	}
-
-	if res.Failed() {
-		if res.VmError != vm.ErrExecutionReverted.Error() {
-			return nil, status.Error(codes.Internal, res.VmError)
-		}
-		return nil, evmtypes.NewExecErrorWithReason(res.Ret)
+	if err != nil && IsStateUnavailableError(err) {
+		return nil, StateUnavailableError(header.Block.Height)
 	}
-
-	return res, nil
+	return res, err
 }
 
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
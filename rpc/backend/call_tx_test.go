@@ -385,6 +385,90 @@ func (suite *BackendTestSuite) TestSendRawTransaction() {
 	}
 }
 
+func (suite *BackendTestSuite) TestDryRunRawTransaction() {
+	ethTx, bz := suite.buildEthereumTx()
+	from := suite.from
+
+	// Sign the ethTx
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterParamsWithoutHeader(queryClient, 1)
+	ethSigner := ethtypes.LatestSigner(suite.backend.ChainConfig())
+	err := ethTx.Sign(ethSigner, suite.signer)
+	suite.Require().NoError(err)
+
+	rlpEncodedBz, _ := rlp.EncodeToBytes(ethTx.AsTransaction())
+	cosmosTx, _ := ethTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), evmtypes.DefaultEVMDenom)
+	txBytes, _ := suite.backend.clientCtx.TxConfig.TxEncoder()(cosmosTx)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		rawTx        []byte
+		expPass      bool
+	}{
+		{
+			"fail - no RLP encoded bytes",
+			func() {},
+			bz,
+			false,
+		},
+		{
+			"fail - failed to check transaction",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				suite.backend.allowUnprotectedTxs = true
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterCheckTxError(client, txBytes)
+			},
+			rlpEncodedBz,
+			false,
+		},
+		{
+			"fail - transaction rejected by CheckTx",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				suite.backend.allowUnprotectedTxs = true
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterCheckTxRejected(client, txBytes)
+			},
+			rlpEncodedBz,
+			false,
+		},
+		{
+			"pass - transaction passes CheckTx",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				suite.backend.allowUnprotectedTxs = true
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterCheckTx(client, txBytes)
+			},
+			rlpEncodedBz,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.DryRunRawTransaction(tc.rawTx, false)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(common.HexToHash(ethTx.Hash), res.TransactionHash)
+				suite.Require().Equal(from, res.From)
+				suite.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), res.Status)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestDoCall() {
 	_, bz := suite.buildEthereumTx()
 	gasPrice := (*hexutil.Big)(big.NewInt(1))
@@ -405,14 +489,20 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 	argsBz, err := json.Marshal(callArgs)
 	suite.Require().NoError(err)
+	coinbase := utiltx.GenerateAddress()
+	blockOverrides := &evmtypes.BlockOverrides{Coinbase: &coinbase, BaseFee: gasPrice}
+	blockOverridesBz, err := json.Marshal(blockOverrides)
+	suite.Require().NoError(err)
 
 	testCases := []struct {
-		name         string
-		registerMock func()
-		blockNum     rpctypes.BlockNumber
-		callArgs     evmtypes.TransactionArgs
-		expEthTx     *evmtypes.MsgEthereumTxResponse
-		expPass      bool
+		name           string
+		registerMock   func()
+		blockNum       rpctypes.BlockNumber
+		callArgs       evmtypes.TransactionArgs
+		blockOverrides *evmtypes.BlockOverrides
+		expEthTx       *evmtypes.MsgEthereumTxResponse
+		expPass        bool
+		errContains    string
 	}{
 		{
 			"fail - Invalid request",
@@ -425,8 +515,26 @@ func (suite *BackendTestSuite) TestDoCall() {
 			},
 			rpctypes.BlockNumber(1),
 			callArgs,
+			nil,
 			&evmtypes.MsgEthereumTxResponse{},
 			false,
+			"",
+		},
+		{
+			"fail - state pruned at the requested height",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterEthCallPrunedStateError(queryClient, &evmtypes.EthCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64()})
+			},
+			rpctypes.BlockNumber(1),
+			callArgs,
+			nil,
+			&evmtypes.MsgEthereumTxResponse{},
+			false,
+			"missing trie node: state unavailable at height 1",
 		},
 		{
 			"pass - Returned transaction response",
@@ -439,8 +547,26 @@ func (suite *BackendTestSuite) TestDoCall() {
 			},
 			rpctypes.BlockNumber(1),
 			callArgs,
+			nil,
+			&evmtypes.MsgEthereumTxResponse{},
+			true,
+			"",
+		},
+		{
+			"pass - Returned transaction response with block overrides",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterEthCall(queryClient, &evmtypes.EthCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64(), BlockOverrides: blockOverridesBz})
+			},
+			rpctypes.BlockNumber(1),
+			callArgs,
+			blockOverrides,
 			&evmtypes.MsgEthereumTxResponse{},
 			true,
+			"",
 		},
 	}
 
@@ -449,12 +575,15 @@ func (suite *BackendTestSuite) TestDoCall() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum, tc.blockOverrides)
 
 			if tc.expPass {
 				suite.Require().Equal(tc.expEthTx, msgEthTx)
 			} else {
 				suite.Require().Error(err)
+				if tc.errContains != "" {
+					suite.Require().ErrorContains(err, tc.errContains)
+				}
 			}
 		})
 	}
@@ -171,6 +171,24 @@ func (b *Backend) FeeHistory(
 		return nil, fmt.Errorf("FeeHistory user block count %d higher than %d", blocks, maxBlockCount)
 	}
 
+	// reward percentiles must be within [0, 100] and in ascending order, same as geth
+	for i, p := range rewardPercentiles {
+		if p < 0 || p > 100 {
+			return nil, fmt.Errorf("invalid reward percentile: %f", p)
+		}
+		if i > 0 && p < rewardPercentiles[i-1] {
+			return nil, fmt.Errorf("invalid reward percentile: #%d:%f > #%d:%f", i-1, rewardPercentiles[i-1], i, p)
+		}
+	}
+
+	// return an empty result if no blocks are requested
+	if blocks < 1 {
+		return &rpctypes.FeeHistoryResult{
+			OldestBlock:  (*hexutil.Big)(big.NewInt(0)),
+			GasUsedRatio: []float64{},
+		}, nil
+	}
+
 	if blockEnd+1 < blocks {
 		blocks = blockEnd + 1
 	}
@@ -246,6 +264,43 @@ func (b *Backend) FeeHistory(
 	return &feeHistory, nil
 }
 
+// MaxPriorityFeePerGas returns a suggestion for the gas tip cap of dynamic fee
+// transactions. It is the percentile of the effective tips paid in the recent
+// non-empty blocks, but never lower than the tip the ante handler accepts given
+// the feemarket MinGasPrice and MinTip.
+func (b *Backend) MaxPriorityFeePerGas() (*big.Int, error) {
+	head, err := b.CurrentHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	if head.BaseFee == nil {
+		// london hardfork not enabled or feemarket not enabled
+		return big.NewInt(0), nil
+	}
+
+	blocks := int64(maxPriorityFeeBlocks)
+	if maxBlockCount := int64(b.cfg.JSONRPC.FeeHistoryCap); blocks > maxBlockCount {
+		blocks = maxBlockCount
+	}
+
+	feeHistory, err := b.FeeHistory(
+		rpc.DecimalOrHex(blocks),
+		rpc.BlockNumber(head.Number.Int64()),
+		[]float64{maxPriorityFeePercentile},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.queryClient.FeeMarket.Params(b.ctx, &feemarkettypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return SuggestPriorityFee(feeHistory, head.BaseFee, res.Params.MinGasPrice, res.Params.MinTip.BigInt()), nil
+}
+
 // SuggestGasTipCap returns the suggested tip cap
 // Although we don't support tx prioritization yet, but we return a positive value to help client to
 // mitigate the base fee changes.
@@ -321,6 +321,63 @@ func (suite *BackendTestSuite) TestGlobalMinGasPrice() {
 	}
 }
 
+func (suite *BackendTestSuite) TestFeeHistoryInputValidation() {
+	testCases := []struct {
+		name              string
+		userBlockCount    ethrpc.DecimalOrHex
+		rewardPercentiles []float64
+		expFeeHistory     *rpc.FeeHistoryResult
+		expPass           bool
+	}{
+		{
+			"fail - reward percentile out of range",
+			1,
+			[]float64{25, 101},
+			nil,
+			false,
+		},
+		{
+			"fail - negative reward percentile",
+			1,
+			[]float64{-1},
+			nil,
+			false,
+		},
+		{
+			"fail - reward percentiles not in ascending order",
+			1,
+			[]float64{50, 25},
+			nil,
+			false,
+		},
+		{
+			"pass - zero block count returns an empty result",
+			0,
+			[]float64{25, 50},
+			&rpc.FeeHistoryResult{
+				OldestBlock:  (*hexutil.Big)(big.NewInt(0)),
+				GasUsedRatio: []float64{},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+
+			feeHistory, err := suite.backend.FeeHistory(tc.userBlockCount, 1, tc.rewardPercentiles)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expFeeHistory, feeHistory)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestFeeHistory() {
 	testCases := []struct {
 		name           string
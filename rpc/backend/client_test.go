@@ -57,6 +57,22 @@ func RegisterBroadcastTxError(client *mocks.Client, tx types.Tx) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// Check Transaction
+func RegisterCheckTx(client *mocks.Client, tx types.Tx) {
+	client.On("CheckTx", rpc.ContextWithHeight(1), tx).
+		Return(&tmrpctypes.ResultCheckTx{}, nil)
+}
+
+func RegisterCheckTxRejected(client *mocks.Client, tx types.Tx) {
+	client.On("CheckTx", rpc.ContextWithHeight(1), tx).
+		Return(&tmrpctypes.ResultCheckTx{ResponseCheckTx: abci.ResponseCheckTx{Code: 5, Log: "insufficient funds"}}, nil)
+}
+
+func RegisterCheckTxError(client *mocks.Client, tx types.Tx) {
+	client.On("CheckTx", rpc.ContextWithHeight(1), tx).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Unconfirmed Transactions
 func RegisterUnconfirmedTxs(client *mocks.Client, limit *int, txs []types.Tx) {
 	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), limit).
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"testing"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -151,6 +152,11 @@ func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.E
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterEthCallPrunedStateError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
+	queryClient.On("EthCall", mock.Anything, request).
+		Return(nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "failed to load state at height 1; version does not exist (latest height: 100)"))
+}
+
 // Estimate Gas
 func RegisterEstimateGas(queryClient *mocks.EVMQueryClient, args evmtypes.TransactionArgs) {
 	bz, _ := json.Marshal(args)
@@ -239,6 +245,11 @@ func RegisterStorageAt(queryClient *mocks.EVMQueryClient, addr common.Address, k
 		Return(&evmtypes.QueryStorageResponse{Value: storage}, nil)
 }
 
+func RegisterPendingStorageAt(queryClient *mocks.EVMQueryClient, addr common.Address, key string, pendingTxs []*evmtypes.MsgEthereumTx, gasCap uint64, storage string) {
+	queryClient.On("Storage", rpc.ContextWithHeight(0), &evmtypes.QueryStorageRequest{Address: addr.String(), Key: key, PendingTxs: pendingTxs, GasCap: gasCap}).
+		Return(&evmtypes.QueryStorageResponse{Value: storage}, nil)
+}
+
 func RegisterStorageAtError(queryClient *mocks.EVMQueryClient, addr common.Address, key string) {
 	queryClient.On("Storage", rpc.ContextWithHeight(1), &evmtypes.QueryStorageRequest{Address: addr.String(), Key: key}).
 		Return(nil, errortypes.ErrInvalidRequest)
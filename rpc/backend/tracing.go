@@ -19,32 +19,81 @@ import (
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
 func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error) {
+	traceTxRequest, contextHeight, err := b.traceTxRequest(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if config != nil {
+		traceTxRequest.TraceConfig = config
+	}
+
+	traceResult, err := b.queryClient.TraceTx(rpctypes.ContextWithHeight(contextHeight), traceTxRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
+	var decodedResult interface{}
+	err = json.Unmarshal(traceResult.Data, &decodedResult)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
+
+// ReplayTransaction re-executes the transaction with the given hash against the
+// state of its block and returns the execution result and the state diff. The
+// node must enable the tx replay through its configuration.
+func (b *Backend) ReplayTransaction(hash common.Hash) (*evmtypes.QueryReplayTxResponse, error) {
+	traceTxRequest, contextHeight, err := b.traceTxRequest(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.queryClient.ReplayTx(rpctypes.ContextWithHeight(contextHeight), &evmtypes.QueryReplayTxRequest{
+		Msg:             traceTxRequest.Msg,
+		Predecessors:    traceTxRequest.Predecessors,
+		BlockNumber:     traceTxRequest.BlockNumber,
+		BlockHash:       traceTxRequest.BlockHash,
+		BlockTime:       traceTxRequest.BlockTime,
+		ProposerAddress: traceTxRequest.ProposerAddress,
+		ChainId:         traceTxRequest.ChainId,
+		BlockMaxGas:     traceTxRequest.BlockMaxGas,
+	})
+}
+
+// traceTxRequest builds the request to re-execute the transaction with the
+// given hash, together with the height of the context to query it at.
+func (b *Backend) traceTxRequest(hash common.Hash) (*evmtypes.QueryTraceTxRequest, int64, error) {
 	// Get transaction by hash
 	transaction, err := b.GetTxByEthHash(hash)
 	if err != nil {
 		b.logger.Debug("tx not found", "hash", hash)
-		return nil, err
+		return nil, 0, err
 	}
 
 	// check if block number is 0
 	if transaction.Height == 0 {
-		return nil, errors.New("genesis is not traceable")
+		return nil, 0, errors.New("genesis is not traceable")
 	}
 
 	blk, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(transaction.Height))
 	if err != nil {
 		b.logger.Debug("block not found", "height", transaction.Height)
-		return nil, err
+		return nil, 0, err
 	}
 
 	// check tx index is not out of bound
 	if len(blk.Block.Txs) > math.MaxUint32 {
-		return nil, fmt.Errorf("tx count %d is overfloing", len(blk.Block.Txs))
+		return nil, 0, fmt.Errorf("tx count %d is overfloing", len(blk.Block.Txs))
 	}
 	txsLen := uint32(len(blk.Block.Txs)) // #nosec G701 -- checked for int overflow already
 	if txsLen < transaction.TxIndex {
 		b.logger.Debug("tx index out of bounds", "index", transaction.TxIndex, "hash", hash.String(), "height", blk.Block.Height)
-		return nil, fmt.Errorf("transaction not included in block %v", blk.Block.Height)
+		return nil, 0, fmt.Errorf("transaction not included in block %v", blk.Block.Height)
 	}
 
 	var predecessors []*evmtypes.MsgEthereumTx
@@ -67,7 +116,7 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 	tx, err := b.clientCtx.TxConfig.TxDecoder()(blk.Block.Txs[transaction.TxIndex])
 	if err != nil {
 		b.logger.Debug("tx not found", "hash", hash)
-		return nil, err
+		return nil, 0, err
 	}
 
 	// add predecessor messages in current cosmos tx
@@ -83,17 +132,17 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 	ethMessage, ok := tx.GetMsgs()[transaction.MsgIndex].(*evmtypes.MsgEthereumTx)
 	if !ok {
 		b.logger.Debug("invalid transaction type", "type", fmt.Sprintf("%T", tx))
-		return nil, fmt.Errorf("invalid transaction type %T", tx)
+		return nil, 0, fmt.Errorf("invalid transaction type %T", tx)
 	}
 
 	nc, ok := b.clientCtx.Client.(tmrpcclient.NetworkClient)
 	if !ok {
-		return nil, errors.New("invalid rpc client")
+		return nil, 0, errors.New("invalid rpc client")
 	}
 
 	cp, err := nc.ConsensusParams(b.ctx, &blk.Block.Height)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	traceTxRequest := evmtypes.QueryTraceTxRequest{
@@ -107,30 +156,14 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
 	}
 
-	if config != nil {
-		traceTxRequest.TraceConfig = config
-	}
-
 	// minus one to get the context of block beginning
 	contextHeight := transaction.Height - 1
 	if contextHeight < 1 {
 		// 0 is a special value in `ContextWithHeight`
 		contextHeight = 1
 	}
-	traceResult, err := b.queryClient.TraceTx(rpctypes.ContextWithHeight(contextHeight), &traceTxRequest)
-	if err != nil {
-		return nil, err
-	}
-
-	// Response format is unknown due to custom tracer config param
-	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
-	var decodedResult interface{}
-	err = json.Unmarshal(traceResult.Data, &decodedResult)
-	if err != nil {
-		return nil, err
-	}
 
-	return decodedResult, nil
+	return &traceTxRequest, contextHeight, nil
 }
 
 // TraceBlock configures a new tracer according to the provided configuration, and
@@ -153,6 +153,68 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		b.logger.Debug("block not found", "height", res.Height, "error", err.Error())
 		return nil, nil
 	}
+	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+		return nil, nil
+	}
+
+	return b.formatTxReceipt(hash, res, resBlock, blockRes)
+}
+
+// GetBlockReceipts returns the receipts of all the Ethereum transactions of the
+// block identified by number or hash, in the order of the block.
+func (b *Backend) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		b.logger.Debug("block not found", "height", blockNum, "error", err.Error())
+		return nil, nil
+	}
+	// return if requested block height is greater than the current one
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", resBlock.Block.Height, "error", err.Error())
+		return nil, nil
+	}
+
+	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	receipts := make([]map[string]interface{}, 0, len(msgs))
+	for _, msg := range msgs {
+		hash := common.HexToHash(msg.Hash)
+		res, err := b.GetTxByEthHash(hash)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to get tx %s", msg.Hash)
+		}
+
+		receipt, err := b.formatTxReceipt(hash, res, resBlock, blockRes)
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+
+	return receipts, nil
+}
+
+// formatTxReceipt assembles the receipt of the Ethereum transaction with the
+// given hash from its indexed result and the block that includes it.
+func (b *Backend) formatTxReceipt(
+	hash common.Hash,
+	res *types.TxResult,
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+) (map[string]interface{}, error) {
+	hexTx := hash.Hex()
+
 	tx, err := b.clientCtx.TxConfig.TxDecoder()(resBlock.Block.Txs[res.TxIndex])
 	if err != nil {
 		b.logger.Debug("decoding failed", "error", err.Error())
@@ -167,11 +229,6 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	}
 
 	cumulativeGasUsed := uint64(0)
-	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
-	if err != nil {
-		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
-		return nil, nil
-	}
 	for _, txResult := range blockRes.TxsResults[0:res.TxIndex] {
 		cumulativeGasUsed += uint64(txResult.GasUsed) // #nosec G701 -- checked for int overflow already
 	}
@@ -249,15 +306,21 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
-	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
-		baseFee, err := b.BaseFee(blockRes)
-		if err != nil {
+	// the effective gas price of a dynamic fee tx depends on the base fee of the block
+	var (
+		baseFee    *big.Int
+		baseFeeErr error
+	)
+	if _, ok := txData.(*evmtypes.DynamicFeeTx); ok {
+		baseFee, baseFeeErr = b.BaseFee(blockRes)
+		if baseFeeErr != nil {
 			// tolerate the error for pruned node.
-			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
-		} else {
-			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
+			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", baseFeeErr)
 		}
 	}
+	if baseFeeErr == nil {
+		receipt["effectiveGasPrice"] = hexutil.Big(*EffectiveGasPrice(txData, baseFee))
+	}
 
 	return receipt, nil
 }
@@ -9,6 +9,7 @@ import (
 	"sort"
 	"strings"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"google.golang.org/grpc/codes"
@@ -103,6 +104,39 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 	return nonce, nil
 }
 
+// pendingEthereumMsgs returns the ethereum messages of the txs in the mempool,
+// in the mempool order. It returns no messages if the mempool can't be read.
+func (b *Backend) pendingEthereumMsgs(gasCap uint64) []*evmtypes.MsgEthereumTx {
+	pendingTxs, err := b.PendingTransactions()
+	if err != nil {
+		b.logger.Error("failed to fetch pending transactions", "error", err.Error())
+		return nil
+	}
+
+	var (
+		msgs     []*evmtypes.MsgEthereumTx
+		totalGas uint64
+	)
+	for _, tx := range pendingTxs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+			// stay within the limits enforced by the storage query
+			gas := ethMsg.GetGas()
+			if len(msgs) == evmtypes.MaxStoragePendingTxs || gas > gasCap-totalGas {
+				return msgs
+			}
+			totalGas += gas
+			msgs = append(msgs, ethMsg)
+		}
+	}
+
+	return msgs
+}
+
 // output: targetOneFeeHistory
 func (b *Backend) processBlock(
 	tendermintBlock *tmrpctypes.ResultBlock,
@@ -270,6 +304,19 @@ func ShouldIgnoreGasUsed(res *abci.ResponseDeliverTx) bool {
 	return res.GetCode() == 11 && strings.Contains(res.GetLog(), "no block gas left to run tx: out of gas")
 }
 
+// EffectiveGasPrice returns the gas price paid by the sender of a transaction.
+// For dynamic fee txs it is min(maxFeePerGas, baseFee + maxPriorityFeePerGas),
+// falling back to maxFeePerGas when the base fee is not available. For legacy
+// and access list txs it is the gas price of the tx.
+func EffectiveGasPrice(txData evmtypes.TxData, baseFee *big.Int) *big.Int {
+	dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx)
+	if !ok || baseFee == nil {
+		return txData.GetGasPrice()
+	}
+
+	return dynamicTx.EffectiveGasPrice(baseFee)
+}
+
 // GetLogsFromBlockResults returns the list of event logs from the tendermint block result response
 func GetLogsFromBlockResults(blockRes *tmrpctypes.ResultBlockResults) ([][]*ethtypes.Log, error) {
 	blockLogs := [][]*ethtypes.Log{}
@@ -300,3 +347,64 @@ func GetHexProofs(proof *crypto.ProofOps) []string {
 	}
 	return proofs
 }
+
+// IsStateUnavailableError returns true if the given query error was caused by
+// the node not having the state at the queried height, i.e. when the state has
+// been pruned. The error is returned by the commit multistore when its earliest
+// available version is above the queried height.
+func IsStateUnavailableError(err error) bool {
+	return strings.Contains(err.Error(), "failed to load state at height")
+}
+
+// StateUnavailableError returns the error for a query on the pruned state at
+// the given height. The message imitates geth behavior, so that tooling can
+// fall back to an archive node.
+func StateUnavailableError(height int64) error {
+	return fmt.Errorf("missing trie node: state unavailable at height %d", height)
+}
+
+const (
+	// maxPriorityFeeBlocks is the number of recent blocks the suggested priority
+	// fee per gas is computed from.
+	maxPriorityFeeBlocks = 20
+	// maxPriorityFeePercentile is the percentile of the effective tips paid in
+	// the recent blocks that is suggested as priority fee per gas.
+	maxPriorityFeePercentile = 60
+)
+
+// SuggestPriorityFee returns the maxPriorityFeePercentile percentile of the
+// rewards of the non-empty blocks in the given fee history. The suggestion is
+// raised to the lowest tip accepted by the ante handler, which must satisfy the
+// min tip and make the effective gas price reach the global min gas price.
+func SuggestPriorityFee(feeHistory *types.FeeHistoryResult, baseFee *big.Int, minGasPrice sdkmath.LegacyDec, minTip *big.Int) *big.Int {
+	rewards := make([]*big.Int, 0, len(feeHistory.Reward))
+	for i, blockRewards := range feeHistory.Reward {
+		// skip the empty blocks, which have no rewards
+		if feeHistory.GasUsedRatio[i] == 0 || len(blockRewards) == 0 || blockRewards[0] == nil {
+			continue
+		}
+		rewards = append(rewards, blockRewards[0].ToInt())
+	}
+
+	suggestion := big.NewInt(0)
+	if len(rewards) > 0 {
+		sort.Slice(rewards, func(i, j int) bool { return rewards[i].Cmp(rewards[j]) < 0 })
+		suggestion.Set(rewards[(len(rewards)-1)*maxPriorityFeePercentile/100])
+	}
+
+	floor := new(big.Int)
+	if minTip != nil {
+		floor.Set(minTip)
+	}
+	if !minGasPrice.IsNil() {
+		minGasPriceTip := new(big.Int).Sub(minGasPrice.Ceil().TruncateInt().BigInt(), baseFee)
+		if minGasPriceTip.Cmp(floor) > 0 {
+			floor = minGasPriceTip
+		}
+	}
+
+	if suggestion.Cmp(floor) < 0 {
+		return floor
+	}
+	return suggestion
+}
@@ -2,8 +2,14 @@ package backend
 
 import (
 	"fmt"
+	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	rpctypes "github.com/evmos/evmos/v16/rpc/types"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 )
 
 func mookProofs(num int, withData bool) *crypto.ProofOps {
@@ -50,3 +56,122 @@ func (suite *BackendTestSuite) TestGetHexProofs() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestEffectiveGasPrice() {
+	baseFee := big.NewInt(100)
+
+	testCases := []struct {
+		name    string
+		txArgs  evmtypes.EvmTxArgs
+		baseFee *big.Int
+		exp     *big.Int
+	}{
+		{
+			"legacy tx - gas price",
+			evmtypes.EvmTxArgs{GasPrice: big.NewInt(150)},
+			baseFee,
+			big.NewInt(150),
+		},
+		{
+			"access list tx - gas price",
+			evmtypes.EvmTxArgs{GasPrice: big.NewInt(150), Accesses: &ethtypes.AccessList{}},
+			baseFee,
+			big.NewInt(150),
+		},
+		{
+			"dynamic fee tx - base fee plus tip",
+			evmtypes.EvmTxArgs{GasFeeCap: big.NewInt(200), GasTipCap: big.NewInt(30)},
+			baseFee,
+			big.NewInt(130),
+		},
+		{
+			"dynamic fee tx - capped by max fee per gas",
+			evmtypes.EvmTxArgs{GasFeeCap: big.NewInt(120), GasTipCap: big.NewInt(30)},
+			baseFee,
+			big.NewInt(120),
+		},
+		{
+			"dynamic fee tx - max fee per gas without base fee",
+			evmtypes.EvmTxArgs{GasFeeCap: big.NewInt(200), GasTipCap: big.NewInt(30)},
+			nil,
+			big.NewInt(200),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			tc.txArgs.ChainID = big.NewInt(9000)
+			msg := evmtypes.NewTx(&tc.txArgs)
+			txData, err := evmtypes.UnpackTxData(msg.Data)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(tc.exp, EffectiveGasPrice(txData, tc.baseFee))
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestSuggestPriorityFee() {
+	baseFee := big.NewInt(100)
+	newFeeHistory := func(gasUsedRatio []float64, rewards ...int64) *rpctypes.FeeHistoryResult {
+		reward := make([][]*hexutil.Big, len(rewards))
+		for i, r := range rewards {
+			reward[i] = []*hexutil.Big{(*hexutil.Big)(big.NewInt(r))}
+		}
+		return &rpctypes.FeeHistoryResult{GasUsedRatio: gasUsedRatio, Reward: reward}
+	}
+
+	testCases := []struct {
+		name        string
+		feeHistory  *rpctypes.FeeHistoryResult
+		minGasPrice sdkmath.LegacyDec
+		minTip      *big.Int
+		exp         *big.Int
+	}{
+		{
+			"no blocks - zero",
+			&rpctypes.FeeHistoryResult{},
+			sdkmath.LegacyZeroDec(),
+			big.NewInt(0),
+			big.NewInt(0),
+		},
+		{
+			"percentile of the block rewards",
+			newFeeHistory([]float64{0.1, 0.2, 0.3, 0.4, 0.5}, 50, 10, 40, 20, 30),
+			sdkmath.LegacyZeroDec(),
+			big.NewInt(0),
+			big.NewInt(30),
+		},
+		{
+			"empty blocks are skipped",
+			newFeeHistory([]float64{0, 0.2, 0, 0.4}, 0, 10, 0, 20),
+			sdkmath.LegacyZeroDec(),
+			big.NewInt(0),
+			big.NewInt(10),
+		},
+		{
+			"raised to the min tip",
+			newFeeHistory([]float64{0.1}, 10),
+			sdkmath.LegacyZeroDec(),
+			big.NewInt(15),
+			big.NewInt(15),
+		},
+		{
+			"raised to the tip reaching the min gas price",
+			newFeeHistory([]float64{0.1}, 10),
+			sdkmath.LegacyNewDecWithPrec(1205, 1),
+			big.NewInt(15),
+			big.NewInt(21),
+		},
+		{
+			"min gas price below the base fee",
+			newFeeHistory([]float64{0.1}, 10),
+			sdkmath.LegacyNewDec(50),
+			big.NewInt(0),
+			big.NewInt(10),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.Require().Equal(tc.exp, SuggestPriorityFee(tc.feeHistory, baseFee, tc.minGasPrice, tc.minTip))
+		})
+	}
+}
@@ -69,6 +69,13 @@ func (a *API) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (
 	return a.backend.TraceTransaction(hash, config)
 }
 
+// ReplayTransaction re-executes the transaction with the given hash against the
+// state of its block and returns the execution result and the state diff.
+func (a *API) ReplayTransaction(hash common.Hash) (*evmtypes.QueryReplayTxResponse, error) {
+	a.logger.Debug("debug_replayTransaction", "hash", hash)
+	return a.backend.ReplayTransaction(hash)
+}
+
 // TraceBlockByNumber returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
 func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *evmtypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
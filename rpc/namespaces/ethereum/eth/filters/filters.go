@@ -94,7 +94,10 @@ const (
 
 // Logs searches the blockchain for matching log entries, returning all from the
 // first block that contains matches, updating the start of the filter accordingly.
-func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*ethtypes.Log, error) {
+// The blocks are processed one at a time and the search stops as soon as the
+// number of matching logs exceeds the given log limit or the context is done,
+// so that wide queries don't buffer an unbounded amount of logs.
+func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) ([]*ethtypes.Log, error) {
 	logs := []*ethtypes.Log{}
 	var err error
 
@@ -116,7 +119,19 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 			return nil, err
 		}
 
-		return f.blockLogs(blockRes, bloom)
+		if !f.bloomMatch(bloom) {
+			return []*ethtypes.Log{}, nil
+		}
+
+		logs, err := f.blockLogs(blockRes)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(logs) > logLimit {
+			return nil, fmt.Errorf("query returned more than %d results", logLimit)
+		}
+		return logs, nil
 	}
 
 	// Figure out the limits of the filter range
@@ -157,6 +172,13 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	to := f.criteria.ToBlock.Int64()
 
 	for height := from; height <= to; height++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// The block bloom is only emitted in the EndBlock events of the block
+		// results (the header bloom is built from them too), so the results are
+		// fetched first and the tx logs are only decoded on a bloom match.
 		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
 		if err != nil {
 			f.logger.Debug("failed to fetch block result from Tendermint", "height", height, "error", err.Error())
@@ -168,7 +190,11 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 			return nil, err
 		}
 
-		filtered, err := f.blockLogs(blockRes, bloom)
+		if !f.bloomMatch(bloom) {
+			continue
+		}
+
+		filtered, err := f.blockLogs(blockRes)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to fetch block by number %d", height)
 		}
@@ -183,11 +209,9 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 }
 
 // blockLogs returns the logs matching the filter criteria within a single block.
-func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
-	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
-		return []*ethtypes.Log{}, nil
-	}
-
+// Callers must check the block bloom with bloomMatch beforehand so that the logs
+// of non-matching blocks are never parsed.
+func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults) ([]*ethtypes.Log, error) {
 	logsList, err := backend.GetLogsFromBlockResults(blockRes)
 	if err != nil {
 		return []*ethtypes.Log{}, errors.Wrapf(err, "failed to fetch logs block number %d", blockRes.Height)
@@ -206,6 +230,32 @@ func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtyp
 	return logs, nil
 }
 
+// bloomMatch returns true if the given block bloom may contain logs matching the
+// filter criteria. Range filters use the bloom bit indexes precomputed on creation,
+// which avoids hashing every address and topic again for each block of the range.
+func (f *Filter) bloomMatch(bloom ethtypes.Bloom) bool {
+	if f.bloomFilters == nil {
+		return bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics)
+	}
+
+	for _, bloomIVs := range f.bloomFilters {
+		// the rule matches if any of its clauses is included in the bloom
+		included := false
+		for _, iv := range bloomIVs {
+			if bloom[iv.I[0]]&iv.V[0] != 0 &&
+				bloom[iv.I[1]]&iv.V[1] != 0 &&
+				bloom[iv.I[2]]&iv.V[2] != 0 {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+	return true
+}
+
 func createBloomFilters(filters [][][]byte, logger log.Logger) [][]BloomIV {
 	bloomFilters := make([][]BloomIV, 0)
 	for _, filter := range filters {
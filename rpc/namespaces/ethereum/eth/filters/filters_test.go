@@ -0,0 +1,280 @@
+package filters
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/evmos/evmos/v16/rpc/types"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+// logsBackend is a minimal Backend that serves a chain in which every block
+// contains the same number of logs.
+type logsBackend struct {
+	Backend
+
+	t            require.TestingT
+	head         int64
+	logsPerBlock int
+	bloom        ethtypes.Bloom
+	// malformed makes the backend serve tx logs that cannot be decoded
+	malformed bool
+	// fetched counts the block results served by the backend
+	fetched int
+}
+
+func (b *logsBackend) HeaderByNumber(types.BlockNumber) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: big.NewInt(b.head)}, nil
+}
+
+func (b *logsBackend) TendermintBlockByHash(common.Hash) (*coretypes.ResultBlock, error) {
+	return &coretypes.ResultBlock{Block: &tmtypes.Block{Header: tmtypes.Header{Height: b.head}}}, nil
+}
+
+func (b *logsBackend) TendermintBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error) {
+	b.fetched++
+
+	attrs := make([]abci.EventAttribute, b.logsPerBlock)
+	for i := range attrs {
+		bz, err := json.Marshal(&evmtypes.Log{
+			Address:     common.HexToAddress("0x1000000000000000000000000000000000000001").Hex(),
+			BlockNumber: uint64(*height),
+		})
+		require.NoError(b.t, err)
+		if b.malformed {
+			bz = []byte("{")
+		}
+		attrs[i] = abci.EventAttribute{Key: evmtypes.AttributeKeyTxLog, Value: string(bz)}
+	}
+
+	return &coretypes.ResultBlockResults{
+		Height: *height,
+		TxsResults: []*abci.ResponseDeliverTx{
+			{Events: []abci.Event{{Type: evmtypes.EventTypeTxLog, Attributes: attrs}}},
+		},
+	}, nil
+}
+
+func (b *logsBackend) BlockBloom(*coretypes.ResultBlockResults) (ethtypes.Bloom, error) {
+	return b.bloom, nil
+}
+
+// fullBloom returns a bloom with all the bits set, which matches any filter.
+func fullBloom() ethtypes.Bloom {
+	var bloom ethtypes.Bloom
+	for i := range bloom {
+		bloom[i] = 0xff
+	}
+	return bloom
+}
+
+func TestFilterLogsLimits(t *testing.T) {
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+	blockHash := common.HexToHash("0x01")
+
+	testCases := []struct {
+		name        string
+		newFilter   func(backend Backend) *Filter
+		ctx         func() context.Context
+		logLimit    int
+		blockLimit  int64
+		expLogs     int
+		expFetched  int
+		errContains string
+	}{
+		{
+			name: "pass - range within limits",
+			newFilter: func(backend Backend) *Filter {
+				return NewRangeFilter(logger, backend, 1, 5, nil, nil)
+			},
+			logLimit:   10,
+			blockLimit: 10,
+			expLogs:    10,
+			expFetched: 5,
+		},
+		{
+			name: "fail - block range exceeds the limit",
+			newFilter: func(backend Backend) *Filter {
+				return NewRangeFilter(logger, backend, 1, 10, nil, nil)
+			},
+			logLimit:    100,
+			blockLimit:  5,
+			expFetched:  0,
+			errContains: "maximum [from, to] blocks distance: 5",
+		},
+		{
+			name: "fail - stops at the first block exceeding the log limit",
+			newFilter: func(backend Backend) *Filter {
+				return NewRangeFilter(logger, backend, 1, 10, nil, nil)
+			},
+			logLimit:    5,
+			blockLimit:  100,
+			expFetched:  3,
+			errContains: "query returned more than 5 results",
+		},
+		{
+			name: "fail - context canceled",
+			newFilter: func(backend Backend) *Filter {
+				return NewRangeFilter(logger, backend, 1, 10, nil, nil)
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx
+			},
+			logLimit:    100,
+			blockLimit:  100,
+			expFetched:  0,
+			errContains: context.Canceled.Error(),
+		},
+		{
+			name: "pass - block hash within the log limit",
+			newFilter: func(backend Backend) *Filter {
+				return NewBlockFilter(logger, backend, filters.FilterCriteria{BlockHash: &blockHash})
+			},
+			logLimit:   2,
+			expLogs:    2,
+			expFetched: 1,
+		},
+		{
+			name: "fail - block hash exceeds the log limit",
+			newFilter: func(backend Backend) *Filter {
+				return NewBlockFilter(logger, backend, filters.FilterCriteria{BlockHash: &blockHash})
+			},
+			logLimit:    1,
+			expFetched:  1,
+			errContains: "query returned more than 1 results",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := &logsBackend{t: t, head: 10, logsPerBlock: 2, bloom: fullBloom()}
+
+			ctx := context.Background()
+			if tc.ctx != nil {
+				ctx = tc.ctx()
+			}
+
+			logs, err := tc.newFilter(backend).Logs(ctx, tc.logLimit, tc.blockLimit)
+			if tc.errContains == "" {
+				require.NoError(t, err)
+				require.Len(t, logs, tc.expLogs)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+				require.Nil(t, logs)
+			}
+			require.Equal(t, tc.expFetched, backend.fetched)
+		})
+	}
+}
+
+func TestFilterBloomMatch(t *testing.T) {
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	otherAddr := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	topic := common.HexToHash("0x01")
+	otherTopic := common.HexToHash("0x02")
+
+	bloom := ethtypes.CreateBloom(ethtypes.Receipts{
+		{Logs: []*ethtypes.Log{{Address: addr, Topics: []common.Hash{topic}}}},
+	})
+
+	testCases := []struct {
+		name      string
+		addresses []common.Address
+		topics    [][]common.Hash
+		expMatch  bool
+	}{
+		{"no criteria", nil, nil, true},
+		{"matching address", []common.Address{addr}, nil, true},
+		{"any of the addresses", []common.Address{otherAddr, addr}, nil, true},
+		{"non-matching address", []common.Address{otherAddr}, nil, false},
+		{"matching topic", nil, [][]common.Hash{{topic}}, true},
+		{"wildcard topic", []common.Address{addr}, [][]common.Hash{{}, {topic}}, true},
+		{"non-matching topic", []common.Address{addr}, [][]common.Hash{{otherTopic}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter := NewRangeFilter(logger, nil, 1, 1, tc.addresses, tc.topics)
+			require.Equal(t, tc.expMatch, filter.bloomMatch(bloom))
+			require.Equal(t, tc.expMatch, bloomFilter(bloom, tc.addresses, tc.topics))
+		})
+	}
+}
+
+func TestFilterLogsSkipsNonMatchingBlocks(t *testing.T) {
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	otherAddr := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	bloom := ethtypes.CreateBloom(ethtypes.Receipts{
+		{Logs: []*ethtypes.Log{{Address: addr}}},
+	})
+
+	testCases := []struct {
+		name      string
+		addresses []common.Address
+		expError  bool
+	}{
+		// the malformed logs of the blocks are never decoded
+		{"non-matching bloom", []common.Address{otherAddr}, false},
+		{"matching bloom", []common.Address{addr}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := &logsBackend{t: t, head: 10, logsPerBlock: 1, bloom: bloom, malformed: true}
+
+			filter := NewRangeFilter(logger, backend, 1, 10, tc.addresses, nil)
+			logs, err := filter.Logs(context.Background(), 100, 100)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Empty(t, logs)
+			require.Equal(t, 10, backend.fetched)
+		})
+	}
+}
+
+// BenchmarkFilterLogsSparse benchmarks a query over a 10k-block range in which no
+// block bloom matches the requested address.
+func BenchmarkFilterLogsSparse(b *testing.B) {
+	logger := log.NewNopLogger()
+	addresses := []common.Address{common.HexToAddress("0x1000000000000000000000000000000000000002")}
+	topics := [][]common.Hash{{common.HexToHash("0x01"), common.HexToHash("0x02")}}
+
+	backend := &logsBackend{
+		t:            b,
+		head:         10_000,
+		logsPerBlock: 10,
+		bloom: ethtypes.CreateBloom(ethtypes.Receipts{
+			{Logs: []*ethtypes.Log{{Address: common.HexToAddress("0x1000000000000000000000000000000000000001")}}},
+		}),
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		filter := NewRangeFilter(logger, backend, 1, 10_000, addresses, topics)
+		logs, err := filter.Logs(context.Background(), 10_000, 10_000)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(logs) != 0 {
+			b.Fatalf("expected no logs, got %d", len(logs))
+		}
+	}
+}
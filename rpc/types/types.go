@@ -54,23 +54,6 @@ type RPCTransaction struct {
 	S                *hexutil.Big         `json:"s"`
 }
 
-// StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
-
-// OverrideAccount indicates the overriding fields of account during the execution of
-// a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
-}
-
 type FeeHistoryResult struct {
 	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
 	Reward       [][]*hexutil.Big `json:"reward,omitempty"`
@@ -84,6 +67,19 @@ type SignTransactionResult struct {
 	Tx  *ethtypes.Transaction `json:"tx"`
 }
 
+// DryRunResult represents the would-be receipt of a raw transaction dry run.
+type DryRunResult struct {
+	TransactionHash common.Hash     `json:"transactionHash"`
+	From            common.Address  `json:"from"`
+	To              *common.Address `json:"to"`
+	Status          hexutil.Uint64  `json:"status"`
+	GasUsed         hexutil.Uint64  `json:"gasUsed"`
+	Logs            []*ethtypes.Log `json:"logs"`
+	ReturnData      hexutil.Bytes   `json:"returnData,omitempty"`
+	Error           string          `json:"error,omitempty"`
+	RevertReason    string          `json:"revertReason,omitempty"`
+}
+
 type OneFeeHistory struct {
 	BaseFee, NextBaseFee *big.Int   // base fee for each block
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
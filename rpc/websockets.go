@@ -373,7 +373,10 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 		}
 		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		if len(params) > 1 {
+			return api.subscribePendingTransactions(wsConn, subID, params[1])
+		}
+		return api.subscribePendingTransactions(wsConn, subID, nil)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
 	default:
@@ -621,7 +624,13 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID, extra interface{}) (pubsub.UnsubscribeFunc, error) {
+	addresses, err := parsePendingTxsFilter(extra)
+	if err != nil {
+		api.logger.Debug("invalid pending transactions filter", "type", fmt.Sprintf("%T", extra))
+		return nil, err
+	}
+
 	sub, unsubFn, err := api.events.SubscribePendingTxs()
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating block filter: %s")
@@ -646,6 +655,10 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 				}
 
 				for _, ethTx := range ethTxs {
+					if !pendingTxMatchesAddresses(ethTx, addresses) {
+						continue
+					}
+
 					// write to ws conn
 					res := &SubscriptionNotification{
 						Jsonrpc: "2.0",
@@ -679,6 +692,65 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return unsubFn, nil
 }
 
+// parsePendingTxsFilter parses the optional filter of the newPendingTransactions
+// subscription, which is an object with an address field holding either a
+// single address or an array of addresses. A nil filter matches every tx.
+func parsePendingTxsFilter(extra interface{}) ([]common.Address, error) {
+	if extra == nil {
+		return nil, nil
+	}
+
+	params, ok := extra.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid criteria")
+	}
+
+	switch address := params["address"].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if !common.IsHexAddress(address) {
+			return nil, errors.Errorf("invalid address %s", address)
+		}
+		return []common.Address{common.HexToAddress(address)}, nil
+	case []interface{}:
+		addresses := make([]common.Address, 0, len(address))
+		for _, addr := range address {
+			addrStr, ok := addr.(string)
+			if !ok || !common.IsHexAddress(addrStr) {
+				return nil, errors.Errorf("invalid address %v", addr)
+			}
+			addresses = append(addresses, common.HexToAddress(addrStr))
+		}
+		return addresses, nil
+	default:
+		return nil, errors.New("invalid addresses; must be address or array of addresses")
+	}
+}
+
+// pendingTxMatchesAddresses returns true if the sender or the recipient of the
+// given tx is one of the given addresses, or if no addresses are given. The
+// sender is recovered from the tx signature, since the From field is not set
+// on the txs decoded from the raw CometBFT txs.
+func pendingTxMatchesAddresses(ethTx *evmtypes.MsgEthereumTx, addresses []common.Address) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+
+	tx := ethTx.AsTransaction()
+	signer := ethtypes.LatestSignerForChainID(tx.ChainId())
+	// the sender is only matched if it can be recovered
+	from, err := ethtypes.Sender(signer, tx)
+	to := tx.To()
+	for _, address := range addresses {
+		if (err == nil && address == from) || (to != nil && address == *to) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (pubsub.UnsubscribeFunc, error) {
 	return nil, errors.New("syncing subscription is not implemented")
 }
@@ -0,0 +1,116 @@
+package rpc
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePendingTxsFilter(t *testing.T) {
+	addr1 := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	addr2 := common.HexToAddress("0x1000000000000000000000000000000000000002")
+
+	testCases := []struct {
+		name     string
+		extra    interface{}
+		expAddrs []common.Address
+		expPass  bool
+	}{
+		{"nil filter", nil, nil, true},
+		{"empty filter", map[string]interface{}{}, nil, true},
+		{"single address", map[string]interface{}{"address": addr1.Hex()}, []common.Address{addr1}, true},
+		{
+			"array of addresses",
+			map[string]interface{}{"address": []interface{}{addr1.Hex(), addr2.Hex()}},
+			[]common.Address{addr1, addr2},
+			true,
+		},
+		{"invalid filter type", addr1.Hex(), nil, false},
+		{"invalid address", map[string]interface{}{"address": "0xinvalid"}, nil, false},
+		{"invalid address in array", map[string]interface{}{"address": []interface{}{addr1.Hex(), 1}}, nil, false},
+		{"invalid address type", map[string]interface{}{"address": 1}, nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			addrs, err := parsePendingTxsFilter(tc.extra)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expAddrs, addrs)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPendingTxMatchesAddresses(t *testing.T) {
+	from, priv := utiltx.NewAddrKey()
+	to := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	other := common.HexToAddress("0x1000000000000000000000000000000000000003")
+	chainID := big.NewInt(9000)
+
+	// signAndDecode signs the tx and decodes it from its raw bytes, so that
+	// the From field is empty as on the txs received from CometBFT
+	signAndDecode := func(args *evmtypes.EvmTxArgs) *evmtypes.MsgEthereumTx {
+		msg := evmtypes.NewTx(args)
+		msg.From = from.Hex()
+		err := msg.Sign(ethtypes.LatestSignerForChainID(chainID), utiltx.NewSigner(priv))
+		require.NoError(t, err)
+
+		bz, err := msg.AsTransaction().MarshalBinary()
+		require.NoError(t, err)
+
+		decoded := &evmtypes.MsgEthereumTx{}
+		require.NoError(t, decoded.UnmarshalBinary(bz))
+		require.Empty(t, decoded.From)
+		return decoded
+	}
+
+	ethTx := signAndDecode(&evmtypes.EvmTxArgs{
+		ChainID:  chainID,
+		GasLimit: 21000,
+		GasPrice: big.NewInt(1),
+		To:       &to,
+	})
+
+	contractCreation := signAndDecode(&evmtypes.EvmTxArgs{
+		ChainID:  chainID,
+		GasLimit: 100000,
+		GasPrice: big.NewInt(1),
+	})
+
+	unsigned := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  chainID,
+		GasLimit: 21000,
+		GasPrice: big.NewInt(1),
+		To:       &to,
+	})
+
+	testCases := []struct {
+		name      string
+		ethTx     *evmtypes.MsgEthereumTx
+		addresses []common.Address
+		expMatch  bool
+	}{
+		{"no filter", ethTx, nil, true},
+		{"match sender", ethTx, []common.Address{from}, true},
+		{"match recipient", ethTx, []common.Address{other, to}, true},
+		{"no match", ethTx, []common.Address{other}, false},
+		{"contract creation - match sender", contractCreation, []common.Address{from}, true},
+		{"contract creation - no match", contractCreation, []common.Address{to}, false},
+		{"unsigned tx - match recipient", unsigned, []common.Address{to}, true},
+		{"unsigned tx - no sender match", unsigned, []common.Address{from}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expMatch, pendingTxMatchesAddresses(tc.ethTx, tc.addresses))
+		})
+	}
+}
@@ -59,6 +59,18 @@ const (
 	// DefaultMaxTxGasWanted is the default gas wanted for each eth tx returned in ante handler in check tx mode
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultPriceBump is the default minimum gas price bump percentage to replace a pending eth tx
+	DefaultPriceBump = 10
+
+	// DefaultRevertLogLevel is the default log level of the reverted eth txs (i.e disabled)
+	DefaultRevertLogLevel = "none"
+
+	// DefaultEnableReplayTx is the default value for the replay tx gRPC query (i.e disabled)
+	DefaultEnableReplayTx = false
+
+	// DefaultPrioritizeByTip is the default value for the ordering of the proposed txs by effective tip (i.e disabled)
+	DefaultPrioritizeByTip = false
+
 	// DefaultGasCap is the default cap on gas that can be used in eth_call/estimateGas
 	DefaultGasCap uint64 = 25000000
 
@@ -92,6 +104,9 @@ const (
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
 
+	// DefaultMaxBatchRequests is the default maximum number of requests in a single JSON-RPC batch (unlimited = 0)
+	DefaultMaxBatchRequests = 1000
+
 	// DefaultGasAdjustment value to use as default in gas-adjustment flag
 	DefaultGasAdjustment = 1.2
 
@@ -119,6 +134,8 @@ const (
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
 
+var revertLogLevels = []string{"none", "debug", "info", "error"}
+
 // Config defines the server's top level configuration. It includes the default app config
 // from the SDK as well as the EVM configuration to enable the JSON-RPC APIs.
 type Config struct {
@@ -138,6 +155,18 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// PriceBump defines the minimum gas price bump percentage required to replace
+	// an eth tx pending in the mempool with a tx with the same nonce.
+	PriceBump uint64 `mapstructure:"price-bump"`
+	// RevertLogLevel defines the log level at which the reverted eth txs are
+	// logged with their sender, recipient, gas used and revert reason.
+	RevertLogLevel string `mapstructure:"revert-log-level"`
+	// EnableReplayTx enables the gRPC query to replay past eth txs for debugging.
+	// It should not be enabled on public endpoints.
+	EnableReplayTx bool `mapstructure:"enable-replay-tx"`
+	// PrioritizeByTip orders the txs of the proposed blocks by their effective
+	// tip, instead of the order in which they were received by the mempool.
+	PrioritizeByTip bool `mapstructure:"prioritize-by-tip"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -174,6 +203,9 @@ type JSONRPCConfig struct {
 	// MaxOpenConnections sets the maximum number of simultaneous connections
 	// for the server listener.
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
+	// MaxBatchRequests sets the maximum number of requests in a single JSON-RPC
+	// batch sent to the HTTP server (0=unlimited).
+	MaxBatchRequests int `mapstructure:"max-batch-requests"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
 	// MetricsAddress defines the metrics server to listen on
@@ -244,17 +276,25 @@ func DefaultConfig() *Config {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:          DefaultEVMTracer,
+		MaxTxGasWanted:  DefaultMaxTxGasWanted,
+		PriceBump:       DefaultPriceBump,
+		RevertLogLevel:  DefaultRevertLogLevel,
+		EnableReplayTx:  DefaultEnableReplayTx,
+		PrioritizeByTip: DefaultPrioritizeByTip,
 	}
 }
 
-// Validate returns an error if the tracer type is invalid.
+// Validate returns an error if the tracer type or the revert log level is invalid.
 func (c EVMConfig) Validate() error {
 	if c.Tracer != "" && !strings.StringInSlice(c.Tracer, evmTracers) {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.RevertLogLevel != "" && !strings.StringInSlice(c.RevertLogLevel, revertLogLevels) {
+		return fmt.Errorf("invalid revert log level %s, available levels: %v", c.RevertLogLevel, revertLogLevels)
+	}
+
 	return nil
 }
 
@@ -286,6 +326,7 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		HTTPIdleTimeout:          DefaultHTTPIdleTimeout,
 		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
 		MaxOpenConnections:       DefaultMaxOpenConnections,
+		MaxBatchRequests:         DefaultMaxBatchRequests,
 		EnableIndexer:            false,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
@@ -330,6 +371,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.MaxBatchRequests < 0 {
+		return errors.New("JSON-RPC max batch requests cannot be negative")
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -16,6 +16,7 @@ func TestDefaultConfig(t *testing.T) {
 	require.False(t, cfg.JSONRPC.Enable)
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
+	require.Equal(t, cfg.JSONRPC.MaxBatchRequests, DefaultMaxBatchRequests)
 }
 
 func TestGetConfig(t *testing.T) {
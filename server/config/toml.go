@@ -22,6 +22,27 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# PriceBump defines the minimum gas price bump percentage required to replace an eth tx
+# pending in the mempool with a tx with the same nonce. Default: 10.
+price-bump = {{ .EVM.PriceBump }}
+
+# RevertLogLevel defines the log level at which the reverted eth txs are logged with their
+# sender, recipient, gas used, revert reason and call depth. Only the delivered txs are logged,
+# and the logging is disabled if the node log level filters out this level for the evm module.
+# Recording the call depth traces the execution of every delivered eth tx.
+# Valid levels are: none|debug|info|error. Default: none (disabled).
+revert-log-level = "{{ .EVM.RevertLogLevel }}"
+
+# EnableReplayTx enables the gRPC query and the 'debug_replayTransaction' JSON-RPC method
+# that replay past eth txs against the state of their block. It should not be enabled
+# on public endpoints.
+enable-replay-tx = {{ .EVM.EnableReplayTx }}
+
+# PrioritizeByTip orders the txs of the blocks proposed by the node by their effective tip,
+# as Ethereum nodes do, instead of the order in which they were received by the mempool.
+# The txs of a same sender keep their nonce order.
+prioritize-by-tip = {{ .EVM.PrioritizeByTip }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -76,6 +97,10 @@ allow-unprotected-txs = {{ .JSONRPC.AllowUnprotectedTxs }}
 # for the server listener.
 max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 
+# MaxBatchRequests sets the maximum number of requests in a single JSON-RPC batch
+# sent to the HTTP server (0=unlimited).
+max-batch-requests = {{ .JSONRPC.MaxBatchRequests }}
+
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
@@ -51,6 +51,7 @@ const (
 	JSONRPCHTTPIdleTimeout     = "json-rpc.http-idle-timeout"
 	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
+	JSONRPCMaxBatchRequests    = "json-rpc.max-batch-requests"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
@@ -61,8 +62,12 @@ const (
 
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTracer          = "evm.tracer"
+	EVMMaxTxGasWanted  = "evm.max-tx-gas-wanted"
+	EVMPriceBump       = "evm.price-bump"
+	EVMRevertLogLevel  = "evm.revert-log-level"
+	EVMEnableReplayTx  = "evm.enable-replay-tx"
+	EVMPrioritizeByTip = "evm.prioritize-by-tip"
 )
 
 // TLS flags
@@ -3,7 +3,9 @@
 package server
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -13,8 +15,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/evmos/evmos/v16/indexer"
+	"github.com/evmos/evmos/v16/server/config"
 )
 
+// backfillLogInterval is the number of blocks between the progress logs of the
+// EVM indexer backfill
+const backfillLogInterval = 1000
+
 func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "index-eth-tx [backward|forward]",
@@ -39,37 +46,18 @@ func NewIndexTxCmd() *cobra.Command {
 				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
 			}
 
-			cfg := serverCtx.Config
-			home := cfg.RootDir
-			logger := serverCtx.Logger
-			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
-			if err != nil {
-				logger.Error("failed to open evm indexer DB", "error", err.Error())
-				return err
-			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
-
-			// open local tendermint db, because the local rpc won't be available.
-			tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
+			idxer, stores, err := openIndexerStores(serverCtx, clientCtx)
 			if err != nil {
 				return err
 			}
-			blockStore := tmstore.NewBlockStore(tmdb)
-
-			stateDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "state", Config: cfg})
-			if err != nil {
-				return err
-			}
-			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
-				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
-			})
+			blockStore := stores.blockStore
 
 			indexBlock := func(height int64) error {
 				blk := blockStore.LoadBlock(height)
 				if blk == nil {
 					return fmt.Errorf("block not found %d", height)
 				}
-				resBlk, err := stateStore.LoadABCIResponses(height)
+				resBlk, err := stores.stateStore.LoadABCIResponses(height)
 				if err != nil {
 					return err
 				}
@@ -118,3 +106,169 @@ func NewIndexTxCmd() *cobra.Command {
 	}
 	return cmd
 }
+
+// NewEVMIndexerCmd returns the command to manage the EVM tx indexer
+func NewEVMIndexerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evm-indexer",
+		Short: "Manage the EVM tx indexer",
+	}
+	cmd.AddCommand(NewBackfillCmd())
+	return cmd
+}
+
+// NewBackfillCmd returns the command to backfill the EVM tx indexer with the
+// historical blocks stored by the node
+func NewBackfillCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backfill [start] [end]",
+		Short: "Backfill the EVM tx indexer with historical blocks",
+		Long: `Backfill the EVM tx indexer by replaying the results of the blocks within [start, end] stored by the node.
+If omitted, start defaults to the earliest stored block and end to the latest one.
+
+The backfill progress is stored in the indexer db, so running the command again with the same block range
+resumes an interrupted backfill. The node must be stopped while backfilling to avoid write conflicts with the live indexer.
+`,
+		Args: cobra.RangeArgs(0, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := checkNotLiveIndexing(serverCtx, clientCtx); err != nil {
+				return err
+			}
+
+			logger := serverCtx.Logger
+			idxer, stores, err := openIndexerStores(serverCtx, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			start, end := stores.blockStore.Base(), stores.blockStore.Height()
+			if start == 0 {
+				return fmt.Errorf("no blocks stored by the node")
+			}
+			if len(args) > 0 {
+				if start, err = parseBackfillHeight(args[0], start, end); err != nil {
+					return err
+				}
+			}
+			if len(args) > 1 {
+				if end, err = parseBackfillHeight(args[1], start, end); err != nil {
+					return err
+				}
+			}
+
+			progress, err := idxer.BackfillProgress(start, end)
+			if err != nil {
+				return err
+			}
+			from := start
+			if progress != -1 {
+				from = progress + 1
+				logger.Info("resuming EVM indexer backfill", "start", start, "end", end, "from", from)
+			} else {
+				logger.Info("starting EVM indexer backfill", "start", start, "end", end)
+			}
+
+			for height := from; height <= end; height++ {
+				blk := stores.blockStore.LoadBlock(height)
+				if blk == nil {
+					return fmt.Errorf("block not found %d", height)
+				}
+				resBlk, err := stores.stateStore.LoadABCIResponses(height)
+				if err != nil {
+					return err
+				}
+				if err := idxer.IndexBlock(blk, resBlk.DeliverTxs); err != nil {
+					return err
+				}
+				if err := idxer.SetBackfillProgress(start, end, height); err != nil {
+					return err
+				}
+
+				if (height-start+1)%backfillLogInterval == 0 {
+					logger.Info("EVM indexer backfill progress", "height", height, "end", end)
+				}
+			}
+
+			if err := idxer.DeleteBackfillProgress(start, end); err != nil {
+				return err
+			}
+			logger.Info("EVM indexer backfill completed", "start", start, "end", end)
+			return nil
+		},
+	}
+	return cmd
+}
+
+// indexerStores holds the local stores the historical blocks are read from.
+type indexerStores struct {
+	blockStore *tmstore.BlockStore
+	stateStore sm.Store
+}
+
+// openIndexerStores opens the EVM indexer db together with the local block and
+// state stores, because the local rpc won't be available.
+func openIndexerStores(serverCtx *server.Context, clientCtx client.Context) (*indexer.KVIndexer, indexerStores, error) {
+	cfg := serverCtx.Config
+	logger := serverCtx.Logger
+
+	idxDB, err := OpenIndexerDB(cfg.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+	if err != nil {
+		logger.Error("failed to open evm indexer DB", "error", err.Error())
+		return nil, indexerStores{}, fmt.Errorf("failed to open evm indexer DB, make sure the node is stopped: %w", err)
+	}
+	idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
+
+	tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return nil, indexerStores{}, err
+	}
+
+	stateDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return nil, indexerStores{}, err
+	}
+
+	return idxer, indexerStores{
+		blockStore: tmstore.NewBlockStore(tmdb),
+		stateStore: sm.NewStore(stateDB, sm.StoreOptions{
+			DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+		}),
+	}, nil
+}
+
+// checkNotLiveIndexing returns an error if the node is running with the EVM
+// indexer enabled, in which case both would write to the indexer db.
+func checkNotLiveIndexing(serverCtx *server.Context, clientCtx client.Context) error {
+	appCfg, err := config.GetConfig(serverCtx.Viper)
+	if err != nil {
+		return err
+	}
+
+	if !appCfg.JSONRPC.EnableIndexer || clientCtx.Client == nil {
+		return nil
+	}
+
+	if _, err := clientCtx.Client.Status(context.Background()); err == nil {
+		return fmt.Errorf("the node is running with the EVM indexer enabled, stop it before backfilling")
+	}
+	return nil
+}
+
+// parseBackfillHeight parses a block height and checks that it is within the
+// range of blocks stored by the node.
+func parseBackfillHeight(arg string, base, latest int64) (int64, error) {
+	height, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid block height %s: %w", arg, err)
+	}
+	if height < base || height > latest {
+		return 0, fmt.Errorf("block height %d is out of the stored block range [%d, %d]", height, base, latest)
+	}
+	return height, nil
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBackfillHeight(t *testing.T) {
+	testCases := []struct {
+		name      string
+		arg       string
+		expHeight int64
+		expErr    string
+	}{
+		{"within the stored range", "50", 50, ""},
+		{"first stored block", "10", 10, ""},
+		{"latest stored block", "100", 100, ""},
+		{"invalid height", "abc", 0, "invalid block height abc"},
+		{"before the first stored block", "9", 0, "block height 9 is out of the stored block range [10, 100]"},
+		{"after the latest stored block", "101", 0, "block height 101 is out of the stored block range [10, 100]"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			height, err := parseBackfillHeight(tc.arg, 10, 100)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.expHeight, height)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
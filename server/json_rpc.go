@@ -3,6 +3,10 @@
 package server
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -62,7 +66,7 @@ func StartJSONRPC(ctx *server.Context,
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", batchLimitHandler(rpcServer, config.JSONRPC.MaxBatchRequests)).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -113,3 +117,50 @@ func StartJSONRPC(ctx *server.Context,
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
+
+// maxRequestContentLength is the maximum size of a request body read by the
+// go-ethereum RPC server.
+const maxRequestContentLength = 1024 * 1024 * 5
+
+// batchLimitHandler wraps the given JSON-RPC handler so that batches with more
+// than maxBatchRequests requests are rejected with a JSON-RPC error before any
+// of their requests is decoded and executed. A limit of 0 disables the check.
+func batchLimitHandler(next http.Handler, maxBatchRequests int) http.Handler {
+	if maxBatchRequests <= 0 {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength+1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// restore the body for the RPC server, which handles oversized and
+		// malformed requests on its own
+		r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+
+		trimmed := bytes.TrimLeft(body, " \t\r\n")
+		if len(trimmed) == 0 || trimmed[0] != '[' {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var batch []json.RawMessage
+		if err := json.Unmarshal(trimmed, &batch); err != nil || len(batch) <= maxBatchRequests {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      nil,
+			"error": map[string]interface{}{
+				"code":    -32600, // invalid request
+				"message": fmt.Sprintf("batch of %d requests exceeds the limit of %d", len(batch), maxBatchRequests),
+			},
+		})
+	})
+}
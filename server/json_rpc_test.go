@@ -0,0 +1,70 @@
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchLimitHandler(t *testing.T) {
+	call := `{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`
+	batch := func(n int) string {
+		calls := make([]string, n)
+		for i := range calls {
+			calls[i] = call
+		}
+		return "[" + strings.Join(calls, ",") + "]"
+	}
+
+	testCases := []struct {
+		name      string
+		limit     int
+		body      string
+		expServed bool
+	}{
+		{"single request", 2, call, true},
+		{"batch within the limit", 2, batch(2), true},
+		{"batch exceeding the limit", 2, " \n" + batch(3), false},
+		{"unlimited batch", 0, batch(3), true},
+		{"malformed batch is left to the server", 2, "[1,", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var servedBody string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				bz, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				servedBody = string(bz)
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body))
+			batchLimitHandler(next, tc.limit).ServeHTTP(rec, req)
+
+			if tc.expServed {
+				require.Equal(t, tc.body, servedBody)
+				return
+			}
+
+			require.Empty(t, servedBody)
+
+			var res struct {
+				ID    interface{} `json:"id"`
+				Error struct {
+					Code    int    `json:"code"`
+					Message string `json:"message"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+			require.Nil(t, res.ID)
+			require.Equal(t, -32600, res.Error.Code)
+			require.Equal(t, "batch of 3 requests exceeds the limit of 2", res.Error.Message)
+		})
+	}
+}
@@ -197,11 +197,16 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, config.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCMaxBatchRequests, config.DefaultMaxBatchRequests, "Sets the maximum number of requests in a single JSON-RPC batch (0=unlimited)")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMPriceBump, config.DefaultPriceBump, "the minimum gas price bump percentage required to replace a pending eth tx with the same nonce")
+	cmd.Flags().String(srvflags.EVMRevertLogLevel, config.DefaultRevertLogLevel, "the log level at which the reverted eth txs are logged (none|debug|info|error)")
+	cmd.Flags().Bool(srvflags.EVMEnableReplayTx, config.DefaultEnableReplayTx, "enable the gRPC query to replay past eth txs for debugging")
+	cmd.Flags().Bool(srvflags.EVMPrioritizeByTip, config.DefaultPrioritizeByTip, "order the txs of the proposed blocks by their effective tip")
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -154,6 +154,9 @@ func CheckEthTxResponse(r abci.ResponseDeliverTx, cdc codec.Codec) ([]*evm.MsgEt
 		}
 
 		if res.Failed() {
+			if reason, err := evm.UnpackRevertReason(res.Revert()); err == nil {
+				return nil, fmt.Errorf("tx failed. VmError: %s, Reason: %s", res.VmError, reason)
+			}
 			return nil, fmt.Errorf("tx failed. VmError: %s", res.VmError)
 		}
 		responses = append(responses, &res)
@@ -0,0 +1,60 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package factory
+
+import (
+	"fmt"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DecodeMsgResponses decodes the responses of the messages of the given tx
+// result into the given responses, which must be in the order of the messages.
+func DecodeMsgResponses(res abcitypes.ResponseDeliverTx, responses ...proto.Message) error {
+	var txMsgData sdktypes.TxMsgData
+	if err := proto.Unmarshal(res.Data, &txMsgData); err != nil {
+		return errorsmod.Wrap(err, "failed to unmarshal tx msg data")
+	}
+
+	if len(txMsgData.MsgResponses) != len(responses) {
+		return fmt.Errorf("expected %d msg responses, got %d", len(responses), len(txMsgData.MsgResponses))
+	}
+
+	for i, msgResponse := range txMsgData.MsgResponses {
+		if typeURL := "/" + proto.MessageName(responses[i]); msgResponse.TypeUrl != typeURL {
+			return fmt.Errorf("expected msg response %d of type %s, got %s", i, typeURL, msgResponse.TypeUrl)
+		}
+		if err := proto.Unmarshal(msgResponse.Value, responses[i]); err != nil {
+			return errorsmod.Wrapf(err, "failed to unmarshal msg response %d", i)
+		}
+	}
+
+	return nil
+}
+
+// DecodeTypedEvent decodes the first of the given events that is of the type of
+// the given typed event into it. Typed events are the events emitted with
+// EmitTypedEvent, whose type is the proto message name of the event.
+func DecodeTypedEvent(events []abcitypes.Event, event proto.Message) error {
+	eventType := proto.MessageName(event)
+	for _, e := range events {
+		if e.Type != eventType {
+			continue
+		}
+
+		msg, err := sdktypes.ParseTypedEvent(e)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to parse typed event %s", eventType)
+		}
+
+		proto.Merge(event, msg)
+		return nil
+	}
+
+	return fmt.Errorf("typed event %s not found", eventType)
+}
@@ -4,8 +4,11 @@
 package factory
 
 import (
+	"fmt"
+
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	testutiltypes "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
@@ -25,6 +28,9 @@ type TxFactory interface {
 	BuildCosmosTx(privKey cryptotypes.PrivKey, txArgs CosmosTxArgs) (signing.Tx, error)
 	// ExecuteCosmosTx builds, signs and broadcasts a Cosmos tx with the provided private key and txArgs
 	ExecuteCosmosTx(privKey cryptotypes.PrivKey, txArgs CosmosTxArgs) (abcitypes.ResponseDeliverTx, error)
+	// ExecuteCosmosMsgs builds, signs and broadcasts a Cosmos tx with the provided messages
+	// and the default tx args. It returns an error if the tx failed.
+	ExecuteCosmosMsgs(privKey cryptotypes.PrivKey, msgs ...sdktypes.Msg) (abcitypes.ResponseDeliverTx, error)
 }
 
 var _ TxFactory = (*IntegrationTxFactory)(nil)
@@ -72,3 +78,17 @@ func (tf *IntegrationTxFactory) ExecuteCosmosTx(privKey cryptotypes.PrivKey, txA
 
 	return tf.network.BroadcastTxSync(txBytes)
 }
+
+// ExecuteCosmosMsgs creates, signs and broadcasts a Cosmos transaction with the
+// given messages, returning an error if the transaction failed
+func (tf *IntegrationTxFactory) ExecuteCosmosMsgs(privKey cryptotypes.PrivKey, msgs ...sdktypes.Msg) (abcitypes.ResponseDeliverTx, error) {
+	res, err := tf.ExecuteCosmosTx(privKey, CosmosTxArgs{Msgs: msgs})
+	if err != nil {
+		return res, err
+	}
+
+	if !res.IsOK() {
+		return res, fmt.Errorf("tx failed with code %d: %s", res.Code, res.Log)
+	}
+	return res, nil
+}
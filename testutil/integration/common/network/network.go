@@ -13,6 +13,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 )
@@ -29,14 +30,18 @@ type Network interface {
 
 	NextBlock() error
 	NextBlockAfter(duration time.Duration) error
+	NextBlockAt(blockTime time.Time) error
+	WaitUntil(predicate func() (bool, error), maxBlocks int) error
 
 	// Clients
 	GetAuthClient() authtypes.QueryClient
 	GetAuthzClient() authz.QueryClient
 	GetBankClient() banktypes.QueryClient
 	GetStakingClient() stakingtypes.QueryClient
+	GetDistrClient() distrtypes.QueryClient
 
 	BroadcastTxSync(txBytes []byte) (abcitypes.ResponseDeliverTx, error)
+	CheckTx(txBytes []byte) (abcitypes.ResponseCheckTx, error)
 	Simulate(txBytes []byte) (*txtypes.SimulateResponse, error)
 
 	// GetIBCChain returns the IBC test chain.
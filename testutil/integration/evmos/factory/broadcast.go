@@ -3,15 +3,25 @@
 package factory
 
 import (
+	"errors"
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v16/contracts"
 	"github.com/evmos/evmos/v16/precompiles/testutil"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 )
 
+// defaultRevertGasLimit is the gas limit used for the txs expected to revert when
+// none is provided, since their gas cannot be estimated.
+const defaultRevertGasLimit uint64 = 1_000_000
+
 // ExecuteEthTx executes an Ethereum transaction - contract call with the provided private key and txArgs
 // It first builds a MsgEthereumTx and then broadcasts it to the network.
 func (tf *IntegrationTxFactory) ExecuteEthTx(
@@ -39,6 +49,26 @@ func (tf *IntegrationTxFactory) ExecuteEthTx(
 	return res, nil
 }
 
+// CheckEthTx builds and signs an Ethereum transaction with the provided private key and txArgs
+// and runs it through the CheckTx ABCI method of the network. The response is returned
+// so that the ante handler results can be asserted without committing a block.
+func (tf *IntegrationTxFactory) CheckEthTx(
+	priv cryptotypes.PrivKey,
+	txArgs evmtypes.EvmTxArgs,
+) (abcitypes.ResponseCheckTx, error) {
+	signedMsg, err := tf.GenerateSignedEthTx(priv, txArgs)
+	if err != nil {
+		return abcitypes.ResponseCheckTx{}, errorsmod.Wrap(err, "failed to generate signed ethereum tx")
+	}
+
+	txBytes, err := tf.encodeTx(signedMsg)
+	if err != nil {
+		return abcitypes.ResponseCheckTx{}, errorsmod.Wrap(err, "failed to encode ethereum tx")
+	}
+
+	return tf.network.CheckTx(txBytes)
+}
+
 // ExecuteContractCall executes a contract call with the provided private key.
 func (tf *IntegrationTxFactory) ExecuteContractCall(privKey cryptotypes.PrivKey, txArgs evmtypes.EvmTxArgs, callArgs CallArgs) (abcitypes.ResponseDeliverTx, error) {
 	completeTxArgs, err := tf.GenerateContractCallArgs(txArgs, callArgs)
@@ -49,6 +79,120 @@ func (tf *IntegrationTxFactory) ExecuteContractCall(privKey cryptotypes.PrivKey,
 	return tf.ExecuteEthTx(privKey, completeTxArgs)
 }
 
+// CallContract calls the given method of the contract deployed at contractAddr with the
+// provided private key and arguments. The gas limit is estimated before broadcasting the tx.
+//
+// It returns the decoded Ethereum Tx response. If the call was reverted, the returned
+// error contains the decoded revert reason.
+func (tf *IntegrationTxFactory) CallContract(
+	priv cryptotypes.PrivKey,
+	contractAddr common.Address,
+	contractABI abi.ABI,
+	method string,
+	args ...interface{},
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	txArgs, err := tf.GenerateContractCallArgs(
+		evmtypes.EvmTxArgs{To: &contractAddr},
+		CallArgs{ContractABI: contractABI, MethodName: method, Args: args},
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to generate contract call args")
+	}
+
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	txArgs.GasLimit, err = tf.estimateGasLimit(from, &txArgs)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to estimate gas limit")
+	}
+
+	signedMsg, err := tf.GenerateSignedEthTx(priv, txArgs)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to generate signed ethereum tx")
+	}
+
+	txBytes, err := tf.encodeTx(signedMsg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to encode ethereum tx")
+	}
+
+	res, err := tf.network.BroadcastTxSync(txBytes)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to broadcast ethereum tx")
+	}
+
+	if !res.IsOK() {
+		return nil, fmt.Errorf("tx failed. Code: %d, Logs: %s", res.Code, res.Log)
+	}
+
+	ethRes, err := evmtypes.DecodeTxResponse(res.Data)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to decode ethereum tx response")
+	}
+
+	if ethRes.Failed() {
+		if ethRes.VmError == vm.ErrExecutionReverted.Error() {
+			return ethRes, evmtypes.NewExecErrorWithReason(ethRes.Ret)
+		}
+		return ethRes, errors.New(ethRes.VmError)
+	}
+
+	return ethRes, nil
+}
+
+// ExpectRevert builds, signs and broadcasts an Ethereum tx with the provided private key
+// and txArgs, and checks that the tx was included in the block but reverted with the
+// expected reason. It returns an error if the tx was not included, did not revert or
+// reverted with a different reason.
+//
+// Since the gas estimation of a reverting tx fails, the gas limit defaults to
+// defaultRevertGasLimit if it is not set in the txArgs.
+func (tf *IntegrationTxFactory) ExpectRevert(
+	priv cryptotypes.PrivKey,
+	txArgs evmtypes.EvmTxArgs,
+	expectedReason string,
+) error {
+	if txArgs.GasLimit == 0 {
+		txArgs.GasLimit = defaultRevertGasLimit
+	}
+
+	signedMsg, err := tf.GenerateSignedEthTx(priv, txArgs)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to generate signed ethereum tx")
+	}
+
+	txBytes, err := tf.encodeTx(signedMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to encode ethereum tx")
+	}
+
+	res, err := tf.network.BroadcastTxSync(txBytes)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to broadcast ethereum tx")
+	}
+
+	ethRes, err := tf.decodeEthTxResponse(&res)
+	if err != nil {
+		return errorsmod.Wrap(err, "tx was not included")
+	}
+
+	if ethRes.VmError != vm.ErrExecutionReverted.Error() {
+		if ethRes.Failed() {
+			return fmt.Errorf("expected tx to revert, but it failed with: %s", ethRes.VmError)
+		}
+		return errors.New("expected tx to revert, but it succeeded")
+	}
+
+	reason, err := evmtypes.UnpackRevertReason(ethRes.Ret)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to decode revert reason")
+	}
+
+	if reason != expectedReason {
+		return fmt.Errorf("expected revert reason %q, got %q", expectedReason, reason)
+	}
+	return nil
+}
+
 // DeployContract deploys a contract with the provided private key,
 // compiled contract data and constructor arguments.
 // TxArgs Input and Nonce fields are overwritten.
@@ -71,6 +215,23 @@ func (tf *IntegrationTxFactory) DeployContract(
 	return crypto.CreateAddress(from, completeTxArgs.Nonce), nil
 }
 
+// DeployERC20 deploys the standard ERC20 test contract with the provided private key and
+// constructor arguments. It returns the address of the deployed contract or an error if
+// the deployment transaction failed or was reverted.
+func (tf *IntegrationTxFactory) DeployERC20(
+	priv cryptotypes.PrivKey,
+	deployArgs ERC20DeployArgs,
+) (common.Address, error) {
+	return tf.DeployContract(
+		priv,
+		evmtypes.EvmTxArgs{},
+		ContractDeploymentData{
+			Contract:        contracts.ERC20MinterBurnerDecimalsContract,
+			ConstructorArgs: []interface{}{deployArgs.Name, deployArgs.Symbol, deployArgs.Decimals},
+		},
+	)
+}
+
 // CallContractAndCheckLogs is a helper function to call a contract and check the logs using
 // the integration test utilities.
 //
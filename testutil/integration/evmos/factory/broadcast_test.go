@@ -0,0 +1,254 @@
+package factory_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v16/contracts"
+	commonfactory "github.com/evmos/evmos/v16/testutil/integration/common/factory"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/factory"
+	grpchandler "github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpectRevert(t *testing.T) {
+	keyring := testkeyring.New(1)
+	nw := network.New(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	handler := grpchandler.NewIntegrationHandler(nw)
+	tf := factory.New(nw, handler)
+
+	sender := keyring.GetKey(0)
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	contractAddr, err := tf.DeployERC20(sender.Priv, factory.ERC20DeployArgs{
+		Name:     "Test",
+		Symbol:   "TEST",
+		Decimals: 18,
+	})
+	require.NoError(t, err, "failed to deploy contract")
+	require.NoError(t, nw.NextBlock(), "failed to advance block")
+
+	testcases := []struct {
+		name        string
+		method      string
+		args        []interface{}
+		reason      string
+		errContains string
+	}{
+		{
+			name:   "pass - reverted with the expected reason",
+			method: "transfer",
+			args:   []interface{}{contractAddr, big.NewInt(1)},
+			reason: "ERC20: transfer amount exceeds balance",
+		},
+		{
+			name:        "fail - reverted with a different reason",
+			method:      "transfer",
+			args:        []interface{}{contractAddr, big.NewInt(1)},
+			reason:      "other reason",
+			errContains: "expected revert reason \"other reason\", got \"ERC20: transfer amount exceeds balance\"",
+		},
+		{
+			name:        "fail - tx succeeded",
+			method:      "transfer",
+			args:        []interface{}{contractAddr, big.NewInt(0)},
+			reason:      "ERC20: transfer amount exceeds balance",
+			errContains: "expected tx to revert, but it succeeded",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			txArgs, err := tf.GenerateContractCallArgs(
+				evmtypes.EvmTxArgs{To: &contractAddr},
+				factory.CallArgs{ContractABI: erc20ABI, MethodName: tc.method, Args: tc.args},
+			)
+			require.NoError(t, err, "failed to generate contract call args")
+
+			err = tf.ExpectRevert(sender.Priv, txArgs, tc.reason)
+			if tc.errContains != "" {
+				require.ErrorContains(t, err, tc.errContains)
+			} else {
+				require.NoError(t, err, "unexpected error")
+			}
+			require.NoError(t, nw.NextBlock(), "failed to advance block")
+		})
+	}
+}
+
+func TestExecuteCosmosMsgs(t *testing.T) {
+	keyring := testkeyring.New(2)
+	nw := network.New(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	handler := grpchandler.NewIntegrationHandler(nw)
+	tf := factory.New(nw, handler)
+
+	granter := keyring.GetKey(0)
+	grantee := keyring.GetKey(1)
+
+	expiration := nw.GetContext().BlockTime().Add(time.Hour)
+	msgGrant, err := authz.NewMsgGrant(
+		granter.AccAddr,
+		grantee.AccAddr,
+		authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{})),
+		&expiration,
+	)
+	require.NoError(t, err, "failed to build grant msg")
+
+	msgSubmitProposal, err := govv1beta1.NewMsgSubmitProposal(
+		govv1beta1.NewTextProposal("test", "test proposal"),
+		sdk.NewCoins(sdk.NewCoin(nw.GetDenom(), sdkmath.NewInt(1e18))),
+		granter.AccAddr,
+	)
+	require.NoError(t, err, "failed to build proposal msg")
+
+	res, err := tf.ExecuteCosmosMsgs(granter.Priv, msgGrant, msgSubmitProposal)
+	require.NoError(t, err, "failed to execute cosmos msgs")
+
+	var (
+		grantRes    authz.MsgGrantResponse
+		proposalRes govv1beta1.MsgSubmitProposalResponse
+	)
+	err = commonfactory.DecodeMsgResponses(res, &grantRes, &proposalRes)
+	require.NoError(t, err, "failed to decode msg responses")
+	require.Equal(t, uint64(1), proposalRes.ProposalId)
+
+	err = commonfactory.DecodeMsgResponses(res, &proposalRes, &grantRes)
+	require.ErrorContains(t, err, "expected msg response 0 of type /cosmos.gov.v1beta1.MsgSubmitProposalResponse")
+
+	var eventGrant authz.EventGrant
+	err = commonfactory.DecodeTypedEvent(res.Events, &eventGrant)
+	require.NoError(t, err, "failed to decode grant event")
+	require.Equal(t, granter.AccAddr.String(), eventGrant.Granter)
+	require.Equal(t, grantee.AccAddr.String(), eventGrant.Grantee)
+
+	err = commonfactory.DecodeTypedEvent(res.Events, &authz.EventRevoke{})
+	require.ErrorContains(t, err, "typed event cosmos.authz.v1beta1.EventRevoke not found")
+
+	// the failed txs are returned as an error
+	balance, err := handler.GetBalance(grantee.AccAddr, nw.GetDenom())
+	require.NoError(t, err, "failed to get balance")
+	msgSend := banktypes.NewMsgSend(grantee.AccAddr, granter.AccAddr, sdk.NewCoins(balance.Balance.AddAmount(sdkmath.OneInt())))
+	_, err = tf.ExecuteCosmosMsgs(grantee.Priv, msgSend)
+	require.ErrorContains(t, err, "insufficient funds")
+}
+
+func TestCheckEthTx(t *testing.T) {
+	keyring := testkeyring.New(1)
+	nw := network.New(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	handler := grpchandler.NewIntegrationHandler(nw)
+	tf := factory.New(nw, handler)
+
+	sender := keyring.GetKey(0)
+	recipient := keyring.GetAddr(0)
+	// the check state still uses the previous base fee,
+	// so leave some room above the queried one
+	gasFeeCap := big.NewInt(10_000_000_000)
+
+	// NOTE: the passing case goes last since a successful CheckTx
+	// bumps the sender sequence on the check state.
+	testcases := []struct {
+		name        string
+		txArgs      evmtypes.EvmTxArgs
+		errContains string
+	}{
+		{
+			name:        "fail - nonce too high",
+			txArgs:      evmtypes.EvmTxArgs{To: &recipient, Amount: big.NewInt(1), GasFeeCap: gasFeeCap, Nonce: 5},
+			errContains: "invalid nonce",
+		},
+		{
+			name: "fail - gas fee cap below the base fee",
+			txArgs: evmtypes.EvmTxArgs{
+				To:        &recipient,
+				Amount:    big.NewInt(1),
+				GasFeeCap: big.NewInt(1),
+				GasTipCap: big.NewInt(1),
+			},
+			errContains: "max fee per gas less than block base fee",
+		},
+		{
+			name:   "pass - valid tx",
+			txArgs: evmtypes.EvmTxArgs{To: &recipient, Amount: big.NewInt(1), GasFeeCap: gasFeeCap},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := tf.CheckEthTx(sender.Priv, tc.txArgs)
+			require.NoError(t, err, "failed to check tx")
+
+			if tc.errContains == "" {
+				require.True(t, res.IsOK(), "expected tx to pass: %s", res.Log)
+			} else {
+				require.False(t, res.IsOK(), "expected tx to fail")
+				require.Contains(t, res.Log, tc.errContains)
+			}
+		})
+	}
+
+	// CheckTx does not commit the tx
+	require.NoError(t, nw.NextBlock(), "failed to advance block")
+	acc, err := handler.GetEvmAccount(sender.Addr)
+	require.NoError(t, err, "failed to get account")
+	require.Equal(t, uint64(0), acc.GetNonce(), "expected nonce to be unchanged")
+}
+
+func TestDeployERC20(t *testing.T) {
+	keyring := testkeyring.New(1)
+	nw := network.New(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	handler := grpchandler.NewIntegrationHandler(nw)
+	tf := factory.New(nw, handler)
+
+	sender := keyring.GetKey(0)
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	acc, err := handler.GetEvmAccount(sender.Addr)
+	require.NoError(t, err, "failed to get account")
+
+	contractAddr, err := tf.DeployERC20(sender.Priv, factory.ERC20DeployArgs{
+		Name:     "Test",
+		Symbol:   "TEST",
+		Decimals: 6,
+	})
+	require.NoError(t, err, "failed to deploy contract")
+	require.NoError(t, nw.NextBlock(), "failed to advance block")
+	require.Equal(t, crypto.CreateAddress(sender.Addr, acc.GetNonce()), contractAddr)
+
+	_, code, _, err := handler.GetEvmAccountState(contractAddr)
+	require.NoError(t, err, "failed to get contract state")
+	require.NotEmpty(t, code, "expected contract code to be stored")
+
+	expValues := map[string]interface{}{
+		"name":     "Test",
+		"symbol":   "TEST",
+		"decimals": uint8(6),
+	}
+	for method, expValue := range expValues {
+		res, err := tf.CallContractAtHeight(sender.Addr, contractAddr, erc20ABI, method, 0)
+		require.NoError(t, err, "failed to call %s", method)
+
+		out, err := erc20ABI.Unpack(method, res.Ret)
+		require.NoError(t, err, "failed to unpack %s", method)
+		require.Equal(t, expValue, out[0], "unexpected %s", method)
+	}
+}
@@ -5,19 +5,27 @@ package factory
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/evmos/evmos/v16/server/config"
+	"github.com/evmos/evmos/v16/testutil/tx"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 )
 
+// blobTxType is the EIP-4844 transaction type. It is not defined in the go-ethereum
+// fork used by Evmos, which does not include the Cancun upgrade yet.
+const blobTxType = 0x03
+
 func (tf *IntegrationTxFactory) GenerateDefaultTxTypeArgs(sender common.Address, txType int) (evmtypes.EvmTxArgs, error) {
 	defaultArgs := evmtypes.EvmTxArgs{}
 	switch txType {
@@ -28,29 +36,67 @@ func (tf *IntegrationTxFactory) GenerateDefaultTxTypeArgs(sender common.Address,
 			Address:     sender,
 			StorageKeys: []common.Hash{{0}},
 		}}
-		defaultArgs.GasPrice = big.NewInt(1e9)
+		gasPrice, err := tf.defaultGasPrice()
+		if err != nil {
+			return evmtypes.EvmTxArgs{}, err
+		}
+		defaultArgs.GasPrice = gasPrice
 		return tf.populateEvmTxArgsWithDefault(sender, defaultArgs)
 	case gethtypes.LegacyTxType:
-		defaultArgs.GasPrice = big.NewInt(1e9)
+		gasPrice, err := tf.defaultGasPrice()
+		if err != nil {
+			return evmtypes.EvmTxArgs{}, err
+		}
+		defaultArgs.GasPrice = gasPrice
 		return tf.populateEvmTxArgsWithDefault(sender, defaultArgs)
+	case blobTxType:
+		// TODO: populate BlobFeeCap, BlobHashes and the sidecar once the go-ethereum
+		// dependency supports blob transactions.
+		return evmtypes.EvmTxArgs{}, errors.New("blob tx type (EIP-4844) is not supported by the current go-ethereum version")
 	default:
 		return evmtypes.EvmTxArgs{}, errors.New("tx type not supported")
 	}
 }
 
 // EstimateGasLimit estimates the gas limit for a tx with the provided address and txArgs
-func (tf *IntegrationTxFactory) EstimateGasLimit(from *common.Address, txArgs *evmtypes.EvmTxArgs) (uint64, error) {
-	args, err := json.Marshal(evmtypes.TransactionArgs{
-		Data:       (*hexutil.Bytes)(&txArgs.Input),
-		From:       from,
-		To:         txArgs.To,
-		AccessList: txArgs.Accesses,
-	})
+// against the state at the given block height. A zero height uses the latest state.
+//
+// Estimating against a past height requires the network to keep the state of that
+// height, i.e. the pruning strategy must be "nothing" or keep enough recent heights.
+func (tf *IntegrationTxFactory) EstimateGasLimit(from *common.Address, txArgs *evmtypes.EvmTxArgs, height int64) (uint64, error) {
+	args, err := marshalCallArgs(from, txArgs)
 	if err != nil {
-		return 0, errorsmod.Wrap(err, "failed to marshal tx args")
+		return 0, err
 	}
 
-	res, err := tf.grpcHandler.EstimateGas(args, config.DefaultGasCap)
+	res, err := tf.grpcHandler.EstimateGasAtHeight(args, config.DefaultGasCap, height)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "failed to estimate gas")
+	}
+	return res.Gas, nil
+}
+
+// EstimateGasLimitWithOverrides estimates the gas limit for a tx with the provided address and txArgs
+// against the hypothetical state resulting from applying the given state overrides.
+func (tf *IntegrationTxFactory) EstimateGasLimitWithOverrides(
+	from *common.Address,
+	txArgs *evmtypes.EvmTxArgs,
+	overrides *evmtypes.StateOverride,
+) (uint64, error) {
+	args, err := marshalCallArgs(from, txArgs)
+	if err != nil {
+		return 0, err
+	}
+
+	var overridesBz []byte
+	if overrides != nil {
+		overridesBz, err = json.Marshal(overrides)
+		if err != nil {
+			return 0, errorsmod.Wrap(err, "failed to marshal state overrides")
+		}
+	}
+
+	res, err := tf.grpcHandler.EstimateGasWithOverrides(args, overridesBz, config.DefaultGasCap)
 	if err != nil {
 		return 0, errorsmod.Wrap(err, "failed to estimate gas")
 	}
@@ -58,6 +104,97 @@ func (tf *IntegrationTxFactory) EstimateGasLimit(from *common.Address, txArgs *e
 	return gas, nil
 }
 
+// CallContractAtHeight calls the given method of a deployed contract from the provided
+// address against the state at the given block height, without committing any state
+// change. A zero height uses the latest state. The returned error contains the revert
+// reason if any.
+//
+// Calling against a past height requires the network to keep the state of that
+// height, i.e. the pruning strategy must be "nothing" or keep enough recent heights.
+func (tf *IntegrationTxFactory) CallContractAtHeight(
+	from common.Address,
+	contractAddr common.Address,
+	contractABI abi.ABI,
+	method string,
+	height int64,
+	args ...interface{},
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	txArgs, err := tf.GenerateContractCallArgs(
+		evmtypes.EvmTxArgs{To: &contractAddr},
+		CallArgs{ContractABI: contractABI, MethodName: method, Args: args},
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to generate contract call args")
+	}
+
+	callArgs, err := marshalCallArgs(&from, &txArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := tf.grpcHandler.EthCallAtHeight(callArgs, config.DefaultGasCap, height)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to call contract")
+	}
+
+	if res.Failed() {
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			return res, evmtypes.NewExecErrorWithReason(res.Ret)
+		}
+		return res, errors.New(res.VmError)
+	}
+
+	return res, nil
+}
+
+// SimulateEthCall executes the tx with the provided txArgs as sent by the given address
+// against the latest state, without signing it nor committing any state change. This
+// allows simulating txs from arbitrary callers (e.g. impersonating an account).
+//
+// The returned response holds the return data and logs of the execution. Unlike
+// CallContract, a failed execution is not returned as an error but through the
+// VmError of the response.
+func (tf *IntegrationTxFactory) SimulateEthCall(from common.Address, txArgs evmtypes.EvmTxArgs) (*evmtypes.MsgEthereumTxResponse, error) {
+	callArgs := evmtypes.TransactionArgs{
+		From:       &from,
+		To:         txArgs.To,
+		Data:       (*hexutil.Bytes)(&txArgs.Input),
+		AccessList: txArgs.Accesses,
+	}
+	if txArgs.GasLimit != 0 {
+		callArgs.Gas = (*hexutil.Uint64)(&txArgs.GasLimit)
+	}
+	if txArgs.Amount != nil {
+		callArgs.Value = (*hexutil.Big)(txArgs.Amount)
+	}
+
+	args, err := json.Marshal(callArgs)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to marshal tx args")
+	}
+
+	res, err := tf.grpcHandler.EthCallAtHeight(args, config.DefaultGasCap, 0)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to simulate eth call")
+	}
+	return res, nil
+}
+
+// marshalCallArgs returns the JSON-encoded call args for a tx with the provided
+// address and txArgs, as expected by the EVM module queries.
+func marshalCallArgs(from *common.Address, txArgs *evmtypes.EvmTxArgs) ([]byte, error) {
+	args, err := json.Marshal(evmtypes.TransactionArgs{
+		Data:       (*hexutil.Bytes)(&txArgs.Input),
+		From:       from,
+		To:         txArgs.To,
+		AccessList: txArgs.Accesses,
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to marshal tx args")
+	}
+	return args, nil
+}
+
 // GenerateSignedEthTx generates an Ethereum tx with the provided private key and txArgs but does not broadcast it.
 func (tf *IntegrationTxFactory) GenerateSignedEthTx(privKey cryptotypes.PrivKey, txArgs evmtypes.EvmTxArgs) (signing.Tx, error) {
 	msgEthereumTx, err := tf.GenerateMsgEthereumTx(privKey, txArgs)
@@ -78,6 +215,30 @@ func (tf *IntegrationTxFactory) GenerateSignedEthTx(privKey cryptotypes.PrivKey,
 	return tf.buildSignedTx(signedMsg)
 }
 
+// GenerateSignedEthTxBatch generates a batch of Ethereum txs from the same sender with the provided
+// private key and txArgs but does not broadcast them. The sender's nonce is fetched once and
+// each tx is assigned a sequential nonce, ignoring the Nonce field of the provided txArgs.
+func (tf *IntegrationTxFactory) GenerateSignedEthTxBatch(privKey cryptotypes.PrivKey, txArgs []evmtypes.EvmTxArgs) ([]signing.Tx, error) {
+	fromAddr := common.BytesToAddress(privKey.PubKey().Address().Bytes())
+	accountResp, err := tf.grpcHandler.GetEvmAccount(fromAddr)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to get evm account: %s", fromAddr.String())
+	}
+	nonce := accountResp.GetNonce()
+
+	txs := make([]signing.Tx, 0, len(txArgs))
+	for i, args := range txArgs {
+		args.Nonce = nonce + uint64(i)
+		tx, err := tf.GenerateSignedEthTx(privKey, args)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to generate signed ethereum tx %d", i)
+		}
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
 // GenerateMsgEthereumTx creates a new MsgEthereumTx with the provided arguments.
 // If any of the arguments are not provided, they will be populated with default values.
 func (tf *IntegrationTxFactory) GenerateMsgEthereumTx(
@@ -96,9 +257,25 @@ func (tf *IntegrationTxFactory) GenerateMsgEthereumTx(
 }
 
 // GenerateGethCoreMsg creates a new GethCoreMsg with the provided arguments.
+// The base fee is queried from the network.
 func (tf *IntegrationTxFactory) GenerateGethCoreMsg(
 	privKey cryptotypes.PrivKey,
 	txArgs evmtypes.EvmTxArgs,
+) (core.Message, error) {
+	baseFee, err := tf.getBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	return tf.GenerateGethCoreMsgWithBaseFee(privKey, txArgs, baseFee)
+}
+
+// GenerateGethCoreMsgWithBaseFee creates a new GethCoreMsg with the provided arguments
+// using the given base fee instead of querying it from the network.
+func (tf *IntegrationTxFactory) GenerateGethCoreMsgWithBaseFee(
+	privKey cryptotypes.PrivKey,
+	txArgs evmtypes.EvmTxArgs,
+	baseFee *big.Int,
 ) (core.Message, error) {
 	msg, err := tf.GenerateMsgEthereumTx(privKey, txArgs)
 	if err != nil {
@@ -110,14 +287,50 @@ func (tf *IntegrationTxFactory) GenerateGethCoreMsg(
 		return nil, errorsmod.Wrap(err, "failed to sign ethereum tx")
 	}
 
-	baseFeeResp, err := tf.grpcHandler.GetBaseFee()
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to get base fee")
-	}
 	signer := gethtypes.LatestSignerForChainID(
 		tf.network.GetEIP155ChainID(),
 	)
-	return signedMsg.AsMessage(signer, baseFeeResp.BaseFee.BigInt())
+	return signedMsg.AsMessage(signer, baseFee)
+}
+
+// GenerateGethCoreMsgWithChainID creates a new GethCoreMsg with the provided arguments,
+// signed for the given EIP-155 chain ID instead of the network's one.
+// The base fee is queried from the network.
+func (tf *IntegrationTxFactory) GenerateGethCoreMsgWithChainID(
+	privKey cryptotypes.PrivKey,
+	txArgs evmtypes.EvmTxArgs,
+	chainID *big.Int,
+) (core.Message, error) {
+	if chainID == nil {
+		return nil, errors.New("chain id cannot be nil")
+	}
+	txArgs.ChainID = chainID
+
+	msg, err := tf.GenerateMsgEthereumTx(privKey, txArgs)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to generate ethereum tx")
+	}
+
+	signer := gethtypes.LatestSignerForChainID(chainID)
+	if err := msg.Sign(signer, tx.NewSigner(privKey)); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to sign ethereum tx")
+	}
+
+	baseFee, err := tf.getBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	coreMsg, err := msg.AsMessage(signer, baseFee)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to convert ethereum tx to core message")
+	}
+
+	fromAddr := common.BytesToAddress(privKey.PubKey().Address().Bytes())
+	if coreMsg.From() != fromAddr {
+		return nil, fmt.Errorf("recovered signer %s does not match the private key address %s", coreMsg.From(), fromAddr)
+	}
+	return coreMsg, nil
 }
 
 // GenerateContractCallArgs generates the txArgs for a contract call.
@@ -0,0 +1,378 @@
+package factory_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v16/app"
+	"github.com/evmos/evmos/v16/contracts"
+	"github.com/evmos/evmos/v16/encoding"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/factory"
+	grpchandler "github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallContractAtHeight(t *testing.T) {
+	keyring := testkeyring.New(1)
+	nw := network.New(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	handler := grpchandler.NewIntegrationHandler(nw)
+	tf := factory.New(nw, handler)
+
+	sender := keyring.GetKey(0)
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	contractAddr, err := tf.DeployERC20(sender.Priv, factory.ERC20DeployArgs{
+		Name:     "Test",
+		Symbol:   "TEST",
+		Decimals: 18,
+	})
+	require.NoError(t, err, "failed to deploy contract")
+	require.NoError(t, nw.NextBlock(), "failed to advance block")
+
+	// the last committed height holds the contract without any minted tokens
+	deployHeight := nw.GetContext().BlockHeight() - 1
+
+	amount := big.NewInt(1000)
+	_, err = tf.CallContract(sender.Priv, contractAddr, erc20ABI, "mint", sender.Addr, amount)
+	require.NoError(t, err, "failed to mint tokens")
+	require.NoError(t, nw.NextBlock(), "failed to advance block")
+
+	testcases := []struct {
+		name        string
+		height      int64
+		expBalance  *big.Int
+		errContains string
+	}{
+		{
+			name:       "pass - latest state",
+			height:     0,
+			expBalance: amount,
+		},
+		{
+			name:       "pass - historical state",
+			height:     deployHeight,
+			expBalance: big.NewInt(0),
+		},
+		{
+			name:        "fail - future height",
+			height:      nw.GetContext().BlockHeight() + 1,
+			errContains: "cannot query with height in the future",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := tf.CallContractAtHeight(sender.Addr, contractAddr, erc20ABI, "balanceOf", tc.height, sender.Addr)
+			if tc.errContains != "" {
+				require.ErrorContains(t, err, tc.errContains)
+				return
+			}
+			require.NoError(t, err, "unexpected error calling contract")
+
+			out, err := erc20ABI.Unpack("balanceOf", res.Ret)
+			require.NoError(t, err, "failed to unpack balance")
+			require.Equal(t, tc.expBalance.String(), out[0].(*big.Int).String())
+		})
+	}
+
+	// the transfer of the minted tokens can only be estimated after they were minted
+	txArgs, err := tf.GenerateContractCallArgs(
+		evmtypes.EvmTxArgs{To: &contractAddr},
+		factory.CallArgs{ContractABI: erc20ABI, MethodName: "transfer", Args: []interface{}{contractAddr, amount}},
+	)
+	require.NoError(t, err, "failed to generate contract call args")
+
+	_, err = tf.EstimateGasLimit(&sender.Addr, &txArgs, 0)
+	require.NoError(t, err, "unexpected error estimating gas at the latest height")
+
+	_, err = tf.EstimateGasLimit(&sender.Addr, &txArgs, deployHeight)
+	require.ErrorContains(t, err, "execution reverted")
+}
+
+func TestSimulateEthCall(t *testing.T) {
+	keyring := testkeyring.New(1)
+	nw := network.New(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	handler := grpchandler.NewIntegrationHandler(nw)
+	tf := factory.New(nw, handler)
+
+	sender := keyring.GetKey(0)
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	contractAddr, err := tf.DeployERC20(sender.Priv, factory.ERC20DeployArgs{
+		Name:     "Test",
+		Symbol:   "TEST",
+		Decimals: 18,
+	})
+	require.NoError(t, err, "failed to deploy contract")
+	require.NoError(t, nw.NextBlock(), "failed to advance block")
+
+	// an account without any key nor funds
+	impersonated := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	amount := big.NewInt(1000)
+
+	testcases := []struct {
+		name      string
+		from      common.Address
+		expFailed bool
+	}{
+		{
+			name: "pass - minter",
+			from: sender.Addr,
+		},
+		{
+			name:      "fail - impersonated account without minter role",
+			from:      impersonated,
+			expFailed: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			txArgs, err := tf.GenerateContractCallArgs(
+				evmtypes.EvmTxArgs{To: &contractAddr},
+				factory.CallArgs{ContractABI: erc20ABI, MethodName: "mint", Args: []interface{}{impersonated, amount}},
+			)
+			require.NoError(t, err, "failed to generate contract call args")
+
+			res, err := tf.SimulateEthCall(tc.from, txArgs)
+			require.NoError(t, err, "unexpected error simulating call")
+			require.Equal(t, tc.expFailed, res.Failed(), "unexpected execution result: %s", res.VmError)
+			if !tc.expFailed {
+				require.Len(t, res.Logs, 1, "expected transfer event")
+			}
+		})
+	}
+
+	// the simulated mint is not committed
+	res, err := tf.CallContractAtHeight(sender.Addr, contractAddr, erc20ABI, "totalSupply", 0)
+	require.NoError(t, err, "failed to query total supply")
+	out, err := erc20ABI.Unpack("totalSupply", res.Ret)
+	require.NoError(t, err, "failed to unpack total supply")
+	require.Zero(t, out[0].(*big.Int).Sign(), "expected no minted tokens")
+}
+
+func TestGenerateSignedEthTxBatch(t *testing.T) {
+	keyring := testkeyring.New(1)
+	nw := network.New(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	handler := grpchandler.NewIntegrationHandler(nw)
+	tf := factory.New(nw, handler)
+
+	sender := keyring.GetKey(0)
+	recipient := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	// move the sender nonce away from zero
+	_, err := tf.ExecuteEthTx(sender.Priv, evmtypes.EvmTxArgs{To: &recipient, Amount: big.NewInt(1)})
+	require.NoError(t, err, "failed to execute tx")
+	require.NoError(t, nw.NextBlock(), "failed to advance block")
+
+	acc, err := handler.GetEvmAccount(sender.Addr)
+	require.NoError(t, err, "failed to get account")
+	startNonce := acc.GetNonce()
+	require.Equal(t, uint64(1), startNonce)
+
+	// the provided nonces are ignored
+	txArgs := []evmtypes.EvmTxArgs{
+		{To: &recipient, Amount: big.NewInt(1), Nonce: 100},
+		{To: &recipient, Amount: big.NewInt(2)},
+		{To: &recipient, Amount: big.NewInt(3), Nonce: 100},
+	}
+	txs, err := tf.GenerateSignedEthTxBatch(sender.Priv, txArgs)
+	require.NoError(t, err, "failed to generate txs")
+	require.Len(t, txs, len(txArgs))
+
+	for i, tx := range txs {
+		msg, ok := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+		require.True(t, ok, "expected a MsgEthereumTx")
+
+		ethTx := msg.AsTransaction()
+		require.Equal(t, startNonce+uint64(i), ethTx.Nonce(), "expected sequential nonces")
+		require.Equal(t, txArgs[i].Amount, ethTx.Value())
+
+		from, err := msg.GetSender(nw.GetEIP155ChainID())
+		require.NoError(t, err, "failed to recover sender")
+		require.Equal(t, sender.Addr, from)
+	}
+
+	// the txs are accepted in order within the same block
+	txEncoder := encoding.MakeConfig(app.ModuleBasics).TxConfig.TxEncoder()
+	for _, tx := range txs {
+		bz, err := txEncoder(tx)
+		require.NoError(t, err, "failed to encode tx")
+		res, err := nw.BroadcastTxSync(bz)
+		require.NoError(t, err, "failed to broadcast tx")
+		require.True(t, res.IsOK(), "expected tx to succeed: %s", res.Log)
+	}
+
+	acc, err = handler.GetEvmAccount(sender.Addr)
+	require.NoError(t, err, "failed to get account")
+	require.Equal(t, startNonce+uint64(len(txs)), acc.GetNonce())
+}
+
+func TestGenerateDefaultTxTypeArgs(t *testing.T) {
+	keyring := testkeyring.New(1)
+	sender := keyring.GetAddr(0)
+
+	testcases := []struct {
+		name   string
+		txType int
+		opts   []factory.ConfigOption
+		expTip *big.Int
+	}{
+		{
+			name:   "legacy tx - default tip",
+			txType: ethtypes.LegacyTxType,
+			expTip: big.NewInt(1e9),
+		},
+		{
+			name:   "access list tx - default tip",
+			txType: ethtypes.AccessListTxType,
+			expTip: big.NewInt(1e9),
+		},
+		{
+			name:   "legacy tx - custom tip",
+			txType: ethtypes.LegacyTxType,
+			opts:   []factory.ConfigOption{factory.WithGasTip(big.NewInt(5e9))},
+			expTip: big.NewInt(5e9),
+		},
+		{
+			name:   "access list tx - zero tip",
+			txType: ethtypes.AccessListTxType,
+			opts:   []factory.ConfigOption{factory.WithGasTip(big.NewInt(0))},
+			expTip: big.NewInt(0),
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			nw := network.New(
+				network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+			)
+			handler := grpchandler.NewIntegrationHandler(nw)
+			tf := factory.New(nw, handler, tc.opts...)
+
+			baseFeeResp, err := handler.GetBaseFee()
+			require.NoError(t, err, "failed to get base fee")
+			expGasPrice := new(big.Int).Add(baseFeeResp.BaseFee.BigInt(), tc.expTip)
+
+			txArgs, err := tf.GenerateDefaultTxTypeArgs(sender, tc.txType)
+			require.NoError(t, err, "failed to generate tx args")
+			require.Equal(t, expGasPrice, txArgs.GasPrice)
+			require.Nil(t, txArgs.GasFeeCap)
+			require.Nil(t, txArgs.GasTipCap)
+		})
+	}
+}
+
+func TestGenerateGethCoreMsgWithBaseFee(t *testing.T) {
+	keyring := testkeyring.New(1)
+	nw := network.New(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	handler := grpchandler.NewIntegrationHandler(nw)
+	tf := factory.New(nw, handler)
+
+	sender := keyring.GetKey(0)
+	recipient := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	txArgs := evmtypes.EvmTxArgs{
+		To:        &recipient,
+		Amount:    big.NewInt(1),
+		GasLimit:  21000,
+		GasFeeCap: big.NewInt(10e9),
+		GasTipCap: big.NewInt(1e9),
+	}
+
+	testcases := []struct {
+		name        string
+		baseFee     *big.Int
+		expGasPrice *big.Int
+	}{
+		{
+			name:        "base fee plus tip below the fee cap",
+			baseFee:     big.NewInt(2e9),
+			expGasPrice: big.NewInt(3e9),
+		},
+		{
+			name:        "base fee plus tip above the fee cap",
+			baseFee:     big.NewInt(9.5e9),
+			expGasPrice: big.NewInt(10e9),
+		},
+		{
+			name:        "nil base fee",
+			baseFee:     nil,
+			expGasPrice: big.NewInt(10e9),
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := tf.GenerateGethCoreMsgWithBaseFee(sender.Priv, txArgs, tc.baseFee)
+			require.NoError(t, err, "failed to generate core message")
+			require.Equal(t, sender.Addr, msg.From())
+			require.Equal(t, tc.expGasPrice, msg.GasPrice())
+			require.Equal(t, txArgs.GasFeeCap, msg.GasFeeCap())
+			require.Equal(t, txArgs.GasTipCap, msg.GasTipCap())
+		})
+	}
+}
+
+func TestGenerateGethCoreMsgWithChainID(t *testing.T) {
+	keyring := testkeyring.New(1)
+	nw := network.New(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	handler := grpchandler.NewIntegrationHandler(nw)
+	tf := factory.New(nw, handler)
+
+	sender := keyring.GetKey(0)
+	recipient := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	testcases := []struct {
+		name        string
+		chainID     *big.Int
+		errContains string
+	}{
+		{
+			name:    "pass - network chain id",
+			chainID: nw.GetEIP155ChainID(),
+		},
+		{
+			name:    "pass - custom chain id",
+			chainID: big.NewInt(1),
+		},
+		{
+			name:        "fail - nil chain id",
+			chainID:     nil,
+			errContains: "chain id cannot be nil",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			txArgs := evmtypes.EvmTxArgs{To: &recipient, Amount: big.NewInt(1), GasLimit: 21000}
+			msg, err := tf.GenerateGethCoreMsgWithChainID(sender.Priv, txArgs, tc.chainID)
+			if tc.errContains != "" {
+				require.ErrorContains(t, err, tc.errContains)
+				return
+			}
+
+			require.NoError(t, err, "failed to generate core message")
+			require.Equal(t, sender.Addr, msg.From())
+		})
+	}
+}
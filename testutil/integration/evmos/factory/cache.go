@@ -0,0 +1,69 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package factory
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// gasCacheKey identifies the gas estimation of a tx by its sender, recipient
+// and input data.
+type gasCacheKey struct {
+	from      common.Address
+	to        common.Address
+	create    bool
+	inputHash common.Hash
+}
+
+// estimationCache caches the gas estimations and the base fee queried by the
+// factory to build txs, to avoid repeating the same queries when building many
+// txs in a row.
+//
+// The gas estimations are kept for the lifetime of the factory, while the base
+// fee is only reused within the block height it was queried at.
+type estimationCache struct {
+	gas map[gasCacheKey]uint64
+
+	baseFeeHeight int64
+	baseFee       *big.Int
+}
+
+// newEstimationCache creates a new empty estimationCache
+func newEstimationCache() *estimationCache {
+	return &estimationCache{
+		gas: make(map[gasCacheKey]uint64),
+	}
+}
+
+// newGasCacheKey returns the cache key of the gas estimation of a tx with the
+// provided sender and txArgs.
+func newGasCacheKey(from common.Address, txArgs *evmtypes.EvmTxArgs) gasCacheKey {
+	key := gasCacheKey{
+		from:      from,
+		create:    txArgs.To == nil,
+		inputHash: crypto.Keccak256Hash(txArgs.Input),
+	}
+	if txArgs.To != nil {
+		key.to = *txArgs.To
+	}
+	return key
+}
+
+// getBaseFee returns the cached base fee if it was queried at the given block
+// height.
+func (c *estimationCache) getBaseFee(height int64) (*big.Int, bool) {
+	if c.baseFee == nil || c.baseFeeHeight != height {
+		return nil, false
+	}
+	return new(big.Int).Set(c.baseFee), true
+}
+
+// setBaseFee caches the base fee queried at the given block height.
+func (c *estimationCache) setBaseFee(height int64, baseFee *big.Int) {
+	c.baseFeeHeight = height
+	c.baseFee = new(big.Int).Set(baseFee)
+}
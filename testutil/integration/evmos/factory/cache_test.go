@@ -0,0 +1,91 @@
+package factory_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/factory"
+	grpchandler "github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
+	"github.com/stretchr/testify/require"
+)
+
+// countingHandler counts the gas estimation and base fee queries made through
+// the wrapped handler.
+type countingHandler struct {
+	grpchandler.Handler
+	estimations int
+	baseFees    int
+}
+
+func (h *countingHandler) EstimateGasAtHeight(args []byte, gasCap uint64, height int64) (*evmtypes.EstimateGasResponse, error) {
+	h.estimations++
+	return h.Handler.EstimateGasAtHeight(args, gasCap, height)
+}
+
+func (h *countingHandler) GetBaseFee() (*feemarkettypes.QueryBaseFeeResponse, error) {
+	h.baseFees++
+	return h.Handler.GetBaseFee()
+}
+
+func TestEstimationCache(t *testing.T) {
+	keyring := testkeyring.New(2)
+	receiver := keyring.GetAddr(1)
+
+	testcases := []struct {
+		name           string
+		opts           []factory.ConfigOption
+		accesses       *ethtypes.AccessList
+		expEstimations int
+		expBaseFees    int
+	}{
+		{
+			name:           "no cache",
+			expEstimations: 4,
+			expBaseFees:    4,
+		},
+		{
+			name:           "cache",
+			opts:           []factory.ConfigOption{factory.WithEstimationCache()},
+			expEstimations: 1,
+			expBaseFees:    2,
+		},
+		{
+			name:           "cache - bypassed for access list txs",
+			opts:           []factory.ConfigOption{factory.WithEstimationCache()},
+			accesses:       &ethtypes.AccessList{{Address: receiver, StorageKeys: []common.Hash{}}},
+			expEstimations: 4,
+			expBaseFees:    2,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			nw := network.New(
+				network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+			)
+			handler := &countingHandler{Handler: grpchandler.NewIntegrationHandler(nw)}
+			tf := factory.New(nw, handler, tc.opts...)
+
+			// build two txs on each of two blocks
+			for i := 0; i < 4; i++ {
+				if i == 2 {
+					require.NoError(t, nw.NextBlock(), "failed to advance block")
+				}
+				_, err := tf.GenerateSignedEthTx(keyring.GetPrivKey(0), evmtypes.EvmTxArgs{
+					To:       &receiver,
+					Accesses: tc.accesses,
+				})
+				require.NoError(t, err, "failed to generate tx")
+			}
+
+			require.Equal(t, tc.expEstimations, handler.estimations, "unexpected number of gas estimations")
+			require.Equal(t, tc.expBaseFees, handler.baseFees, "unexpected number of base fee queries")
+		})
+	}
+}
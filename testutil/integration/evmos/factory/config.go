@@ -0,0 +1,49 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package factory
+
+import (
+	"math/big"
+)
+
+// Config defines the configuration for the IntegrationTxFactory.
+// It allows for customization of the default values used to build transactions.
+type Config struct {
+	// gasTip is the tip added on top of the base fee to compute the default gas price
+	// of legacy and access list transactions.
+	gasTip *big.Int
+	// estimationCache enables the caching of the gas estimations and base fee
+	// queried to build transactions.
+	estimationCache bool
+}
+
+// DefaultConfig returns the default configuration for the IntegrationTxFactory.
+func DefaultConfig() Config {
+	return Config{
+		// 1 gwei
+		gasTip: big.NewInt(1e9),
+	}
+}
+
+// ConfigOption defines a function that can modify the factory Config.
+type ConfigOption func(*Config)
+
+// WithGasTip sets the tip that is added on top of the base fee to compute the
+// default gas price of legacy and access list transactions.
+func WithGasTip(tip *big.Int) ConfigOption {
+	return func(cfg *Config) {
+		cfg.gasTip = tip
+	}
+}
+
+// WithEstimationCache enables the caching of the gas estimations and base fee
+// queried by the factory to build transactions. The gas estimations are keyed by
+// sender, recipient and input data and are kept for the lifetime of the factory,
+// so they can be stale if the state accessed by the tx changes. The base fee is
+// queried again on every new block. Transactions with an access list always
+// estimate their gas, since the access list changes the gas used.
+func WithEstimationCache() ConfigOption {
+	return func(cfg *Config) {
+		cfg.estimationCache = true
+	}
+}
@@ -12,8 +12,10 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	testutiltypes "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/evmos/evmos/v16/app"
@@ -35,18 +37,43 @@ type TxFactory interface {
 	GenerateDefaultTxTypeArgs(sender common.Address, txType int) (evmtypes.EvmTxArgs, error)
 	// GenerateSignedEthTx generates an Ethereum tx with the provided private key and txArgs but does not broadcast it.
 	GenerateSignedEthTx(privKey cryptotypes.PrivKey, txArgs evmtypes.EvmTxArgs) (signing.Tx, error)
+	// GenerateSignedEthTxBatch generates a batch of Ethereum txs with sequential nonces from the same sender
+	// with the provided private key and txArgs but does not broadcast them.
+	GenerateSignedEthTxBatch(privKey cryptotypes.PrivKey, txArgs []evmtypes.EvmTxArgs) ([]signing.Tx, error)
 
 	// SignMsgEthereumTx signs a MsgEthereumTx with the provided private key.
 	SignMsgEthereumTx(privKey cryptotypes.PrivKey, msgEthereumTx evmtypes.MsgEthereumTx) (evmtypes.MsgEthereumTx, error)
+	// GenerateSignedEIP712Tx generates a Cosmos tx with the provided messages and fee,
+	// signed over its EIP-712 typed data for the given chain ID, but does not broadcast it.
+	GenerateSignedEIP712Tx(privKey cryptotypes.PrivKey, msgs []sdktypes.Msg, fee legacytx.StdFee, chainID string) (signing.Tx, error) //nolint:staticcheck
 
 	// ExecuteEthTx builds, signs and broadcasts an Ethereum tx with the provided private key and txArgs.
 	// If the txArgs are not provided, they will be populated with default values or gas estimations.
 	ExecuteEthTx(privKey cryptotypes.PrivKey, txArgs evmtypes.EvmTxArgs) (abcitypes.ResponseDeliverTx, error)
+	// CheckEthTx builds and signs an Ethereum tx with the provided private key and txArgs
+	// and runs it through CheckTx without committing a block.
+	CheckEthTx(privKey cryptotypes.PrivKey, txArgs evmtypes.EvmTxArgs) (abcitypes.ResponseCheckTx, error)
 	// ExecuteContractCall executes a contract call with the provided private key
 	ExecuteContractCall(privKey cryptotypes.PrivKey, txArgs evmtypes.EvmTxArgs, callArgs CallArgs) (abcitypes.ResponseDeliverTx, error)
+	// CallContract calls the given method of a deployed contract with the provided private key and
+	// arguments, returning the decoded response. The returned error contains the revert reason if any.
+	CallContract(privKey cryptotypes.PrivKey, contractAddr common.Address, contractABI abi.ABI, method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error)
+	// SimulateEthCall executes the tx with the provided txArgs as sent by the given address without
+	// signing it nor committing any state change, returning the response of the execution.
+	SimulateEthCall(from common.Address, txArgs evmtypes.EvmTxArgs) (*evmtypes.MsgEthereumTxResponse, error)
+	// ExpectRevert broadcasts an Ethereum tx with the provided private key and txArgs and
+	// returns an error unless the tx was included but reverted with the expected reason.
+	ExpectRevert(privKey cryptotypes.PrivKey, txArgs evmtypes.EvmTxArgs, expectedReason string) error
+	// CallContractAtHeight calls the given method of a deployed contract from the provided address
+	// against the state at the given block height without committing any state change. A zero height
+	// uses the latest state. The returned error contains the revert reason if any.
+	CallContractAtHeight(from common.Address, contractAddr common.Address, contractABI abi.ABI, method string, height int64, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error)
 	// DeployContract deploys a contract with the provided private key,
 	// compiled contract data and constructor arguments
 	DeployContract(privKey cryptotypes.PrivKey, txArgs evmtypes.EvmTxArgs, deploymentData ContractDeploymentData) (common.Address, error)
+	// DeployERC20 deploys the standard ERC20 test contract with the provided private key
+	// and constructor arguments
+	DeployERC20(privKey cryptotypes.PrivKey, deployArgs ERC20DeployArgs) (common.Address, error)
 	// CallContractAndCheckLogs is a helper function to call a contract and check the logs using
 	// the integration test utilities.
 	//
@@ -61,8 +88,16 @@ type TxFactory interface {
 	GenerateMsgEthereumTx(privKey cryptotypes.PrivKey, txArgs evmtypes.EvmTxArgs) (evmtypes.MsgEthereumTx, error)
 	// GenerateGethCoreMsg creates a new GethCoreMsg with the provided arguments.
 	GenerateGethCoreMsg(privKey cryptotypes.PrivKey, txArgs evmtypes.EvmTxArgs) (core.Message, error)
-	// EstimateGasLimit estimates the gas limit for a tx with the provided address and txArgs.
-	EstimateGasLimit(from *common.Address, txArgs *evmtypes.EvmTxArgs) (uint64, error)
+	// GenerateGethCoreMsgWithBaseFee creates a new GethCoreMsg with the provided arguments and base fee.
+	GenerateGethCoreMsgWithBaseFee(privKey cryptotypes.PrivKey, txArgs evmtypes.EvmTxArgs, baseFee *big.Int) (core.Message, error)
+	// GenerateGethCoreMsgWithChainID creates a new GethCoreMsg with the provided arguments signed for the given chain ID.
+	GenerateGethCoreMsgWithChainID(privKey cryptotypes.PrivKey, txArgs evmtypes.EvmTxArgs, chainID *big.Int) (core.Message, error)
+	// EstimateGasLimit estimates the gas limit for a tx with the provided address and txArgs
+	// against the state at the given block height. A zero height uses the latest state.
+	EstimateGasLimit(from *common.Address, txArgs *evmtypes.EvmTxArgs, height int64) (uint64, error)
+	// EstimateGasLimitWithOverrides estimates the gas limit for a tx with the provided address and txArgs
+	// against the state resulting from applying the given state overrides.
+	EstimateGasLimitWithOverrides(from *common.Address, txArgs *evmtypes.EvmTxArgs, overrides *evmtypes.StateOverride) (uint64, error)
 	// GetEvmTxResponseFromTxResult returns the MsgEthereumTxResponse from the provided txResult.
 	GetEvmTxResponseFromTxResult(txResult abcitypes.ResponseDeliverTx) (*evmtypes.MsgEthereumTxResponse, error)
 }
@@ -73,23 +108,40 @@ var _ TxFactory = (*IntegrationTxFactory)(nil)
 // to the network on integration tests. This is to simulate the behavior of a real user.
 type IntegrationTxFactory struct {
 	*commonfactory.IntegrationTxFactory
+	cfg         Config
 	grpcHandler grpc.Handler
 	network     network.Network
 	ec          *testutiltypes.TestEncodingConfig
+	// cache is nil unless the estimation cache is enabled in the config
+	cache *estimationCache
 }
 
-// New creates a new IntegrationTxFactory instance
+// New creates a new IntegrationTxFactory instance with the given
+// configuration options. If no configuration options are provided
+// it uses the default configuration.
 func New(
 	network network.Network,
 	grpcHandler grpc.Handler,
+	opts ...ConfigOption,
 ) TxFactory {
+	cfg := DefaultConfig()
+	// Modify the default config with the given options
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	ec := makeConfig(app.ModuleBasics)
-	return &IntegrationTxFactory{
+	tf := &IntegrationTxFactory{
 		IntegrationTxFactory: commonfactory.New(network, grpcHandler, &ec),
+		cfg:                  cfg,
 		grpcHandler:          grpcHandler,
 		network:              network,
 		ec:                   &ec,
 	}
+	if cfg.estimationCache {
+		tf.cache = newEstimationCache()
+	}
+	return tf
 }
 
 // GetEvmTxResponseFromTxResult returns the MsgEthereumTxResponse from the provided txResult.
@@ -128,18 +180,18 @@ func (tf *IntegrationTxFactory) populateEvmTxArgsWithDefault(
 			txArgs.GasTipCap = big.NewInt(1)
 		}
 		if txArgs.GasFeeCap == nil {
-			baseFeeResp, err := tf.grpcHandler.GetBaseFee()
+			baseFee, err := tf.getBaseFee()
 			if err != nil {
-				return evmtypes.EvmTxArgs{}, errorsmod.Wrap(err, "failed to get base fee")
+				return evmtypes.EvmTxArgs{}, err
 			}
-			txArgs.GasFeeCap = baseFeeResp.BaseFee.BigInt()
+			txArgs.GasFeeCap = baseFee
 		}
 	}
 
 	// If the gas limit is not set, estimate it
 	// through the /simulate endpoint.
 	if txArgs.GasLimit == 0 {
-		gasLimit, err := tf.EstimateGasLimit(&fromAddr, &txArgs)
+		gasLimit, err := tf.estimateGasLimit(fromAddr, &txArgs)
 		if err != nil {
 			return evmtypes.EvmTxArgs{}, errorsmod.Wrap(err, "failed to estimate gas limit")
 		}
@@ -149,6 +201,68 @@ func (tf *IntegrationTxFactory) populateEvmTxArgsWithDefault(
 	return txArgs, nil
 }
 
+// defaultGasPrice returns the gas price used for legacy and access list transactions
+// when none is provided, which is the current base fee plus the configured tip.
+func (tf *IntegrationTxFactory) defaultGasPrice() (*big.Int, error) {
+	baseFee, err := tf.getBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice := new(big.Int).Set(tf.cfg.gasTip)
+	if baseFee != nil {
+		gasPrice.Add(gasPrice, baseFee)
+	}
+	return gasPrice, nil
+}
+
+// getBaseFee returns the current base fee of the network, or nil if it is not
+// set. If the estimation cache is enabled, the base fee is only queried once
+// per block.
+func (tf *IntegrationTxFactory) getBaseFee() (*big.Int, error) {
+	height := tf.network.GetContext().BlockHeight()
+	if tf.cache != nil {
+		if baseFee, ok := tf.cache.getBaseFee(height); ok {
+			return baseFee, nil
+		}
+	}
+
+	baseFeeResp, err := tf.grpcHandler.GetBaseFee()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get base fee")
+	}
+	if baseFeeResp.BaseFee == nil {
+		return nil, nil
+	}
+
+	baseFee := baseFeeResp.BaseFee.BigInt()
+	if tf.cache != nil {
+		tf.cache.setBaseFee(height, baseFee)
+	}
+	return baseFee, nil
+}
+
+// estimateGasLimit estimates the gas limit for a tx with the provided address and
+// txArgs against the latest state. If the estimation cache is enabled, the cached
+// estimation is used for txs without an access list.
+func (tf *IntegrationTxFactory) estimateGasLimit(from common.Address, txArgs *evmtypes.EvmTxArgs) (uint64, error) {
+	if tf.cache == nil || txArgs.Accesses != nil {
+		return tf.EstimateGasLimit(&from, txArgs, 0)
+	}
+
+	key := newGasCacheKey(from, txArgs)
+	if gas, ok := tf.cache.gas[key]; ok {
+		return gas, nil
+	}
+
+	gas, err := tf.EstimateGasLimit(&from, txArgs, 0)
+	if err != nil {
+		return 0, err
+	}
+	tf.cache.gas[key] = gas
+	return gas, nil
+}
+
 func (tf *IntegrationTxFactory) encodeTx(tx sdktypes.Tx) ([]byte, error) {
 	txConfig := tf.ec.TxConfig
 	txBytes, err := txConfig.TxEncoder()(tx)
@@ -166,27 +280,40 @@ func (tf *IntegrationTxFactory) buildSignedTx(msg evmtypes.MsgEthereumTx) (signi
 
 // checkEthTxResponse checks if the response is valid and returns the MsgEthereumTxResponse
 func (tf *IntegrationTxFactory) checkEthTxResponse(res *abcitypes.ResponseDeliverTx) error {
+	evmRes, err := tf.decodeEthTxResponse(res)
+	if err != nil {
+		return err
+	}
+
+	if evmRes.Failed() {
+		if reason, err := evmtypes.UnpackRevertReason(evmRes.Ret); err == nil {
+			return fmt.Errorf("tx failed. VmError: %v, Reason: %s, Logs: %s", evmRes.VmError, reason, res.GetLog())
+		}
+		return fmt.Errorf("tx failed. VmError: %v, Logs: %s", evmRes.VmError, res.GetLog())
+	}
+	return nil
+}
+
+// decodeEthTxResponse checks that the tx was included and decodes the
+// MsgEthereumTxResponse of its single message.
+func (tf *IntegrationTxFactory) decodeEthTxResponse(res *abcitypes.ResponseDeliverTx) (*evmtypes.MsgEthereumTxResponse, error) {
 	var txData sdktypes.TxMsgData
 	if !res.IsOK() {
-		return fmt.Errorf("tx failed. Code: %d, Logs: %s", res.Code, res.Log)
+		return nil, fmt.Errorf("tx failed. Code: %d, Logs: %s", res.Code, res.Log)
 	}
 
 	cdc := tf.ec.Codec
 	if err := cdc.Unmarshal(res.Data, &txData); err != nil {
-		return errorsmod.Wrap(err, "failed to unmarshal tx data")
+		return nil, errorsmod.Wrap(err, "failed to unmarshal tx data")
 	}
 
 	if len(txData.MsgResponses) != 1 {
-		return fmt.Errorf("expected 1 message response, got %d", len(txData.MsgResponses))
+		return nil, fmt.Errorf("expected 1 message response, got %d", len(txData.MsgResponses))
 	}
 
 	var evmRes evmtypes.MsgEthereumTxResponse
 	if err := proto.Unmarshal(txData.MsgResponses[0].Value, &evmRes); err != nil {
-		return errorsmod.Wrap(err, "failed to unmarshal evm tx response")
+		return nil, errorsmod.Wrap(err, "failed to unmarshal evm tx response")
 	}
-
-	if evmRes.Failed() {
-		return fmt.Errorf("tx failed. VmError: %v, Logs: %s", evmRes.VmError, res.GetLog())
-	}
-	return nil
+	return &evmRes, nil
 }
@@ -3,9 +3,15 @@
 package factory
 
 import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -63,3 +69,94 @@ func CheckError(err error, logCheckArgs testutil.LogCheckArgs) error {
 
 	return nil
 }
+
+// AssertEventEmitted checks that the given logs contain the event with the given name
+// of the contract ABI and that its fields match the expected arguments, keyed by the
+// event input names. Only the fields present in expectedArgs are compared. Indexed
+// fields of dynamic types (e.g. string or bytes) are compared against their topic hash.
+//
+// It returns an error listing the mismatching fields if no log of the event matches
+// the expected arguments.
+func AssertEventEmitted(receiptLogs []*ethtypes.Log, contractABI abi.ABI, eventName string, expectedArgs map[string]interface{}) error {
+	event, ok := contractABI.Events[eventName]
+	if !ok {
+		return fmt.Errorf("event %q not found in the contract ABI", eventName)
+	}
+
+	var (
+		found bool
+		diffs []string
+	)
+	for _, log := range receiptLogs {
+		if len(log.Topics) == 0 || log.Topics[0] != event.ID {
+			continue
+		}
+		found = true
+
+		args, err := unpackEventArgs(contractABI, event, log)
+		if err != nil {
+			return err
+		}
+
+		diffs = diffEventArgs(args, expectedArgs)
+		if len(diffs) == 0 {
+			return nil
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("event %q not emitted", eventName)
+	}
+	return fmt.Errorf("event %q emitted with unexpected args:\n%s", eventName, strings.Join(diffs, "\n"))
+}
+
+// unpackEventArgs decodes both the indexed and non-indexed fields of the given
+// event log into a map keyed by the event input names.
+func unpackEventArgs(contractABI abi.ABI, event abi.Event, log *ethtypes.Log) (map[string]interface{}, error) {
+	args := make(map[string]interface{})
+	if len(log.Data) > 0 {
+		if err := contractABI.UnpackIntoMap(args, event.Name, log.Data); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to unpack event %q data", event.Name)
+		}
+	}
+
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(args, indexed, log.Topics[1:]); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to parse event %q topics", event.Name)
+	}
+	return args, nil
+}
+
+// diffEventArgs returns a description of each expected argument that is missing
+// or differs from the decoded event arguments.
+func diffEventArgs(args, expectedArgs map[string]interface{}) []string {
+	var diffs []string
+	for name, expected := range expectedArgs {
+		got, ok := args[name]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("  %s: field not found in event", name))
+			continue
+		}
+		if !equalEventArg(expected, got) {
+			diffs = append(diffs, fmt.Sprintf("  %s: expected %v, got %v", name, expected, got))
+		}
+	}
+	sort.Strings(diffs)
+	return diffs
+}
+
+// equalEventArg compares an expected event argument with the decoded one,
+// comparing big integers by value.
+func equalEventArg(expected, got interface{}) bool {
+	expInt, expOk := expected.(*big.Int)
+	gotInt, gotOk := got.(*big.Int)
+	if expOk && gotOk {
+		return expInt.Cmp(gotInt) == 0
+	}
+	return reflect.DeepEqual(expected, got)
+}
@@ -0,0 +1,96 @@
+package factory_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v16/contracts"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/factory"
+	grpchandler "github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssertEventEmitted(t *testing.T) {
+	keyring := testkeyring.New(1)
+	nw := network.New(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	handler := grpchandler.NewIntegrationHandler(nw)
+	tf := factory.New(nw, handler)
+
+	sender := keyring.GetKey(0)
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	contractAddr, err := tf.DeployERC20(sender.Priv, factory.ERC20DeployArgs{
+		Name:     "Test",
+		Symbol:   "TEST",
+		Decimals: 18,
+	})
+	require.NoError(t, err, "failed to deploy contract")
+	require.NoError(t, nw.NextBlock(), "failed to advance block")
+
+	amount := big.NewInt(1000)
+	res, err := tf.CallContract(sender.Priv, contractAddr, erc20ABI, "mint", sender.Addr, amount)
+	require.NoError(t, err, "failed to mint tokens")
+	logs := evmtypes.LogsToEthereum(res.Logs)
+
+	testcases := []struct {
+		name        string
+		eventName   string
+		expArgs     map[string]interface{}
+		errContains string
+	}{
+		{
+			name:      "pass - all fields",
+			eventName: "Transfer",
+			expArgs: map[string]interface{}{
+				"from":  common.Address{},
+				"to":    sender.Addr,
+				"value": big.NewInt(1000),
+			},
+		},
+		{
+			name:      "pass - subset of fields",
+			eventName: "Transfer",
+			expArgs:   map[string]interface{}{"value": big.NewInt(1000)},
+		},
+		{
+			name:        "fail - mismatching fields",
+			eventName:   "Transfer",
+			expArgs:     map[string]interface{}{"to": contractAddr, "value": big.NewInt(1)},
+			errContains: "to: expected " + contractAddr.String() + ", got " + sender.Addr.String(),
+		},
+		{
+			name:        "fail - unknown field",
+			eventName:   "Transfer",
+			expArgs:     map[string]interface{}{"amount": big.NewInt(1000)},
+			errContains: "amount: field not found in event",
+		},
+		{
+			name:        "fail - event not emitted",
+			eventName:   "Approval",
+			errContains: "event \"Approval\" not emitted",
+		},
+		{
+			name:        "fail - event not in ABI",
+			eventName:   "Unknown",
+			errContains: "event \"Unknown\" not found in the contract ABI",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := factory.AssertEventEmitted(logs, erc20ABI, tc.eventName, tc.expArgs)
+			if tc.errContains != "" {
+				require.ErrorContains(t, err, tc.errContains)
+			} else {
+				require.NoError(t, err, "unexpected error")
+			}
+		})
+	}
+}
@@ -3,10 +3,21 @@
 package factory
 
 import (
+	"errors"
+
 	errorsmod "cosmossdk.io/errors"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/evmos/evmos/v16/ethereum/eip712"
 	"github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/types"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 )
 
@@ -20,3 +31,76 @@ func (tf *IntegrationTxFactory) SignMsgEthereumTx(privKey cryptotypes.PrivKey, m
 	}
 	return msgEthereumTx, nil
 }
+
+// GenerateSignedEIP712Tx generates a Cosmos tx containing the provided messages and signs
+// it with the EIP-712 typed data representation of its legacy amino JSON sign doc for the
+// given chain ID.
+//
+// NOTE: the signature uses the SIGN_MODE_LEGACY_AMINO_JSON sign mode, as the ethsecp256k1
+// public key of the signer verifies it against the EIP-712 representation of the amino
+// JSON sign bytes. No ExtensionOptionsWeb3Tx is attached, since that extension option is
+// only used by the deprecated legacy EIP-712 decorator and is rejected by the ante
+// handler router.
+func (tf *IntegrationTxFactory) GenerateSignedEIP712Tx(
+	privKey cryptotypes.PrivKey,
+	msgs []sdktypes.Msg,
+	fee legacytx.StdFee, //nolint:staticcheck
+	chainID string,
+) (authsigning.Tx, error) {
+	if len(msgs) == 0 {
+		return nil, errors.New("at least one message is required to generate an EIP-712 tx")
+	}
+
+	ethChainID, err := types.ParseChainID(chainID)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to parse chain id: %s", chainID)
+	}
+
+	senderAddress := sdktypes.AccAddress(privKey.PubKey().Address().Bytes())
+	account, err := tf.grpcHandler.GetAccount(senderAddress.String())
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to get account: %s", senderAddress.String())
+	}
+
+	signBytes := legacytx.StdSignBytes(chainID, account.GetAccountNumber(), account.GetSequence(), 0, fee, msgs, "", nil)
+	typedData, err := eip712.WrapTxToTypedData(ethChainID.Uint64(), signBytes)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to wrap tx to EIP-712 typed data")
+	}
+
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to hash EIP-712 typed data")
+	}
+
+	signature, pubKey, err := tx.NewSigner(privKey).SignByAddress(senderAddress, sigHash)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to sign EIP-712 typed data")
+	}
+	signature[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
+
+	txBuilder, ok := tf.ec.TxConfig.NewTxBuilder().(authtx.ExtensionOptionsTxBuilder)
+	if !ok {
+		return nil, errors.New("txBuilder could not be casted to authtx.ExtensionOptionsTxBuilder type")
+	}
+
+	if err := txBuilder.SetMsgs(msgs...); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to set tx msgs")
+	}
+	txBuilder.SetFeeAmount(fee.Amount)
+	txBuilder.SetGasLimit(fee.Gas)
+
+	sigsV2 := signing.SignatureV2{
+		PubKey: pubKey,
+		Data: &signing.SingleSignatureData{
+			SignMode:  signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			Signature: signature,
+		},
+		Sequence: account.GetSequence(),
+	}
+	if err := txBuilder.SetSignatures(sigsV2); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to set tx signatures")
+	}
+
+	return txBuilder.GetTx(), nil
+}
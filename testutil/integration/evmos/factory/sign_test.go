@@ -0,0 +1,53 @@
+package factory_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/evmos/evmos/v16/app"
+	"github.com/evmos/evmos/v16/encoding"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/factory"
+	grpchandler "github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateSignedEIP712Tx(t *testing.T) {
+	keyring := testkeyring.New(2)
+	nw := network.New(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	handler := grpchandler.NewIntegrationHandler(nw)
+	tf := factory.New(nw, handler)
+
+	sender := keyring.GetKey(0)
+	receiver := keyring.GetAccAddr(1)
+	denom := nw.GetDenom()
+
+	prevBalance, err := handler.GetBalance(receiver, denom)
+	require.NoError(t, err)
+
+	amount := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1000)))
+	msg := banktypes.NewMsgSend(sender.AccAddr, receiver, amount)
+	fee := legacytx.NewStdFee(200_000, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1e16)))) //nolint:staticcheck
+
+	tx, err := tf.GenerateSignedEIP712Tx(sender.Priv, []sdk.Msg{msg}, fee, nw.GetChainID())
+	require.NoError(t, err)
+
+	txConfig := encoding.MakeConfig(app.ModuleBasics).TxConfig
+	txBytes, err := txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	res, err := nw.BroadcastTxSync(txBytes)
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), "expected the EIP-712 tx to succeed: %s", res.Log)
+	require.NoError(t, nw.NextBlock())
+
+	balance, err := handler.GetBalance(receiver, denom)
+	require.NoError(t, err)
+	require.Equal(t, prevBalance.Balance.Add(amount[0]), *balance.Balance)
+}
@@ -42,3 +42,13 @@ type ContractDeploymentData struct {
 	// ConstructorArgs are the arguments to pass to the constructor.
 	ConstructorArgs []interface{}
 }
+
+// ERC20DeployArgs is a struct to define the constructor arguments of the standard ERC20 test contract.
+type ERC20DeployArgs struct {
+	// Name is the name of the token.
+	Name string
+	// Symbol is the symbol of the token.
+	Symbol string
+	// Decimals is the number of decimals of the token.
+	Decimals uint8
+}
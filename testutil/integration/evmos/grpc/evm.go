@@ -4,8 +4,12 @@ package grpc
 
 import (
 	"context"
+	"fmt"
+	"math/big"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/common"
+	rpctypes "github.com/evmos/evmos/v16/rpc/types"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 )
 
@@ -17,10 +21,85 @@ func (gqh *IntegrationHandler) GetEvmAccount(address common.Address) (*evmtypes.
 	})
 }
 
+// GetEvmAccountState returns the nonce, code and balance of the EVM account with
+// the given address, aggregating the EVM module Account and Code queries. It
+// returns zero values for the accounts that do not exist, so that the code is
+// only non-empty for contracts.
+func (gqh *IntegrationHandler) GetEvmAccountState(address common.Address) (nonce uint64, code []byte, balance *big.Int, err error) {
+	accountRes, err := gqh.GetEvmAccount(address)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	balance, ok := new(big.Int).SetString(accountRes.Balance, 10)
+	if !ok {
+		return 0, nil, nil, fmt.Errorf("invalid balance %q", accountRes.Balance)
+	}
+
+	evmClient := gqh.network.GetEvmClient()
+	codeRes, err := evmClient.Code(context.Background(), &evmtypes.QueryCodeRequest{
+		Address: address.String(),
+	})
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	return accountRes.Nonce, codeRes.Code, balance, nil
+}
+
+// GetContractStorage returns the storage slots of the account with the given
+// address at the given block height, with pagination. A zero height uses the
+// latest state.
+func (gqh *IntegrationHandler) GetContractStorage(address common.Address, pagination *query.PageRequest, height int64) (*evmtypes.QueryContractStorageResponse, error) {
+	evmClient := gqh.network.GetEvmClient()
+	return evmClient.ContractStorage(rpctypes.ContextWithHeight(height), &evmtypes.QueryContractStorageRequest{
+		Address:    address.String(),
+		Pagination: pagination,
+	})
+}
+
 // EstimateGas returns the estimated gas for the given call args.
 func (gqh *IntegrationHandler) EstimateGas(args []byte, gasCap uint64) (*evmtypes.EstimateGasResponse, error) {
+	return gqh.EstimateGasWithOverrides(args, nil, gasCap)
+}
+
+// EstimateGasWithOverrides returns the estimated gas for the given call args
+// against the state resulting from applying the given JSON-encoded state overrides.
+func (gqh *IntegrationHandler) EstimateGasWithOverrides(args []byte, overrides []byte, gasCap uint64) (*evmtypes.EstimateGasResponse, error) {
 	evmClient := gqh.network.GetEvmClient()
 	return evmClient.EstimateGas(context.Background(), &evmtypes.EthCallRequest{
+		Args:      args,
+		GasCap:    gasCap,
+		Overrides: overrides,
+	})
+}
+
+// EstimateGasAtHeight returns the estimated gas for the given call args against
+// the state at the given block height. A zero height uses the latest state.
+func (gqh *IntegrationHandler) EstimateGasAtHeight(args []byte, gasCap uint64, height int64) (*evmtypes.EstimateGasResponse, error) {
+	evmClient := gqh.network.GetEvmClient()
+	return evmClient.EstimateGas(rpctypes.ContextWithHeight(height), &evmtypes.EthCallRequest{
+		Args:   args,
+		GasCap: gasCap,
+	})
+}
+
+// EthCallAtHeight executes the given call args without committing any state
+// change against the state at the given block height. A zero height uses the
+// latest state.
+func (gqh *IntegrationHandler) EthCallAtHeight(args []byte, gasCap uint64, height int64) (*evmtypes.MsgEthereumTxResponse, error) {
+	evmClient := gqh.network.GetEvmClient()
+	return evmClient.EthCall(rpctypes.ContextWithHeight(height), &evmtypes.EthCallRequest{
+		Args:   args,
+		GasCap: gasCap,
+	})
+}
+
+// CreateAccessList returns the access list generated for the given call args
+// along with the gas used when executing the call with it.
+func (gqh *IntegrationHandler) CreateAccessList(args []byte, gasCap uint64) (*evmtypes.CreateAccessListResponse, error) {
+	evmClient := gqh.network.GetEvmClient()
+	return evmClient.CreateAccessList(context.Background(), &evmtypes.EthCallRequest{
 		Args:   args,
 		GasCap: gasCap,
 	})
@@ -0,0 +1,120 @@
+package grpc_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v16/contracts"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/factory"
+	grpchandler "github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEvmAccountState(t *testing.T) {
+	keyring := testkeyring.New(1)
+	nw := network.New(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	handler := grpchandler.NewIntegrationHandler(nw)
+	tf := factory.New(nw, handler)
+
+	contractAddr, err := tf.DeployERC20(keyring.GetPrivKey(0), factory.ERC20DeployArgs{
+		Name:     "Test",
+		Symbol:   "TEST",
+		Decimals: 18,
+	})
+	require.NoError(t, err, "failed to deploy contract")
+	require.NoError(t, nw.NextBlock(), "failed to advance block")
+
+	testcases := []struct {
+		name       string
+		address    common.Address
+		expNonce   uint64
+		expCode    bool
+		expBalance *big.Int
+	}{
+		{
+			name:       "externally owned account",
+			address:    keyring.GetAddr(0),
+			expNonce:   1,
+			expBalance: nil,
+		},
+		{
+			name:       "contract",
+			address:    contractAddr,
+			expNonce:   1,
+			expCode:    true,
+			expBalance: big.NewInt(0),
+		},
+		{
+			name:       "non-existent account",
+			address:    common.HexToAddress("0x1234567890123456789012345678901234567890"),
+			expBalance: big.NewInt(0),
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			nonce, code, balance, err := handler.GetEvmAccountState(tc.address)
+			require.NoError(t, err, "unexpected error querying account state")
+			require.Equal(t, tc.expNonce, nonce, "unexpected nonce")
+			require.Equal(t, tc.expCode, len(code) > 0, "unexpected code")
+			require.NotNil(t, balance, "expected balance")
+			if tc.expBalance != nil {
+				require.Equal(t, tc.expBalance.String(), balance.String(), "unexpected balance")
+			} else {
+				require.Positive(t, balance.Sign(), "expected positive balance")
+			}
+		})
+	}
+}
+
+func TestGetContractStorage(t *testing.T) {
+	keyring := testkeyring.New(1)
+	nw := network.New(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	handler := grpchandler.NewIntegrationHandler(nw)
+	tf := factory.New(nw, handler)
+
+	sender := keyring.GetKey(0)
+	contractAddr, err := tf.DeployERC20(sender.Priv, factory.ERC20DeployArgs{
+		Name:     "Test",
+		Symbol:   "TEST",
+		Decimals: 18,
+	})
+	require.NoError(t, err, "failed to deploy contract")
+	require.NoError(t, nw.NextBlock(), "failed to advance block")
+
+	// the last committed height holds the contract without any minted tokens
+	deployHeight := nw.GetContext().BlockHeight() - 1
+
+	_, err = tf.CallContract(sender.Priv, contractAddr, contracts.ERC20MinterBurnerDecimalsContract.ABI, "mint", sender.Addr, big.NewInt(1000))
+	require.NoError(t, err, "failed to mint tokens")
+	require.NoError(t, nw.NextBlock(), "failed to advance block")
+
+	deployRes, err := handler.GetContractStorage(contractAddr, nil, deployHeight)
+	require.NoError(t, err, "unexpected error querying storage at deploy height")
+	require.NotEmpty(t, deployRes.Storage, "expected storage slots after deployment")
+
+	res, err := handler.GetContractStorage(contractAddr, nil, 0)
+	require.NoError(t, err, "unexpected error querying storage")
+	// minting sets the balance of the recipient and the total supply
+	require.Len(t, res.Storage, len(deployRes.Storage)+2, "unexpected number of storage slots")
+	require.Equal(t, uint64(len(res.Storage)), res.Pagination.Total, "unexpected total")
+
+	pageRes, err := handler.GetContractStorage(contractAddr, &query.PageRequest{Limit: 1}, 0)
+	require.NoError(t, err, "unexpected error querying storage page")
+	require.Len(t, pageRes.Storage, 1, "unexpected page size")
+	require.Equal(t, res.Storage[0], pageRes.Storage[0], "unexpected first slot")
+	require.NotEmpty(t, pageRes.Pagination.NextKey, "expected next page")
+
+	eoaRes, err := handler.GetContractStorage(sender.Addr, nil, 0)
+	require.NoError(t, err, "unexpected error querying storage of an EOA")
+	require.Empty(t, eoaRes.Storage, "expected no storage slots for an EOA")
+}
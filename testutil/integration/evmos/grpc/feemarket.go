@@ -14,7 +14,14 @@ func (gqh *IntegrationHandler) GetBaseFee() (*feemarkettypes.QueryBaseFeeRespons
 	return feeMarketClient.BaseFee(context.Background(), &feemarkettypes.QueryBaseFeeRequest{})
 }
 
-// GetBaseFee returns the base fee from the feemarket module.
+// GetBaseFeeAtHeight returns the base fee from the feemarket module that was
+// in effect at the given block height. A zero height uses the latest state.
+func (gqh *IntegrationHandler) GetBaseFeeAtHeight(height int64) (*feemarkettypes.QueryBaseFeeResponse, error) {
+	feeMarketClient := gqh.network.GetFeeMarketClient()
+	return feeMarketClient.BaseFee(context.Background(), &feemarkettypes.QueryBaseFeeRequest{Height: height})
+}
+
+// GetFeeMarketParams returns the params from the feemarket module.
 func (gqh *IntegrationHandler) GetFeeMarketParams() (*feemarkettypes.QueryParamsResponse, error) {
 	feeMarketClient := gqh.network.GetFeeMarketClient()
 	return feeMarketClient.Params(context.Background(), &feemarkettypes.QueryParamsRequest{})
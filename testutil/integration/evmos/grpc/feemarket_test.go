@@ -0,0 +1,39 @@
+package grpc_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	grpchandler "github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBaseFeeAtHeight(t *testing.T) {
+	nw := network.New()
+	handler := grpchandler.NewIntegrationHandler(nw)
+
+	// record the base fee of the latest state at each height
+	baseFees := make(map[int64]sdkmath.Int)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, nw.NextBlock(), "failed to advance block")
+
+		res, err := handler.GetBaseFee()
+		require.NoError(t, err, "failed to query base fee")
+		require.NotNil(t, res.BaseFee, "expected base fee")
+		baseFees[nw.GetContext().BlockHeight()] = *res.BaseFee
+	}
+
+	for height, expBaseFee := range baseFees {
+		res, err := handler.GetBaseFeeAtHeight(height)
+		require.NoError(t, err, "failed to query base fee at height %d", height)
+		require.NotNil(t, res.BaseFee, "expected base fee at height %d", height)
+		require.Equal(t, expBaseFee.String(), res.BaseFee.String(), "unexpected base fee at height %d", height)
+	}
+
+	_, err := handler.GetBaseFeeAtHeight(nw.GetContext().BlockHeight() + 1)
+	require.ErrorContains(t, err, "in the future")
+
+	_, err = handler.GetBaseFeeAtHeight(-1)
+	require.ErrorContains(t, err, "invalid height")
+}
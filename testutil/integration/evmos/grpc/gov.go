@@ -6,6 +6,7 @@ package grpc
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"golang.org/x/exp/slices"
 )
@@ -26,3 +27,33 @@ func (gqh *IntegrationHandler) GetProposal(proposalID uint64) (*govtypes.QueryPr
 	govClient := gqh.network.GetGovClient()
 	return govClient.Proposal(gqh.network.GetContext(), &govtypes.QueryProposalRequest{ProposalId: proposalID})
 }
+
+// GetProposals returns the proposals from the gov module, with pagination.
+func (gqh *IntegrationHandler) GetProposals(pagination *query.PageRequest) (*govtypes.QueryProposalsResponse, error) {
+	govClient := gqh.network.GetGovClient()
+	return govClient.Proposals(gqh.network.GetContext(), &govtypes.QueryProposalsRequest{Pagination: pagination})
+}
+
+// GetVote returns the vote of the given voter on the given proposal from the gov module.
+func (gqh *IntegrationHandler) GetVote(proposalID uint64, voter string) (*govtypes.QueryVoteResponse, error) {
+	govClient := gqh.network.GetGovClient()
+	return govClient.Vote(gqh.network.GetContext(), &govtypes.QueryVoteRequest{
+		ProposalId: proposalID,
+		Voter:      voter,
+	})
+}
+
+// GetDeposit returns the deposit of the given depositor on the given proposal from the gov module.
+func (gqh *IntegrationHandler) GetDeposit(proposalID uint64, depositor string) (*govtypes.QueryDepositResponse, error) {
+	govClient := gqh.network.GetGovClient()
+	return govClient.Deposit(gqh.network.GetContext(), &govtypes.QueryDepositRequest{
+		ProposalId: proposalID,
+		Depositor:  depositor,
+	})
+}
+
+// GetTallyResult returns the tally result of the given proposal from the gov module.
+func (gqh *IntegrationHandler) GetTallyResult(proposalID uint64) (*govtypes.QueryTallyResultResponse, error) {
+	govClient := gqh.network.GetGovClient()
+	return govClient.TallyResult(gqh.network.GetContext(), &govtypes.QueryTallyResultRequest{ProposalId: proposalID})
+}
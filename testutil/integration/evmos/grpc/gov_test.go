@@ -0,0 +1,74 @@
+package grpc_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/factory"
+	grpchandler "github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v16/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGovQueries(t *testing.T) {
+	keyring := testkeyring.New(1)
+	nw := network.New(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	handler := grpchandler.NewIntegrationHandler(nw)
+	tf := factory.New(nw, handler)
+
+	proposer := keyring.GetKey(0)
+	deposit := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(1e18)))
+
+	// submit two text proposals and vote on the first one with the account
+	// holding all the delegations of the network
+	for i := 0; i < 2; i++ {
+		msg, err := govtypes.NewMsgSubmitProposal(nil, deposit, proposer.AccAddr.String(), "ipfs://CID", "Test", "Test proposal")
+		require.NoError(t, err)
+		_, err = tf.ExecuteCosmosMsgs(proposer.Priv, msg)
+		require.NoError(t, err, "failed to submit proposal")
+		require.NoError(t, nw.NextBlock(), "failed to advance block")
+	}
+	_, err := tf.ExecuteCosmosMsgs(proposer.Priv, govtypes.NewMsgVote(proposer.AccAddr, 1, govtypes.OptionYes, ""))
+	require.NoError(t, err, "failed to vote")
+	require.NoError(t, nw.NextBlock(), "failed to advance block")
+
+	proposalRes, err := handler.GetProposal(1)
+	require.NoError(t, err)
+	require.Equal(t, govtypes.StatusVotingPeriod, proposalRes.Proposal.Status, "wrong proposal status")
+	require.Equal(t, proposer.AccAddr.String(), proposalRes.Proposal.Proposer, "wrong proposer")
+
+	proposalsRes, err := handler.GetProposals(&query.PageRequest{Limit: 1, CountTotal: true})
+	require.NoError(t, err)
+	require.Len(t, proposalsRes.Proposals, 1, "wrong number of proposals in page")
+	require.Equal(t, uint64(2), proposalsRes.Pagination.Total, "wrong total of proposals")
+	require.Equal(t, proposalRes.Proposal, proposalsRes.Proposals[0], "wrong first proposal")
+
+	voteRes, err := handler.GetVote(1, proposer.AccAddr.String())
+	require.NoError(t, err)
+	require.Len(t, voteRes.Vote.Options, 1, "wrong number of vote options")
+	require.Equal(t, govtypes.OptionYes, voteRes.Vote.Options[0].Option, "wrong vote option")
+
+	_, err = handler.GetVote(2, proposer.AccAddr.String())
+	require.ErrorContains(t, err, "not found", "expected no vote on the second proposal")
+
+	depositRes, err := handler.GetDeposit(1, proposer.AccAddr.String())
+	require.NoError(t, err)
+	require.Equal(t, deposit, sdk.Coins(depositRes.Deposit.Amount), "wrong deposit amount")
+
+	// the proposer delegates all the bonded tokens of the network
+	bondedTokens := sdk.ZeroInt()
+	for _, validator := range nw.GetValidators() {
+		bondedTokens = bondedTokens.Add(validator.Tokens)
+	}
+	tallyRes, err := handler.GetTallyResult(1)
+	require.NoError(t, err)
+	require.Equal(t, bondedTokens.String(), tallyRes.Tally.YesCount, "wrong yes count")
+	require.Equal(t, "0", tallyRes.Tally.NoCount, "wrong no count")
+}
@@ -4,6 +4,9 @@
 package grpc
 
 import (
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/ethereum/go-ethereum/common"
 	commongrpc "github.com/evmos/evmos/v16/testutil/integration/common/grpc"
@@ -19,15 +22,26 @@ type Handler interface {
 
 	// EVM methods
 	GetEvmAccount(address common.Address) (*evmtypes.QueryAccountResponse, error)
+	GetEvmAccountState(address common.Address) (nonce uint64, code []byte, balance *big.Int, err error)
+	GetContractStorage(address common.Address, pagination *query.PageRequest, height int64) (*evmtypes.QueryContractStorageResponse, error)
 	EstimateGas(args []byte, GasCap uint64) (*evmtypes.EstimateGasResponse, error)
+	EstimateGasWithOverrides(args []byte, overrides []byte, GasCap uint64) (*evmtypes.EstimateGasResponse, error)
+	EstimateGasAtHeight(args []byte, GasCap uint64, height int64) (*evmtypes.EstimateGasResponse, error)
+	EthCallAtHeight(args []byte, GasCap uint64, height int64) (*evmtypes.MsgEthereumTxResponse, error)
+	CreateAccessList(args []byte, GasCap uint64) (*evmtypes.CreateAccessListResponse, error)
 	GetEvmParams() (*evmtypes.QueryParamsResponse, error)
 
 	// FeeMarket methods
 	GetBaseFee() (*feemarkettypes.QueryBaseFeeResponse, error)
+	GetBaseFeeAtHeight(height int64) (*feemarkettypes.QueryBaseFeeResponse, error)
 	GetFeeMarketParams() (*feemarkettypes.QueryParamsResponse, error)
 
 	// Gov methods
 	GetProposal(proposalID uint64) (*govtypes.QueryProposalResponse, error)
+	GetProposals(pagination *query.PageRequest) (*govtypes.QueryProposalsResponse, error)
+	GetVote(proposalID uint64, voter string) (*govtypes.QueryVoteResponse, error)
+	GetDeposit(proposalID uint64, depositor string) (*govtypes.QueryDepositResponse, error)
+	GetTallyResult(proposalID uint64) (*govtypes.QueryTallyResultResponse, error)
 	GetGovParams(paramsType string) (*govtypes.QueryParamsResponse, error)
 }
 
@@ -3,6 +3,7 @@
 package network
 
 import (
+	"fmt"
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -18,18 +19,27 @@ func (n *IntegrationNetwork) NextBlock() error {
 // NextBlockAfter is a private helper function that runs the EndBlocker logic, commits the changes,
 // updates the header to have a block time after the given duration and runs the BeginBlocker.
 func (n *IntegrationNetwork) NextBlockAfter(duration time.Duration) error {
-	// End block and commit
+	return n.NextBlockAt(n.ctx.BlockTime().Add(duration))
+}
+
+// NextBlockAt runs the EndBlocker logic, commits the changes, updates the header to have the
+// given block time and runs the BeginBlocker, so that the BeginBlockers (e.g. epochs and
+// feemarket) see the given time. It allows to jump past an epoch boundary or a vesting cliff
+// in a single block. The block time cannot be before the one of the current block.
+func (n *IntegrationNetwork) NextBlockAt(blockTime time.Time) error {
 	header := n.ctx.BlockHeader()
+	if blockTime.Before(header.Time) {
+		return fmt.Errorf("block time %s is before the current block time %s", blockTime, header.Time)
+	}
+
+	// End block and commit
 	n.app.EndBlocker(n.ctx, abci.RequestEndBlock{Height: header.Height})
 	n.app.Commit()
 
-	// Calculate new block time after duration
-	newBlockTime := header.Time.Add(duration)
-
 	// Update block header and BeginBlock
 	header.Height++
 	header.AppHash = n.app.LastCommitID().Hash
-	header.Time = newBlockTime
+	header.Time = blockTime
 	n.app.BeginBlock(abci.RequestBeginBlock{
 		Header: header,
 	})
@@ -46,3 +56,24 @@ func (n *IntegrationNetwork) NextBlockAfter(duration time.Duration) error {
 	n.ctx = newCtx
 	return nil
 }
+
+// WaitUntil advances the network one block at a time, evaluating the given
+// predicate after each block, until the predicate is met. It returns an error
+// if the predicate fails or is still not met after maxBlocks blocks.
+func (n *IntegrationNetwork) WaitUntil(predicate func() (bool, error), maxBlocks int) error {
+	for i := 0; i < maxBlocks; i++ {
+		if err := n.NextBlock(); err != nil {
+			return err
+		}
+
+		ok, err := predicate()
+		if err != nil {
+			return fmt.Errorf("failed to evaluate the predicate at height %d: %w", n.ctx.BlockHeight(), err)
+		}
+		if ok {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("predicate not met after %d blocks", maxBlocks)
+}
@@ -0,0 +1,93 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package network_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	epochstypes "github.com/evmos/evmos/v16/x/epochs/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitUntil(t *testing.T) {
+	testCases := []struct {
+		name        string
+		predicate   func(nw network.Network, startHeight int64) func() (bool, error)
+		maxBlocks   int
+		expBlocks   int64
+		errContains string
+	}{
+		{
+			name: "pass - predicate met before the limit",
+			predicate: func(nw network.Network, startHeight int64) func() (bool, error) {
+				return func() (bool, error) {
+					return nw.GetContext().BlockHeight() >= startHeight+3, nil
+				}
+			},
+			maxBlocks: 5,
+			expBlocks: 3,
+		},
+		{
+			name: "fail - predicate not met before the limit",
+			predicate: func(_ network.Network, _ int64) func() (bool, error) {
+				return func() (bool, error) { return false, nil }
+			},
+			maxBlocks:   2,
+			expBlocks:   2,
+			errContains: "predicate not met after 2 blocks",
+		},
+		{
+			name: "fail - predicate error",
+			predicate: func(_ network.Network, _ int64) func() (bool, error) {
+				return func() (bool, error) { return false, errors.New("query failed") }
+			},
+			maxBlocks:   5,
+			expBlocks:   1,
+			errContains: "query failed",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			nw := network.New()
+			startHeight := nw.GetContext().BlockHeight()
+
+			err := nw.WaitUntil(tc.predicate(nw, startHeight), tc.maxBlocks)
+			if tc.errContains != "" {
+				require.ErrorContains(t, err, tc.errContains)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, startHeight+tc.expBlocks, nw.GetContext().BlockHeight())
+		})
+	}
+}
+
+func TestNextBlockAt(t *testing.T) {
+	nw := network.New()
+	require.NoError(t, nw.NextBlock())
+
+	currentEpoch := func() int64 {
+		res, err := nw.GetEpochsClient().CurrentEpoch(
+			nw.GetContext(),
+			&epochstypes.QueryCurrentEpochRequest{Identifier: epochstypes.DayEpochID},
+		)
+		require.NoError(t, err)
+		return res.CurrentEpoch
+	}
+	startEpoch := currentEpoch()
+
+	// jump past the end of the current day epoch in a single block
+	blockTime := nw.GetContext().BlockTime().Add(25 * time.Hour)
+	require.NoError(t, nw.NextBlockAt(blockTime))
+	require.Equal(t, blockTime, nw.GetContext().BlockTime())
+	require.Equal(t, startEpoch+1, currentEpoch())
+
+	err := nw.NextBlockAt(blockTime.Add(-time.Second))
+	require.ErrorContains(t, err, "is before the current block time")
+}
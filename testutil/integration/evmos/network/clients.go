@@ -3,20 +3,30 @@
 package network
 
 import (
+	"context"
+	"fmt"
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/evmos/evmos/v16/app"
 	"github.com/evmos/evmos/v16/encoding"
+	epochstypes "github.com/evmos/evmos/v16/x/epochs/types"
 	erc20types "github.com/evmos/evmos/v16/x/erc20/types"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 	infltypes "github.com/evmos/evmos/v16/x/inflation/v1/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 func getQueryHelper(ctx sdktypes.Context) *baseapp.QueryServiceTestHelper {
@@ -34,10 +44,20 @@ func (n *IntegrationNetwork) GetERC20Client() erc20types.QueryClient {
 	return erc20types.NewQueryClient(queryHelper)
 }
 
+// GetEvmClient returns a client for the EVM module queries. The queries are
+// executed against the state at the height set in the gRPC block height header
+// of the request context, if any, or against the current state otherwise.
+//
+// The network keeps the state of all the committed heights, since the app is
+// created with the default "nothing" pruning strategy. Using any other strategy
+// requires the queried heights to be kept (i.e. within keep-recent).
 func (n *IntegrationNetwork) GetEvmClient() evmtypes.QueryClient {
-	queryHelper := getQueryHelper(n.GetContext())
-	evmtypes.RegisterQueryServer(queryHelper, n.app.EvmKeeper)
-	return evmtypes.NewQueryClient(queryHelper)
+	return evmtypes.NewQueryClient(&heightQueryConn{
+		network: n,
+		register: func(queryHelper *baseapp.QueryServiceTestHelper) {
+			evmtypes.RegisterQueryServer(queryHelper, n.app.EvmKeeper)
+		},
+	})
 }
 
 func (n *IntegrationNetwork) GetGovClient() govtypes.QueryClient {
@@ -58,6 +78,12 @@ func (n *IntegrationNetwork) GetFeeMarketClient() feemarkettypes.QueryClient {
 	return feemarkettypes.NewQueryClient(queryHelper)
 }
 
+func (n *IntegrationNetwork) GetEpochsClient() epochstypes.QueryClient {
+	queryHelper := getQueryHelper(n.GetContext())
+	epochstypes.RegisterQueryServer(queryHelper, n.app.EpochsKeeper)
+	return epochstypes.NewQueryClient(queryHelper)
+}
+
 func (n *IntegrationNetwork) GetInflationClient() infltypes.QueryClient {
 	queryHelper := getQueryHelper(n.GetContext())
 	infltypes.RegisterQueryServer(queryHelper, n.app.InflationKeeper)
@@ -81,3 +107,45 @@ func (n *IntegrationNetwork) GetStakingClient() stakingtypes.QueryClient {
 	stakingtypes.RegisterQueryServer(queryHelper, stakingkeeper.Querier{Keeper: &n.app.StakingKeeper})
 	return stakingtypes.NewQueryClient(queryHelper)
 }
+
+func (n *IntegrationNetwork) GetDistrClient() distrtypes.QueryClient {
+	queryHelper := getQueryHelper(n.GetContext())
+	distrtypes.RegisterQueryServer(queryHelper, distrkeeper.NewQuerier(n.app.DistrKeeper))
+	return distrtypes.NewQueryClient(queryHelper)
+}
+
+// heightQueryConn is a gRPC client connection that executes the queries against
+// the state at the height set in the gRPC block height header of the request
+// context, mimicking the behavior of the node gRPC server.
+type heightQueryConn struct {
+	network  *IntegrationNetwork
+	register func(*baseapp.QueryServiceTestHelper)
+}
+
+// Invoke implements the grpc ClientConn.Invoke method
+func (c *heightQueryConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	queryCtx := c.network.GetContext()
+
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		if heights := md.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+			height, err := strconv.ParseInt(heights[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid '%s' gRPC header: %w", grpctypes.GRPCBlockHeightHeader, err)
+			}
+
+			queryCtx, err = c.network.app.CreateQueryContext(height, false)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	queryHelper := getQueryHelper(queryCtx)
+	c.register(queryHelper)
+	return queryHelper.Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewStream implements the grpc ClientConn.NewStream method
+func (c *heightQueryConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, fmt.Errorf("not supported")
+}
@@ -4,6 +4,7 @@
 package network
 
 import (
+	"fmt"
 	"math/big"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
@@ -21,6 +22,7 @@ type Config struct {
 	chainID            string
 	eip155ChainID      *big.Int
 	amountOfValidators int
+	validatorPowers    []int64
 	preFundedAccounts  []sdktypes.AccAddress
 	balances           []banktypes.Balance
 	denom              string
@@ -63,6 +65,20 @@ func getGenAccountsAndBalances(cfg Config) (genAccounts []authtypes.GenesisAccou
 	return
 }
 
+// getValidatorPowers returns the voting power of each validator of the network,
+// which defaults to 1.
+func (cfg Config) getValidatorPowers() []int64 {
+	if len(cfg.validatorPowers) > 0 {
+		return cfg.validatorPowers
+	}
+
+	powers := make([]int64, cfg.amountOfValidators)
+	for i := range powers {
+		powers[i] = 1
+	}
+	return powers
+}
+
 // ConfigOption defines a function that can modify the NetworkConfig.
 // The purpose of this is to force to be declarative when the default configuration
 // requires to be changed.
@@ -84,6 +100,22 @@ func WithChainID(chainID string) ConfigOption {
 func WithAmountOfValidators(amount int) ConfigOption {
 	return func(cfg *Config) {
 		cfg.amountOfValidators = amount
+		cfg.validatorPowers = nil
+	}
+}
+
+// WithValidatorPowers sets one validator for the network per given voting
+// power, with the corresponding amount of bonded tokens. It panics if a
+// voting power is not positive.
+func WithValidatorPowers(powers ...int64) ConfigOption {
+	for _, power := range powers {
+		if power <= 0 {
+			panic(fmt.Sprintf("invalid validator power %d", power))
+		}
+	}
+	return func(cfg *Config) {
+		cfg.amountOfValidators = len(powers)
+		cfg.validatorPowers = powers
 	}
 }
 
@@ -8,9 +8,11 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	grpchandler "github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
 	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
 	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v16/types"
 	"github.com/evmos/evmos/v16/utils"
 	"github.com/stretchr/testify/require"
 )
@@ -49,3 +51,38 @@ func TestWithBalances(t *testing.T) {
 	require.Len(t, req.Balances, 2, "wrong number of balances")
 	require.Equal(t, balances[1].Coins, req.Balances, "wrong balances")
 }
+
+func TestWithValidatorPowers(t *testing.T) {
+	nw := network.New(
+		network.WithValidatorPowers(1, 5, 3),
+	)
+
+	validators := nw.GetValidators()
+	require.Len(t, validators, 3, "wrong number of validators")
+
+	// the validators are sorted by decreasing voting power
+	expPowers := []int64{5, 3, 1}
+	for i, expPower := range expPowers {
+		operatorAddress := nw.GetValidatorOperatorAddress(i)
+		require.Equal(t, validators[i].OperatorAddress, operatorAddress, "wrong operator address")
+
+		res, err := nw.GetStakingClient().Validator(
+			nw.GetContext(),
+			&stakingtypes.QueryValidatorRequest{ValidatorAddr: operatorAddress},
+		)
+		require.NoError(t, err, "error getting validator")
+		require.Equal(t, stakingtypes.Bonded, res.Validator.Status, "expected bonded validator")
+		require.Equal(t, expPower, res.Validator.ConsensusPower(types.PowerReduction), "wrong consensus power")
+	}
+
+	// all the validators are bonded
+	valSet, err := nw.GetStakingClient().Validators(
+		nw.GetContext(),
+		&stakingtypes.QueryValidatorsRequest{Status: stakingtypes.Bonded.String()},
+	)
+	require.NoError(t, err, "error getting validators")
+	require.Len(t, valSet.Validators, 3, "wrong number of bonded validators")
+
+	// the network keeps producing blocks signed by the validator set
+	require.NoError(t, nw.NextBlock(), "error producing next block")
+}
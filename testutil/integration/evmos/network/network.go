@@ -12,7 +12,6 @@ import (
 
 	gethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/evmos/v16/app"
-	"github.com/evmos/evmos/v16/types"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -22,6 +21,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	commonnetwork "github.com/evmos/evmos/v16/testutil/integration/common/network"
+	epochstypes "github.com/evmos/evmos/v16/x/epochs/types"
 	erc20types "github.com/evmos/evmos/v16/x/erc20/types"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
@@ -37,12 +37,14 @@ type Network interface {
 
 	GetEIP155ChainID() *big.Int
 	GetEVMChainConfig() *gethparams.ChainConfig
+	GetValidatorOperatorAddress(index int) string
 
 	// Clients
 	GetERC20Client() erc20types.QueryClient
 	GetEvmClient() evmtypes.QueryClient
 	GetGovClient() govtypes.QueryClient
 	GetInflationClient() infltypes.QueryClient
+	GetEpochsClient() epochstypes.QueryClient
 	GetFeeMarketClient() feemarkettypes.QueryClient
 
 	// Because to update the module params on a conventional manner governance
@@ -51,6 +53,9 @@ type Network interface {
 	UpdateGovParams(params govtypes.Params) error
 	UpdateInflationParams(params infltypes.Params) error
 	UpdateFeeMarketParams(params feemarkettypes.Params) error
+
+	// SlashValidator applies a slash to the given validator at the current height
+	SlashValidator(valAddr sdktypes.ValAddress, fraction sdktypes.Dec) error
 }
 
 var _ Network = (*IntegrationNetwork)(nil)
@@ -93,12 +98,8 @@ func New(opts ...ConfigOption) *IntegrationNetwork {
 	return network
 }
 
-var (
-	// bondedAmt is the amount of tokens that each validator will have initially bonded
-	bondedAmt = sdktypes.TokensFromConsensusPower(1, types.PowerReduction)
-	// PrefundedAccountInitialBalance is the amount of tokens that each prefunded account has at genesis
-	PrefundedAccountInitialBalance = sdktypes.NewInt(int64(math.Pow10(18) * 4))
-)
+// PrefundedAccountInitialBalance is the amount of tokens that each prefunded account has at genesis
+var PrefundedAccountInitialBalance = sdktypes.NewInt(int64(math.Pow10(18) * 4))
 
 // configureAndInitChain initializes the network with the given configuration.
 // It creates the genesis state and starts the network.
@@ -107,17 +108,21 @@ func (n *IntegrationNetwork) configureAndInitChain() error {
 	// create genesis accounts
 	genAccounts, fundedAccountBalances := getGenAccountsAndBalances(n.cfg)
 
-	// Create validator set with the amount of validators specified in the config
-	// with the default power of 1.
-	valSet, valSigners := createValidatorSetAndSigners(n.cfg.amountOfValidators)
-	totalBonded := bondedAmt.Mul(sdktypes.NewInt(int64(n.cfg.amountOfValidators)))
+	// Create validator set with the amount of validators and voting powers
+	// specified in the config
+	valSet, valSigners := createValidatorSetAndSigners(n.cfg.getValidatorPowers())
 
 	// Build staking type validators and delegations
-	validators, err := createStakingValidators(valSet.Validators, bondedAmt)
+	validators, err := createStakingValidators(valSet.Validators)
 	if err != nil {
 		return err
 	}
 
+	totalBonded := sdktypes.ZeroInt()
+	for _, validator := range validators {
+		totalBonded = totalBonded.Add(validator.Tokens)
+	}
+
 	fundedAccountBalances = addBondedModuleAccountToFundedBalances(
 		fundedAccountBalances,
 		sdktypes.NewCoin(n.cfg.denom, totalBonded),
@@ -247,11 +252,17 @@ func (n *IntegrationNetwork) GetDenom() string {
 	return n.cfg.denom
 }
 
-// GetValidators returns the network's validators
+// GetValidators returns the network's validators, sorted by decreasing voting power
 func (n *IntegrationNetwork) GetValidators() []stakingtypes.Validator {
 	return n.validators
 }
 
+// GetValidatorOperatorAddress returns the bech32 operator address of the validator
+// at the given index of the network's validators. It panics if the index is out of range.
+func (n *IntegrationNetwork) GetValidatorOperatorAddress(index int) string {
+	return n.validators[index].OperatorAddress
+}
+
 // BroadcastTxSync broadcasts the given txBytes to the network and returns the response.
 // TODO - this should be change to gRPC
 func (n *IntegrationNetwork) BroadcastTxSync(txBytes []byte) (abcitypes.ResponseDeliverTx, error) {
@@ -259,6 +270,15 @@ func (n *IntegrationNetwork) BroadcastTxSync(txBytes []byte) (abcitypes.Response
 	return n.app.BaseApp.DeliverTx(req), nil
 }
 
+// CheckTx runs the given txBytes through the CheckTx ABCI method of the network and returns
+// the response. This does not commit any state changes besides the ones applied to the
+// check state (e.g. the sender's sequence).
+// TODO - this should be change to gRPC
+func (n *IntegrationNetwork) CheckTx(txBytes []byte) (abcitypes.ResponseCheckTx, error) {
+	req := abcitypes.RequestCheckTx{Tx: txBytes, Type: abcitypes.CheckTxType_New}
+	return n.app.BaseApp.CheckTx(req), nil
+}
+
 // Simulate simulates the given txBytes to the network and returns the simulated response.
 // TODO - this should be change to gRPC
 func (n *IntegrationNetwork) Simulate(txBytes []byte) (*txtypes.SimulateResponse, error) {
@@ -31,21 +31,22 @@ import (
 	epochstypes "github.com/evmos/evmos/v16/x/epochs/types"
 	infltypes "github.com/evmos/evmos/v16/x/inflation/v1/types"
 
+	"github.com/evmos/evmos/v16/types"
 	evmosutil "github.com/evmos/evmos/v16/utils"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 )
 
-// createValidatorSetAndSigners creates validator set with the amount of validators specified
-// with the default power of 1.
-func createValidatorSetAndSigners(numberOfValidators int) (*tmtypes.ValidatorSet, map[string]tmtypes.PrivValidator) {
+// createValidatorSetAndSigners creates validator set with one validator per given voting power.
+// NOTE: the validators of the set are sorted by decreasing voting power.
+func createValidatorSetAndSigners(powers []int64) (*tmtypes.ValidatorSet, map[string]tmtypes.PrivValidator) {
 	// Create validator set
-	tmValidators := make([]*tmtypes.Validator, 0, numberOfValidators)
-	signers := make(map[string]tmtypes.PrivValidator, numberOfValidators)
+	tmValidators := make([]*tmtypes.Validator, 0, len(powers))
+	signers := make(map[string]tmtypes.PrivValidator, len(powers))
 
-	for i := 0; i < numberOfValidators; i++ {
+	for _, power := range powers {
 		privVal := mock.NewPV()
 		pubKey, _ := privVal.GetPubKey()
-		validator := tmtypes.NewValidator(pubKey, 1)
+		validator := tmtypes.NewValidator(pubKey, power)
 		tmValidators = append(tmValidators, validator)
 		signers[pubKey.Address().String()] = privVal
 	}
@@ -147,12 +148,13 @@ func createStakingValidator(val *tmtypes.Validator, bondedAmt sdkmath.Int) (stak
 	return validator, nil
 }
 
-// createStakingValidators creates staking validators from the given tm validators and bonded
-// amounts
-func createStakingValidators(tmValidators []*tmtypes.Validator, bondedAmt sdkmath.Int) ([]stakingtypes.Validator, error) {
+// createStakingValidators creates staking validators from the given tm validators, with the
+// bonded amounts corresponding to their voting power
+func createStakingValidators(tmValidators []*tmtypes.Validator) ([]stakingtypes.Validator, error) {
 	amountOfValidators := len(tmValidators)
 	stakingValidators := make([]stakingtypes.Validator, 0, amountOfValidators)
 	for _, val := range tmValidators {
+		bondedAmt := sdktypes.TokensFromConsensusPower(val.VotingPower, types.PowerReduction)
 		validator, err := createStakingValidator(val, bondedAmt)
 		if err != nil {
 			return nil, err
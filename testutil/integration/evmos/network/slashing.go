@@ -0,0 +1,44 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package network
+
+import (
+	"fmt"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v16/types"
+)
+
+// SlashValidator slashes the given fraction of the stake of the validator with the
+// given operator address for an infraction committed at the current height. The
+// slash is applied through the slashing keeper, so the validator's tokens are
+// burned and the slash event is recorded by the distribution module.
+func (n *IntegrationNetwork) SlashValidator(valAddr sdktypes.ValAddress, fraction sdktypes.Dec) error {
+	if !fraction.IsPositive() || fraction.GT(sdktypes.OneDec()) {
+		return fmt.Errorf("invalid slash fraction %s, must be in (0, 1]", fraction)
+	}
+
+	validator, found := n.app.StakingKeeper.GetValidator(n.ctx, valAddr)
+	if !found {
+		return fmt.Errorf("validator %s not found", valAddr)
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	power := validator.GetConsensusPower(types.PowerReduction)
+	n.app.SlashingKeeper.Slash(n.ctx, consAddr, fraction, power, n.ctx.BlockHeight())
+
+	// keep the network's validators up to date with the slashed tokens
+	slashed, _ := n.app.StakingKeeper.GetValidator(n.ctx, valAddr)
+	for i, val := range n.validators {
+		if val.OperatorAddress == slashed.OperatorAddress {
+			n.validators[i] = slashed
+		}
+	}
+
+	return nil
+}
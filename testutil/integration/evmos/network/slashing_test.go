@@ -0,0 +1,88 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package network_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlashValidator(t *testing.T) {
+	testCases := []struct {
+		name        string
+		valAddr     func(nw network.Network) sdk.ValAddress
+		fraction    sdk.Dec
+		errContains string
+	}{
+		{
+			name: "pass - slash half of the stake",
+			valAddr: func(nw network.Network) sdk.ValAddress {
+				return nw.GetValidators()[0].GetOperator()
+			},
+			fraction: sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			name: "fail - invalid fraction",
+			valAddr: func(nw network.Network) sdk.ValAddress {
+				return nw.GetValidators()[0].GetOperator()
+			},
+			fraction:    sdk.NewDec(2),
+			errContains: "invalid slash fraction",
+		},
+		{
+			name: "fail - validator not found",
+			valAddr: func(_ network.Network) sdk.ValAddress {
+				return sdk.ValAddress([]byte("not a validator addr"))
+			},
+			fraction:    sdk.NewDecWithPrec(5, 1),
+			errContains: "not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw := network.New()
+			valAddr := tc.valAddr(nw)
+			initialTokens := nw.GetValidators()[0].Tokens
+
+			err := nw.SlashValidator(valAddr, tc.fraction)
+			if tc.errContains != "" {
+				require.ErrorContains(t, err, tc.errContains)
+				return
+			}
+			require.NoError(t, err)
+
+			expTokens := initialTokens.Sub(tc.fraction.MulInt(initialTokens).TruncateInt())
+			require.Equal(t, expTokens, nw.GetValidators()[0].Tokens, "wrong network validator tokens")
+
+			valRes, err := nw.GetStakingClient().Validator(
+				nw.GetContext(),
+				&stakingtypes.QueryValidatorRequest{ValidatorAddr: valAddr.String()},
+			)
+			require.NoError(t, err)
+			require.Equal(t, expTokens, valRes.Validator.Tokens, "wrong validator tokens")
+
+			height := uint64(nw.GetContext().BlockHeight())
+			slashesRes, err := nw.GetDistrClient().ValidatorSlashes(
+				nw.GetContext(),
+				&distrtypes.QueryValidatorSlashesRequest{
+					ValidatorAddress: valAddr.String(),
+					StartingHeight:   height,
+					EndingHeight:     height + 1,
+				},
+			)
+			require.NoError(t, err)
+			require.Len(t, slashesRes.Slashes, 1, "expected one slash event")
+			require.Equal(t, tc.fraction, slashesRes.Slashes[0].Fraction, "wrong slash fraction")
+
+			// the network keeps producing blocks after the validator power update
+			require.NoError(t, nw.NextBlock(), "error producing next block")
+		})
+	}
+}
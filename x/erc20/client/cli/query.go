@@ -26,6 +26,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetTokenPairsCmd(),
 		GetTokenPairCmd(),
+		GetDisabledTokenPairsCmd(),
 		GetParamsCmd(),
 	)
 	return cmd
@@ -100,6 +101,43 @@ func GetTokenPairCmd() *cobra.Command {
 	return cmd
 }
 
+// GetDisabledTokenPairsCmd queries all registered token pairs with conversion disabled
+func GetDisabledTokenPairsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disabled-token-pairs",
+		Short: "Gets registered token pairs with conversion disabled",
+		Long:  "Gets registered token pairs with conversion disabled",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDisabledTokenPairsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DisabledTokenPairs(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetParamsCmd queries erc20 module params
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -47,6 +47,41 @@ func (k Keeper) TokenPairs(c context.Context, req *types.QueryTokenPairsRequest)
 	}, nil
 }
 
+// DisabledTokenPairs returns all registered pairs whose conversion is disabled
+func (k Keeper) DisabledTokenPairs(c context.Context, req *types.QueryDisabledTokenPairsRequest) (*types.QueryDisabledTokenPairsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var pairs []types.TokenPair
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var pair types.TokenPair
+		if err := k.cdc.Unmarshal(value, &pair); err != nil {
+			return false, err
+		}
+
+		if pair.Enabled {
+			return false, nil
+		}
+
+		if accumulate {
+			pairs = append(pairs, pair)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryDisabledTokenPairsResponse{
+		TokenPairs: pairs,
+		Pagination: pageRes,
+	}, nil
+}
+
 // TokenPair returns a given registered token pair
 func (k Keeper) TokenPair(c context.Context, req *types.QueryTokenPairRequest) (*types.QueryTokenPairResponse, error) {
 	if req == nil {
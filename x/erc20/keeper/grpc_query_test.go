@@ -81,6 +81,79 @@ func (suite *KeeperTestSuite) TestTokenPairs() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestDisabledTokenPairs() {
+	var (
+		req    *types.QueryDisabledTokenPairsRequest
+		expRes *types.QueryDisabledTokenPairsResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"no pairs registered",
+			func() {
+				req = &types.QueryDisabledTokenPairsRequest{}
+				expRes = &types.QueryDisabledTokenPairsResponse{Pagination: &query.PageResponse{}}
+			},
+			true,
+		},
+		{
+			"only enabled pairs registered",
+			func() {
+				req = &types.QueryDisabledTokenPairsRequest{
+					Pagination: &query.PageRequest{Limit: 10, CountTotal: true},
+				}
+				pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+
+				expRes = &types.QueryDisabledTokenPairsResponse{
+					Pagination: &query.PageResponse{Total: 0},
+				}
+			},
+			true,
+		},
+		{
+			"1 disabled pair among 2 registered w/pagination",
+			func() {
+				req = &types.QueryDisabledTokenPairsRequest{
+					Pagination: &query.PageRequest{Limit: 10, CountTotal: true},
+				}
+				pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+				pair2 := types.NewTokenPair(utiltx.GenerateAddress(), "coin2", types.OWNER_MODULE)
+				pair2.Enabled = false
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair2)
+
+				expRes = &types.QueryDisabledTokenPairsResponse{
+					Pagination: &query.PageResponse{Total: 1},
+					TokenPairs: []types.TokenPair{pair2},
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.DisabledTokenPairs(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.Pagination, res.Pagination)
+				suite.Require().ElementsMatch(expRes.TokenPairs, res.TokenPairs)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestTokenPair() {
 	var (
 		req    *types.QueryTokenPairRequest
@@ -346,7 +346,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			}
 
 			if tc.disableTokenPair {
-				_, err := suite.app.Erc20Keeper.ToggleConversion(suite.ctx, pair.Denom)
+				_, err := suite.app.Erc20Keeper.ToggleTokenConversion(suite.ctx, pair.Denom)
 				suite.Require().NoError(err)
 			}
 
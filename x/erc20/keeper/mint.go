@@ -44,7 +44,7 @@ func (k Keeper) MintingEnabled(
 
 	if !pair.Enabled {
 		return types.TokenPair{}, errorsmod.Wrapf(
-			types.ErrERC20TokenPairDisabled, "minting token '%s' is not enabled by governance", token,
+			types.ErrERC20TokenPairDisabled, "conversion paused for token '%s' by governance", token,
 		)
 	}
 
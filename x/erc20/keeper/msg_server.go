@@ -30,9 +30,15 @@ func (k Keeper) ConvertCoin(
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// Error checked during msg validation
-	receiver := common.HexToAddress(msg.Receiver)
 	sender := sdk.MustAccAddressFromBech32(msg.Sender)
 
+	// Default to the sender as receiver, so that the emitted events carry the
+	// address that is credited
+	if msg.Receiver == "" {
+		msg.Receiver = common.BytesToAddress(sender).Hex()
+	}
+	receiver := common.HexToAddress(msg.Receiver)
+
 	pair, err := k.MintingEnabled(ctx, sender, receiver.Bytes(), msg.Coin.Denom)
 	if err != nil {
 		return nil, err
@@ -72,9 +78,15 @@ func (k Keeper) ConvertERC20(
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// Error checked during msg validation
-	receiver := sdk.MustAccAddressFromBech32(msg.Receiver)
 	sender := common.HexToAddress(msg.Sender)
 
+	// Default to the sender as receiver, so that the emitted events carry the
+	// address that is credited
+	if msg.Receiver == "" {
+		msg.Receiver = sdk.AccAddress(sender.Bytes()).String()
+	}
+	receiver := sdk.MustAccAddressFromBech32(msg.Receiver)
+
 	pair, err := k.MintingEnabled(ctx, sender.Bytes(), receiver, msg.ContractAddress)
 	if err != nil {
 		return nil, err
@@ -535,3 +547,29 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// ToggleConversion implements the gRPC MsgServer interface. When a
+// ToggleConversion proposal passes, it enables or disables the conversion of
+// the given token pair. The authority is hard-coded to the Cosmos SDK x/gov
+// module account.
+func (k *Keeper) ToggleConversion(goCtx context.Context, req *types.MsgToggleConversion) (*types.MsgToggleConversionResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pair, err := k.ToggleTokenConversion(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeToggleTokenConversion,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return &types.MsgToggleConversionResponse{}, nil
+}
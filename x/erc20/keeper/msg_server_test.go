@@ -9,6 +9,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/common"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
 	"github.com/evmos/evmos/v16/x/erc20/keeper"
 	"github.com/evmos/evmos/v16/x/erc20/types"
 	erc20mocks "github.com/evmos/evmos/v16/x/erc20/types/mocks"
@@ -209,6 +210,51 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeCoin() {
 	suite.mintFeeCollector = false
 }
 
+func (suite *KeeperTestSuite) TestConvertEmptyReceiver() {
+	suite.mintFeeCollector = true
+	defer func() { suite.mintFeeCollector = false }()
+	suite.SetupTest()
+
+	pair := suite.setupRegisterCoin(metadataCoin)
+	suite.Require().NotNil(pair)
+	erc20 := pair.GetERC20Contract()
+	suite.Commit()
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	coins := sdk.NewCoins(sdk.NewCoin(cosmosTokenBase, math.NewInt(100)))
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins)
+	suite.Require().NoError(err)
+
+	// convert coins without a receiver, which should credit the sender
+	msgCoin := &types.MsgConvertCoin{
+		Coin:   sdk.NewCoin(cosmosTokenBase, math.NewInt(10)),
+		Sender: sender.String(),
+	}
+	_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msgCoin)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	suite.Require().Equal(suite.address.Hex(), msgCoin.Receiver)
+	balance := suite.BalanceOf(erc20, suite.address)
+	suite.Require().Equal(int64(10), balance.(*big.Int).Int64())
+
+	// convert the tokens back without a receiver
+	msgERC20 := &types.MsgConvertERC20{
+		ContractAddress: erc20.Hex(),
+		Amount:          math.NewInt(4),
+		Sender:          suite.address.Hex(),
+	}
+	_, err = suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msgERC20)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	suite.Require().Equal(sender.String(), msgERC20.Receiver)
+	cosmosBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, metadataCoin.Base)
+	suite.Require().Equal(int64(94), cosmosBalance.Amount.Int64())
+}
+
 func (suite *KeeperTestSuite) TestConvertERC20NativeCoin() {
 	testCases := []struct {
 		name      string
@@ -1447,3 +1493,79 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestToggleConversionMsg() {
+	var (
+		pair  types.TokenPair
+		pair2 types.TokenPair
+	)
+
+	testCases := []struct {
+		name      string
+		request   func() *types.MsgToggleConversion
+		expectErr bool
+	}{
+		{
+			name: "fail - invalid authority",
+			request: func() *types.MsgToggleConversion {
+				return &types.MsgToggleConversion{Authority: "foobar", Token: pair.Denom}
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - token pair not registered",
+			request: func() *types.MsgToggleConversion {
+				return &types.MsgToggleConversion{
+					Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					Token:     "unregistered",
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "pass - disable conversion",
+			request: func() *types.MsgToggleConversion {
+				return &types.MsgToggleConversion{
+					Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					Token:     pair.Denom,
+				}
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			pair = types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+			pair2 = types.NewTokenPair(utiltx.GenerateAddress(), "coin2", types.OWNER_MODULE)
+			for _, p := range []types.TokenPair{pair, pair2} {
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, p)
+				suite.app.Erc20Keeper.SetDenomMap(suite.ctx, p.Denom, p.GetID())
+				suite.app.Erc20Keeper.SetERC20Map(suite.ctx, p.GetERC20Contract(), p.GetID())
+			}
+
+			_, err := suite.app.Erc20Keeper.ToggleConversion(suite.ctx, tc.request())
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, pair.Denom)
+			toggled, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+			suite.Require().True(found)
+			suite.Require().False(toggled.Enabled)
+
+			// conversion of the disabled pair is paused while the other pair is unaffected
+			sender := sdk.AccAddress(suite.address.Bytes())
+			_, err = suite.app.Erc20Keeper.MintingEnabled(suite.ctx, sender, sender, pair.Denom)
+			suite.Require().ErrorIs(err, types.ErrERC20TokenPairDisabled)
+			suite.Require().ErrorContains(err, "conversion paused")
+
+			_, err = suite.app.Erc20Keeper.MintingEnabled(suite.ctx, sender, sender, pair2.Denom)
+			suite.Require().NotErrorIs(err, types.ErrERC20TokenPairDisabled)
+		})
+	}
+}
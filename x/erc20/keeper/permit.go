@@ -0,0 +1,30 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v16/x/erc20/types"
+)
+
+// GetPermitNonce returns the current EIP-2612 permit nonce of the owner for the
+// given ERC-20 precompile contract.
+func (k Keeper) GetPermitNonce(ctx sdk.Context, contract, owner common.Address) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	bz := store.Get(append(contract.Bytes(), owner.Bytes()...))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetPermitNonce stores the EIP-2612 permit nonce of the owner for the given
+// ERC-20 precompile contract.
+func (k Keeper) SetPermitNonce(ctx sdk.Context, contract, owner common.Address, nonce uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	store.Set(append(contract.Bytes(), owner.Bytes()...), sdk.Uint64ToBigEndian(nonce))
+}
@@ -0,0 +1,21 @@
+package keeper_test
+
+import (
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+)
+
+func (suite *KeeperTestSuite) TestPermitNonce() {
+	suite.SetupTest()
+
+	contract := utiltx.GenerateAddress()
+	owner := utiltx.GenerateAddress()
+
+	suite.Require().Zero(suite.app.Erc20Keeper.GetPermitNonce(suite.ctx, contract, owner))
+
+	suite.app.Erc20Keeper.SetPermitNonce(suite.ctx, contract, owner, 3)
+	suite.Require().Equal(uint64(3), suite.app.Erc20Keeper.GetPermitNonce(suite.ctx, contract, owner))
+
+	// nonces are tracked per token contract and owner
+	suite.Require().Zero(suite.app.Erc20Keeper.GetPermitNonce(suite.ctx, utiltx.GenerateAddress(), owner))
+	suite.Require().Zero(suite.app.Erc20Keeper.GetPermitNonce(suite.ctx, contract, utiltx.GenerateAddress()))
+}
@@ -30,9 +30,9 @@ func (k Keeper) RegisterERC20Extensions(ctx sdk.Context) error {
 		var precompile vm.PrecompiledContract
 
 		if tokenPair.Denom == evmDenom {
-			precompile, err = werc20.NewPrecompile(tokenPair, k.bankKeeper, k.authzKeeper, *k.transferKeeper)
+			precompile, err = werc20.NewPrecompile(tokenPair, k.bankKeeper, k.authzKeeper, *k.transferKeeper, k)
 		} else {
-			precompile, err = erc20.NewPrecompile(tokenPair, k.bankKeeper, k.authzKeeper, *k.transferKeeper)
+			precompile, err = erc20.NewPrecompile(tokenPair, k.bankKeeper, k.authzKeeper, *k.transferKeeper, k)
 		}
 
 		if err != nil {
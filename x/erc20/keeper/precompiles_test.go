@@ -87,7 +87,7 @@ func (suite *KeeperTestSuite) TestRegisterERC20Extensions() {
 				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, tokenPair)
 				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, otherTokenPair)
 
-				tokenPrecompile, err := erc20precompile.NewPrecompile(tokenPair, suite.app.BankKeeper, suite.app.AuthzKeeper, suite.app.TransferKeeper)
+				tokenPrecompile, err := erc20precompile.NewPrecompile(tokenPair, suite.app.BankKeeper, suite.app.AuthzKeeper, suite.app.TransferKeeper, suite.app.Erc20Keeper)
 				suite.Require().NoError(err, "expected no error creating precompile")
 
 				err = suite.app.EvmKeeper.AddEVMExtensions(suite.ctx, tokenPrecompile)
@@ -153,8 +153,8 @@ func (k Keeper) CreateCoinMetadata(
 	return &metadata, nil
 }
 
-// ToggleConversion toggles conversion for a given token pair
-func (k Keeper) ToggleConversion(
+// ToggleTokenConversion toggles conversion for a given token pair
+func (k Keeper) ToggleTokenConversion(
 	ctx sdk.Context,
 	token string,
 ) (types.TokenPair, error) {
@@ -246,7 +246,7 @@ func (suite KeeperTestSuite) TestToggleConverision() { //nolint:govet // we can
 				contractAddr = suite.setupRegisterERC20Pair(contractMinterBurner)
 				id = suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, contractAddr.String())
 				pair, _ = suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
-				pair, _ = suite.app.Erc20Keeper.ToggleConversion(suite.ctx, contractAddr.String())
+				pair, _ = suite.app.Erc20Keeper.ToggleTokenConversion(suite.ctx, contractAddr.String())
 			},
 			true,
 			true,
@@ -259,7 +259,7 @@ func (suite KeeperTestSuite) TestToggleConverision() { //nolint:govet // we can
 			tc.malleate()
 
 			var err error
-			pair, err = suite.app.Erc20Keeper.ToggleConversion(suite.ctx, contractAddr.String())
+			pair, err = suite.app.Erc20Keeper.ToggleTokenConversion(suite.ctx, contractAddr.String())
 			// Request the pair using the GetPairToken func to make sure that is updated on the db
 			pair, _ = suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
 			if tc.expPass {
@@ -67,7 +67,7 @@ func handleToggleConversionProposal(
 	k *keeper.Keeper,
 	p *types.ToggleTokenConversionProposal,
 ) error {
-	pair, err := k.ToggleConversion(ctx, p.Token)
+	pair, err := k.ToggleTokenConversion(ctx, p.Token)
 	if err != nil {
 		return err
 	}
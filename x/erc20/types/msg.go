@@ -11,12 +11,14 @@ import (
 
 	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	"github.com/ethereum/go-ethereum/common"
+	evmostypes "github.com/evmos/evmos/v16/types"
 )
 
 var (
 	_ sdk.Msg = &MsgConvertCoin{}
 	_ sdk.Msg = &MsgConvertERC20{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgToggleConversion{}
 )
 
 const (
@@ -54,7 +56,8 @@ func (msg MsgConvertCoin) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrap(err, "invalid sender address")
 	}
-	if !common.IsHexAddress(msg.Receiver) {
+	// NOTE: an empty receiver defaults to the sender address
+	if msg.Receiver != "" && !common.IsHexAddress(msg.Receiver) {
 		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid receiver hex address %s", msg.Receiver)
 	}
 	return nil
@@ -95,9 +98,11 @@ func (msg MsgConvertERC20) ValidateBasic() error {
 	if !msg.Amount.IsPositive() {
 		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "cannot mint a non-positive amount")
 	}
-	_, err := sdk.AccAddressFromBech32(msg.Receiver)
-	if err != nil {
-		return errorsmod.Wrap(err, "invalid receiver address")
+	// NOTE: an empty receiver defaults to the sender address
+	if msg.Receiver != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+			return errorsmod.Wrap(err, "invalid receiver address")
+		}
 	}
 	if !common.IsHexAddress(msg.Sender) {
 		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid sender hex address %s", msg.Sender)
@@ -135,3 +140,32 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgToggleConversion message.
+func (m *MsgToggleConversion) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgToggleConversion) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if err := evmostypes.ValidateAddress(m.Token); err != nil {
+		if err := sdk.ValidateDenom(m.Token); err != nil {
+			return errorsmod.Wrapf(
+				errortypes.ErrInvalidRequest,
+				"invalid format for token %s, should be either hex ('0x...') cosmos denom", m.Token,
+			)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgToggleConversion) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -115,6 +115,13 @@ func (suite *MsgsTestSuite) TestMsgConvertCoin() {
 			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
 			true,
 		},
+		{
+			"msg convert coin - pass with empty receiver",
+			sdk.NewCoin("coin", math.NewInt(100)),
+			"",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			true,
+		},
 		{
 			"msg convert coin - pass with `erc20/` denom",
 			sdk.NewCoin("erc20/0xdac17f958d2ee523a2206206994597c13d831ec7", math.NewInt(100)),
@@ -216,7 +223,7 @@ func (suite *MsgsTestSuite) TestMsgConvertERC20() {
 		{
 			"invalid receiver address",
 			math.NewInt(100),
-			sdk.AccAddress{}.String(),
+			"evmosinvalid",
 			utiltx.GenerateAddress().String(),
 			utiltx.GenerateAddress().String(),
 			false,
@@ -237,6 +244,14 @@ func (suite *MsgsTestSuite) TestMsgConvertERC20() {
 			utiltx.GenerateAddress().String(),
 			true,
 		},
+		{
+			"msg convert erc20 - pass with empty receiver",
+			math.NewInt(100),
+			"",
+			utiltx.GenerateAddress().String(),
+			utiltx.GenerateAddress().String(),
+			true,
+		},
 	}
 
 	for i, tc := range testCases {
@@ -286,3 +301,55 @@ func (suite *MsgsTestSuite) TestMsgUpdateValidateBasic() {
 		})
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgToggleConversionValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgToggleConversion
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgToggleConversion{
+				Authority: "invalid",
+				Token:     "coin",
+			},
+			false,
+		},
+		{
+			"fail - invalid token",
+			&types.MsgToggleConversion{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Token:     "0x",
+			},
+			false,
+		},
+		{
+			"pass - valid msg with denom",
+			&types.MsgToggleConversion{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Token:     "coin",
+			},
+			true,
+		},
+		{
+			"pass - valid msg with contract address",
+			&types.MsgToggleConversion{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Token:     utiltx.GenerateAddress().String(),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
@@ -227,6 +227,109 @@ func (m *QueryTokenPairResponse) GetTokenPair() TokenPair {
 	return TokenPair{}
 }
 
+// QueryDisabledTokenPairsRequest is the request type for the
+// Query/DisabledTokenPairs RPC method.
+type QueryDisabledTokenPairsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDisabledTokenPairsRequest) Reset()         { *m = QueryDisabledTokenPairsRequest{} }
+func (m *QueryDisabledTokenPairsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDisabledTokenPairsRequest) ProtoMessage()    {}
+func (*QueryDisabledTokenPairsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{4}
+}
+func (m *QueryDisabledTokenPairsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDisabledTokenPairsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDisabledTokenPairsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDisabledTokenPairsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisabledTokenPairsRequest.Merge(m, src)
+}
+func (m *QueryDisabledTokenPairsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDisabledTokenPairsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisabledTokenPairsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDisabledTokenPairsRequest proto.InternalMessageInfo
+
+func (m *QueryDisabledTokenPairsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDisabledTokenPairsResponse is the response type for the
+// Query/DisabledTokenPairs RPC method.
+type QueryDisabledTokenPairsResponse struct {
+	// token_pairs is a slice of registered token pairs with conversion disabled
+	TokenPairs []TokenPair `protobuf:"bytes,1,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDisabledTokenPairsResponse) Reset()         { *m = QueryDisabledTokenPairsResponse{} }
+func (m *QueryDisabledTokenPairsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDisabledTokenPairsResponse) ProtoMessage()    {}
+func (*QueryDisabledTokenPairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{5}
+}
+func (m *QueryDisabledTokenPairsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDisabledTokenPairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDisabledTokenPairsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDisabledTokenPairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisabledTokenPairsResponse.Merge(m, src)
+}
+func (m *QueryDisabledTokenPairsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDisabledTokenPairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisabledTokenPairsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDisabledTokenPairsResponse proto.InternalMessageInfo
+
+func (m *QueryDisabledTokenPairsResponse) GetTokenPairs() []TokenPair {
+	if m != nil {
+		return m.TokenPairs
+	}
+	return nil
+}
+
+func (m *QueryDisabledTokenPairsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -235,7 +338,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fba814bce17cabdf, []int{4}
+	return fileDescriptor_fba814bce17cabdf, []int{6}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +378,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fba814bce17cabdf, []int{5}
+	return fileDescriptor_fba814bce17cabdf, []int{7}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -316,6 +419,8 @@ func init() {
 	proto.RegisterType((*QueryTokenPairsResponse)(nil), "evmos.erc20.v1.QueryTokenPairsResponse")
 	proto.RegisterType((*QueryTokenPairRequest)(nil), "evmos.erc20.v1.QueryTokenPairRequest")
 	proto.RegisterType((*QueryTokenPairResponse)(nil), "evmos.erc20.v1.QueryTokenPairResponse")
+	proto.RegisterType((*QueryDisabledTokenPairsRequest)(nil), "evmos.erc20.v1.QueryDisabledTokenPairsRequest")
+	proto.RegisterType((*QueryDisabledTokenPairsResponse)(nil), "evmos.erc20.v1.QueryDisabledTokenPairsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.erc20.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.erc20.v1.QueryParamsResponse")
 }
@@ -323,39 +428,43 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/query.proto", fileDescriptor_fba814bce17cabdf) }
 
 var fileDescriptor_fba814bce17cabdf = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0xef, 0xec, 0xba, 0x85, 0xbe, 0x82, 0x87, 0xb1, 0xd6, 0x1a, 0x35, 0x2e, 0x29, 0xdb, 0x2d,
-	0x8a, 0x33, 0xa6, 0x8a, 0x47, 0x91, 0x1e, 0xf4, 0xe0, 0xa5, 0x16, 0x0f, 0xe2, 0x45, 0xa7, 0x65,
-	0x88, 0x41, 0x9b, 0x49, 0x33, 0xd3, 0xe0, 0x22, 0x5e, 0xf6, 0x22, 0xde, 0x04, 0xbf, 0x82, 0x1f,
-	0x66, 0x8f, 0x0b, 0x5e, 0x3c, 0x89, 0xb4, 0x7e, 0x10, 0xc9, 0xcc, 0x24, 0xdd, 0xc4, 0xd2, 0x5e,
-	0x4a, 0xf2, 0xde, 0xfb, 0xfd, 0x7b, 0x2f, 0x05, 0x87, 0xa7, 0x33, 0x21, 0x29, 0x4f, 0xa6, 0x83,
-	0xfb, 0x34, 0xf5, 0xe9, 0x7c, 0xc1, 0x93, 0x13, 0x12, 0x27, 0x42, 0x09, 0x7c, 0x59, 0xf7, 0x88,
-	0xee, 0x91, 0xd4, 0x77, 0xee, 0x4c, 0x85, 0xcc, 0x86, 0x27, 0x4c, 0x72, 0x33, 0x48, 0x53, 0x7f,
-	0xc2, 0x15, 0xf3, 0x69, 0xcc, 0x82, 0x30, 0x62, 0x2a, 0x14, 0x91, 0xc1, 0x3a, 0x55, 0x5e, 0x43,
-	0x62, 0x7a, 0x37, 0x2b, 0xbd, 0x80, 0x47, 0x5c, 0x86, 0xd2, 0x76, 0x5b, 0x81, 0x08, 0x84, 0x7e,
-	0xa4, 0xd9, 0x53, 0x8e, 0x09, 0x84, 0x08, 0x3e, 0x70, 0xca, 0xe2, 0x90, 0xb2, 0x28, 0x12, 0x4a,
-	0x8b, 0x59, 0x8c, 0xf7, 0x16, 0xda, 0x2f, 0x32, 0x3f, 0x2f, 0xc5, 0x7b, 0x1e, 0x8d, 0x58, 0x98,
-	0xc8, 0x31, 0x9f, 0x2f, 0xb8, 0x54, 0xf8, 0x29, 0xc0, 0xda, 0x5b, 0x07, 0x1d, 0xa2, 0x7e, 0x73,
-	0xd0, 0x23, 0x26, 0x08, 0xc9, 0x82, 0x10, 0x93, 0xd8, 0x06, 0x21, 0x23, 0x16, 0x70, 0x8b, 0x1d,
-	0x5f, 0x40, 0x7a, 0x3f, 0x10, 0x5c, 0xfb, 0x4f, 0x42, 0xc6, 0x22, 0x92, 0x1c, 0x3f, 0x81, 0xa6,
-	0xca, 0xaa, 0x6f, 0xe2, 0xac, 0xdc, 0x41, 0x87, 0xfb, 0xfd, 0xe6, 0xe0, 0x3a, 0x29, 0x6f, 0x8f,
-	0x14, 0xc0, 0xe1, 0xa5, 0xb3, 0xdf, 0xb7, 0x6b, 0x63, 0x50, 0x05, 0x13, 0x7e, 0x56, 0x72, 0xb9,
-	0xa7, 0x5d, 0x1e, 0xef, 0x74, 0x69, 0xe4, 0x4b, 0x36, 0xef, 0xc1, 0xd5, 0xb2, 0xcb, 0x7c, 0x0f,
-	0x2d, 0x38, 0xd0, 0x7a, 0x7a, 0x05, 0x8d, 0xb1, 0x79, 0xf1, 0x5e, 0x55, 0xf7, 0x56, 0x64, 0x7a,
-	0x0c, 0xb0, 0xce, 0x64, 0xf7, 0xb6, 0x33, 0x52, 0xa3, 0x88, 0xe4, 0xb5, 0x00, 0x6b, 0xe6, 0x11,
-	0x4b, 0xd8, 0x2c, 0xbf, 0x86, 0xf7, 0x1c, 0xae, 0x94, 0xaa, 0x56, 0xec, 0x21, 0xd4, 0x63, 0x5d,
-	0xb1, 0x42, 0xed, 0xaa, 0x90, 0x99, 0xb7, 0x2a, 0x76, 0x76, 0xf0, 0x75, 0x1f, 0x0e, 0x34, 0x1b,
-	0x3e, 0x45, 0x00, 0xeb, 0xbb, 0xe0, 0x5e, 0x15, 0xbe, 0xf9, 0xdb, 0x70, 0x8e, 0x77, 0xce, 0x19,
-	0x7f, 0x5e, 0xf7, 0xf4, 0xe7, 0xdf, 0xef, 0x7b, 0xb7, 0xf0, 0x0d, 0x5a, 0xf9, 0x72, 0x2f, 0x9c,
-	0x1d, 0x7f, 0x41, 0xd0, 0x28, 0xb0, 0xf8, 0x68, 0x3b, 0x77, 0x6e, 0xa1, 0xb7, 0x6b, 0xcc, 0x3a,
-	0xb8, 0xab, 0x1d, 0x1c, 0xe1, 0xee, 0x16, 0x07, 0xf4, 0x93, 0x7e, 0xf9, 0x8c, 0xe7, 0x50, 0x37,
-	0x0b, 0xc3, 0xde, 0x46, 0xfa, 0xd2, 0x4d, 0x9c, 0xee, 0xd6, 0x19, 0xab, 0xef, 0x6a, 0xfd, 0x0e,
-	0x6e, 0x57, 0xf5, 0xcd, 0x2d, 0x86, 0xc3, 0xb3, 0xa5, 0x8b, 0xce, 0x97, 0x2e, 0xfa, 0xb3, 0x74,
-	0xd1, 0xb7, 0x95, 0x5b, 0x3b, 0x5f, 0xb9, 0xb5, 0x5f, 0x2b, 0xb7, 0xf6, 0xba, 0x1f, 0x84, 0xea,
-	0xdd, 0x62, 0x42, 0xa6, 0x62, 0x96, 0x63, 0xf5, 0x6f, 0xea, 0x3f, 0xa2, 0x1f, 0x2d, 0x8f, 0x3a,
-	0x89, 0xb9, 0x9c, 0xd4, 0xf5, 0x7f, 0xf9, 0xc1, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xf7,
-	0x7c, 0xb6, 0x93, 0x04, 0x00, 0x00,
+	// 563 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xcf, 0x6f, 0x12, 0x41,
+	0x14, 0xc7, 0x99, 0xda, 0x92, 0xf0, 0x48, 0x3c, 0x8c, 0x88, 0xb8, 0xea, 0xb6, 0x59, 0x2c, 0x25,
+	0xfe, 0x98, 0x11, 0x34, 0x1e, 0x8d, 0x21, 0x46, 0x0f, 0x5e, 0x90, 0x78, 0x30, 0x5e, 0xea, 0x40,
+	0x27, 0xdb, 0x8d, 0x65, 0x67, 0x61, 0x16, 0x62, 0x63, 0xbc, 0xf4, 0xe2, 0xd5, 0xc4, 0x3f, 0x41,
+	0x8f, 0x26, 0xfe, 0x1b, 0x3d, 0x36, 0xf1, 0xe2, 0xc9, 0x18, 0xf0, 0x0f, 0x31, 0x3b, 0x33, 0xbb,
+	0xb0, 0x5b, 0x0a, 0x5e, 0x4c, 0x7a, 0x21, 0xbb, 0xf3, 0xde, 0xf7, 0xbd, 0xcf, 0xfb, 0xce, 0x5b,
+	0xc0, 0xe2, 0xe3, 0xbe, 0x90, 0x94, 0x0f, 0x7b, 0xcd, 0x7b, 0x74, 0xdc, 0xa0, 0x83, 0x11, 0x1f,
+	0x1e, 0x92, 0x60, 0x28, 0x42, 0x81, 0x2f, 0xaa, 0x18, 0x51, 0x31, 0x32, 0x6e, 0x58, 0xb7, 0x7a,
+	0x42, 0x46, 0xc9, 0x5d, 0x26, 0xb9, 0x4e, 0xa4, 0xe3, 0x46, 0x97, 0x87, 0xac, 0x41, 0x03, 0xe6,
+	0x7a, 0x3e, 0x0b, 0x3d, 0xe1, 0x6b, 0xad, 0x95, 0xad, 0xab, 0x8b, 0xe8, 0xd8, 0xf5, 0x4c, 0xcc,
+	0xe5, 0x3e, 0x97, 0x9e, 0x34, 0xd1, 0x92, 0x2b, 0x5c, 0xa1, 0x1e, 0x69, 0xf4, 0x14, 0x6b, 0x5c,
+	0x21, 0xdc, 0x03, 0x4e, 0x59, 0xe0, 0x51, 0xe6, 0xfb, 0x22, 0x54, 0xcd, 0x8c, 0xc6, 0x79, 0x03,
+	0xe5, 0x17, 0x11, 0xcf, 0x4b, 0xf1, 0x96, 0xfb, 0x6d, 0xe6, 0x0d, 0x65, 0x87, 0x0f, 0x46, 0x5c,
+	0x86, 0xf8, 0x29, 0xc0, 0x8c, 0xad, 0x82, 0xb6, 0x50, 0xbd, 0xd8, 0xac, 0x11, 0x3d, 0x08, 0x89,
+	0x06, 0x21, 0x7a, 0x62, 0x33, 0x08, 0x69, 0x33, 0x97, 0x1b, 0x6d, 0x67, 0x4e, 0xe9, 0x7c, 0x45,
+	0x70, 0xe5, 0x54, 0x0b, 0x19, 0x08, 0x5f, 0x72, 0xfc, 0x18, 0x8a, 0x61, 0x74, 0xba, 0x1b, 0x44,
+	0xc7, 0x15, 0xb4, 0x75, 0xa1, 0x5e, 0x6c, 0x5e, 0x25, 0x69, 0xf7, 0x48, 0x22, 0x6c, 0xad, 0x1f,
+	0xff, 0xda, 0xcc, 0x75, 0x20, 0x4c, 0x2a, 0xe1, 0x67, 0x29, 0xca, 0x35, 0x45, 0xb9, 0xb3, 0x92,
+	0x52, 0xb7, 0x4f, 0x61, 0xde, 0x85, 0xcb, 0x69, 0xca, 0xd8, 0x87, 0x12, 0x6c, 0xa8, 0x7e, 0xca,
+	0x82, 0x42, 0x47, 0xbf, 0x38, 0xaf, 0xb2, 0xbe, 0x25, 0x33, 0x3d, 0x02, 0x98, 0xcd, 0x64, 0x7c,
+	0x5b, 0x39, 0x52, 0x21, 0x19, 0xc9, 0xd9, 0x07, 0x5b, 0x55, 0x7e, 0xe2, 0x49, 0xd6, 0x3d, 0xe0,
+	0x7b, 0xff, 0xef, 0x66, 0xbe, 0x21, 0xd8, 0x3c, 0xb3, 0xd5, 0xf9, 0xbb, 0xa1, 0x12, 0x60, 0x45,
+	0xdb, 0x66, 0x43, 0xd6, 0x8f, 0xcd, 0x70, 0x9e, 0xc3, 0xa5, 0xd4, 0xa9, 0xe1, 0x7e, 0x00, 0xf9,
+	0x40, 0x9d, 0x18, 0x7f, 0xca, 0x59, 0x64, 0x9d, 0x6f, 0x78, 0x4d, 0x6e, 0xf3, 0xfb, 0x3a, 0x6c,
+	0xa8, 0x6a, 0xf8, 0x08, 0x01, 0xcc, 0xec, 0xc0, 0xb5, 0xac, 0x7c, 0xf1, 0x47, 0x63, 0xed, 0xac,
+	0xcc, 0xd3, 0x7c, 0x4e, 0xf5, 0xe8, 0xc7, 0x9f, 0xcf, 0x6b, 0x37, 0xf0, 0x35, 0x9a, 0xf9, 0xa4,
+	0xe7, 0xdc, 0xc6, 0x1f, 0x11, 0x14, 0x12, 0x2d, 0xde, 0x5e, 0x5e, 0x3b, 0x46, 0xa8, 0xad, 0x4a,
+	0x33, 0x04, 0xb7, 0x15, 0xc1, 0x36, 0xae, 0x2e, 0x21, 0xa0, 0xef, 0xd5, 0xcb, 0x07, 0xfc, 0x05,
+	0x01, 0x3e, 0xbd, 0x25, 0x98, 0x2c, 0xec, 0x75, 0xe6, 0xe6, 0x5a, 0xf4, 0x9f, 0xf3, 0x0d, 0xe4,
+	0x1d, 0x05, 0x59, 0xc3, 0x37, 0xb3, 0x90, 0x7b, 0x46, 0xb3, 0x3b, 0xef, 0xd7, 0x00, 0xf2, 0xfa,
+	0x5a, 0xb1, 0xb3, 0xb0, 0x51, 0x6a, 0x73, 0xac, 0xea, 0xd2, 0x1c, 0x03, 0x60, 0x2b, 0x80, 0x0a,
+	0x2e, 0x67, 0x01, 0xf4, 0xc6, 0xb4, 0x5a, 0xc7, 0x13, 0x1b, 0x9d, 0x4c, 0x6c, 0xf4, 0x7b, 0x62,
+	0xa3, 0x4f, 0x53, 0x3b, 0x77, 0x32, 0xb5, 0x73, 0x3f, 0xa7, 0x76, 0xee, 0x75, 0xdd, 0xf5, 0xc2,
+	0xfd, 0x51, 0x97, 0xf4, 0x44, 0x3f, 0xd6, 0xaa, 0xdf, 0x71, 0xe3, 0x21, 0x7d, 0x67, 0xea, 0x84,
+	0x87, 0x01, 0x97, 0xdd, 0xbc, 0xfa, 0x2b, 0xbe, 0xff, 0x77, 0x00, 0x10, 0xd7, 0x15, 0x30, 0x52,
+	0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -374,6 +483,9 @@ type QueryClient interface {
 	TokenPairs(ctx context.Context, in *QueryTokenPairsRequest, opts ...grpc.CallOption) (*QueryTokenPairsResponse, error)
 	// TokenPair retrieves a registered token pair
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
+	// DisabledTokenPairs retrieves registered token pairs whose conversion is
+	// disabled
+	DisabledTokenPairs(ctx context.Context, in *QueryDisabledTokenPairsRequest, opts ...grpc.CallOption) (*QueryDisabledTokenPairsResponse, error)
 	// Params retrieves the erc20 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -404,6 +516,15 @@ func (c *queryClient) TokenPair(ctx context.Context, in *QueryTokenPairRequest,
 	return out, nil
 }
 
+func (c *queryClient) DisabledTokenPairs(ctx context.Context, in *QueryDisabledTokenPairsRequest, opts ...grpc.CallOption) (*QueryDisabledTokenPairsResponse, error) {
+	out := new(QueryDisabledTokenPairsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/DisabledTokenPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/Params", in, out, opts...)
@@ -419,6 +540,9 @@ type QueryServer interface {
 	TokenPairs(context.Context, *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error)
 	// TokenPair retrieves a registered token pair
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
+	// DisabledTokenPairs retrieves registered token pairs whose conversion is
+	// disabled
+	DisabledTokenPairs(context.Context, *QueryDisabledTokenPairsRequest) (*QueryDisabledTokenPairsResponse, error)
 	// Params retrieves the erc20 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -433,6 +557,9 @@ func (*UnimplementedQueryServer) TokenPairs(ctx context.Context, req *QueryToken
 func (*UnimplementedQueryServer) TokenPair(ctx context.Context, req *QueryTokenPairRequest) (*QueryTokenPairResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TokenPair not implemented")
 }
+func (*UnimplementedQueryServer) DisabledTokenPairs(ctx context.Context, req *QueryDisabledTokenPairsRequest) (*QueryDisabledTokenPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisabledTokenPairs not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -477,6 +604,24 @@ func _Query_TokenPair_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DisabledTokenPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDisabledTokenPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DisabledTokenPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Query/DisabledTokenPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DisabledTokenPairs(ctx, req.(*QueryDisabledTokenPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -507,6 +652,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TokenPair",
 			Handler:    _Query_TokenPair_Handler,
 		},
+		{
+			MethodName: "DisabledTokenPairs",
+			Handler:    _Query_DisabledTokenPairs_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -663,6 +812,90 @@ func (m *QueryTokenPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDisabledTokenPairsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisabledTokenPairsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisabledTokenPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisabledTokenPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisabledTokenPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisabledTokenPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenPairs) > 0 {
+		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -786,6 +1019,38 @@ func (m *QueryTokenPairResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDisabledTokenPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDisabledTokenPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenPairs) > 0 {
+		for _, e := range m.TokenPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1183,6 +1448,212 @@ func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDisabledTokenPairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisabledTokenPairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisabledTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisabledTokenPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisabledTokenPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisabledTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPairs = append(m.TokenPairs, TokenPair{})
+			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
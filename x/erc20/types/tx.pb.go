@@ -38,7 +38,8 @@ type MsgConvertCoin struct {
 	// coin is a Cosmos coin whose denomination is registered in a token pair. The coin
 	// amount defines the amount of coins to convert.
 	Coin types.Coin `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin"`
-	// receiver is the hex address to receive ERC20 token
+	// receiver is the hex address to receive ERC20 token. If empty, the tokens
+	// are sent to the hex address of the sender.
 	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	// sender is the cosmos bech32 address from the owner of the given Cosmos coins
 	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -142,7 +143,8 @@ type MsgConvertERC20 struct {
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	// amount of ERC20 tokens to convert
 	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
-	// receiver is the bech32 address to receive native Cosmos coins
+	// receiver is the bech32 address to receive native Cosmos coins. If empty,
+	// the coins are sent to the bech32 address of the sender.
 	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	// sender is the hex address from the owner of the given ERC20 tokens
 	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -335,6 +337,101 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgToggleConversion is the Msg/ToggleConversion request type for toggling
+// the conversion of a token pair.
+type MsgToggleConversion struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *MsgToggleConversion) Reset()         { *m = MsgToggleConversion{} }
+func (m *MsgToggleConversion) String() string { return proto.CompactTextString(m) }
+func (*MsgToggleConversion) ProtoMessage()    {}
+func (*MsgToggleConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{6}
+}
+func (m *MsgToggleConversion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgToggleConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgToggleConversion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgToggleConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgToggleConversion.Merge(m, src)
+}
+func (m *MsgToggleConversion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgToggleConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgToggleConversion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgToggleConversion proto.InternalMessageInfo
+
+func (m *MsgToggleConversion) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgToggleConversion) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// MsgToggleConversionResponse defines the response structure for executing a
+// MsgToggleConversion message.
+type MsgToggleConversionResponse struct {
+}
+
+func (m *MsgToggleConversionResponse) Reset()         { *m = MsgToggleConversionResponse{} }
+func (m *MsgToggleConversionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgToggleConversionResponse) ProtoMessage()    {}
+func (*MsgToggleConversionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{7}
+}
+func (m *MsgToggleConversionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgToggleConversionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgToggleConversionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgToggleConversionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgToggleConversionResponse.Merge(m, src)
+}
+func (m *MsgToggleConversionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgToggleConversionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgToggleConversionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgToggleConversionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgConvertCoin)(nil), "evmos.erc20.v1.MsgConvertCoin")
 	proto.RegisterType((*MsgConvertCoinResponse)(nil), "evmos.erc20.v1.MsgConvertCoinResponse")
@@ -342,48 +439,54 @@ func init() {
 	proto.RegisterType((*MsgConvertERC20Response)(nil), "evmos.erc20.v1.MsgConvertERC20Response")
 	proto.RegisterType((*MsgUpdateParams)(nil), "evmos.erc20.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "evmos.erc20.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgToggleConversion)(nil), "evmos.erc20.v1.MsgToggleConversion")
+	proto.RegisterType((*MsgToggleConversionResponse)(nil), "evmos.erc20.v1.MsgToggleConversionResponse")
 }
 
 func init() { proto.RegisterFile("evmos/erc20/v1/tx.proto", fileDescriptor_f8926fc6cb676914) }
 
 var fileDescriptor_f8926fc6cb676914 = []byte{
-	// 574 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xcd, 0x6e, 0x13, 0x3f,
-	0x14, 0xc5, 0x33, 0x6d, 0x14, 0xfd, 0xe3, 0x54, 0xe9, 0x5f, 0x56, 0xc9, 0x97, 0x60, 0x12, 0xb2,
-	0xa0, 0x01, 0x89, 0x71, 0x93, 0x42, 0x17, 0xec, 0x48, 0xc4, 0x82, 0x45, 0x25, 0x34, 0x08, 0x09,
-	0xb1, 0x89, 0x9c, 0x89, 0x35, 0x19, 0xc1, 0xd8, 0x23, 0xdb, 0x19, 0x35, 0x1b, 0x16, 0x79, 0x01,
-	0x90, 0x78, 0x08, 0xb6, 0x2c, 0x78, 0x88, 0x2e, 0x2b, 0xd8, 0x20, 0x16, 0x15, 0x4a, 0x2a, 0xf1,
-	0x1a, 0x68, 0x6c, 0xe7, 0x63, 0x42, 0x5a, 0x36, 0x51, 0xec, 0x73, 0x7c, 0xef, 0xef, 0xf8, 0x7a,
-	0x40, 0x99, 0xc4, 0x21, 0x13, 0x88, 0x70, 0xaf, 0x73, 0x84, 0xe2, 0x36, 0x92, 0x67, 0x4e, 0xc4,
-	0x99, 0x64, 0xb0, 0xa8, 0x04, 0x47, 0x09, 0x4e, 0xdc, 0xae, 0xd9, 0x1e, 0x13, 0x89, 0x73, 0x80,
-	0x05, 0x41, 0x71, 0x7b, 0x40, 0x24, 0x6e, 0x23, 0x8f, 0x05, 0x54, 0xfb, 0x6b, 0x65, 0xa3, 0x87,
-	0xc2, 0x4f, 0xea, 0x84, 0xc2, 0x37, 0x42, 0x55, 0x0b, 0x7d, 0xb5, 0x42, 0x7a, 0x61, 0xa4, 0xdb,
-	0x1b, 0xcd, 0x7d, 0x42, 0x89, 0x08, 0x16, 0xea, 0x81, 0xcf, 0x7c, 0xa6, 0x4f, 0x25, 0xff, 0x16,
-	0x67, 0x7c, 0xc6, 0xfc, 0x77, 0x04, 0xe1, 0x28, 0x40, 0x98, 0x52, 0x26, 0xb1, 0x0c, 0x18, 0x35,
-	0x67, 0x9a, 0x13, 0x50, 0x3c, 0x15, 0x7e, 0x8f, 0xd1, 0x98, 0x70, 0xd9, 0x63, 0x01, 0x85, 0xc7,
-	0x20, 0x9b, 0x50, 0x56, 0xac, 0x86, 0xd5, 0x2a, 0x74, 0xaa, 0x8e, 0x01, 0x48, 0x62, 0x38, 0x26,
-	0x86, 0x93, 0x18, 0xbb, 0xd9, 0xf3, 0xcb, 0x7a, 0xc6, 0x55, 0x66, 0x58, 0x03, 0xff, 0x71, 0xe2,
-	0x91, 0x20, 0x26, 0xbc, 0xb2, 0xd3, 0xb0, 0x5a, 0x79, 0x77, 0xb9, 0x86, 0x25, 0x90, 0x13, 0x84,
-	0x0e, 0x09, 0xaf, 0xec, 0x2a, 0xc5, 0xac, 0x9a, 0x15, 0x50, 0x4a, 0xb7, 0x76, 0x89, 0x88, 0x18,
-	0x15, 0xa4, 0xf9, 0xd9, 0x02, 0xfb, 0x2b, 0xe9, 0x99, 0xdb, 0xeb, 0x1c, 0xc1, 0xfb, 0xe0, 0x7f,
-	0x8f, 0x51, 0xc9, 0xb1, 0x27, 0xfb, 0x78, 0x38, 0xe4, 0x44, 0x08, 0x85, 0x98, 0x77, 0xf7, 0x17,
-	0xfb, 0x4f, 0xf5, 0x36, 0x7c, 0x0c, 0x72, 0x38, 0x64, 0x63, 0x2a, 0x35, 0x4a, 0xf7, 0x4e, 0x02,
-	0xfa, 0xf3, 0xb2, 0x7e, 0x4b, 0x47, 0x11, 0xc3, 0xb7, 0x4e, 0xc0, 0x50, 0x88, 0xe5, 0xc8, 0x79,
-	0x4e, 0xa5, 0x6b, 0xcc, 0xa9, 0x0c, 0xbb, 0xd7, 0x66, 0xc8, 0xa6, 0x32, 0x54, 0x41, 0x79, 0x03,
-	0x74, 0x19, 0xe2, 0x83, 0x0e, 0xf1, 0x2a, 0x1a, 0x62, 0x49, 0x5e, 0x60, 0x8e, 0x43, 0x01, 0x4f,
-	0x40, 0x1e, 0x8f, 0xe5, 0x88, 0xf1, 0x40, 0x4e, 0x34, 0x7d, 0xb7, 0xf2, 0xed, 0xeb, 0xc3, 0x03,
-	0x73, 0xc7, 0x26, 0xc0, 0x4b, 0xc9, 0x03, 0xea, 0xbb, 0x2b, 0x2b, 0x7c, 0x04, 0x72, 0x91, 0xaa,
-	0xa0, 0x12, 0x15, 0x3a, 0x25, 0x27, 0xfd, 0xd8, 0x1c, 0x5d, 0xdf, 0x8c, 0xc4, 0x78, 0x9f, 0x14,
-	0xa7, 0xbf, 0xbf, 0x3c, 0x58, 0x55, 0x31, 0xb0, 0xeb, 0x40, 0x0b, 0xd8, 0xce, 0xd5, 0x0e, 0xd8,
-	0x3d, 0x15, 0x3e, 0x7c, 0x0f, 0x0a, 0xeb, 0x6f, 0xc1, 0xde, 0xec, 0x93, 0x1e, 0x58, 0xed, 0xde,
-	0xcd, 0xfa, 0xf2, 0x2e, 0x0e, 0xa7, 0xdf, 0xaf, 0x3e, 0xed, 0xdc, 0x85, 0x75, 0xf4, 0xd7, 0xd7,
-	0x83, 0x3c, 0xed, 0xef, 0xab, 0x77, 0x34, 0xb5, 0xc0, 0x5e, 0x6a, 0xec, 0xf5, 0xeb, 0x3b, 0x28,
-	0x43, 0xed, 0xf0, 0x1f, 0x86, 0x25, 0x43, 0x4b, 0x31, 0x34, 0x61, 0xe3, 0x06, 0x06, 0xb5, 0x07,
-	0x5f, 0x83, 0xbd, 0xd4, 0xd4, 0xb6, 0x31, 0xac, 0x1b, 0xb6, 0x32, 0x6c, 0xbb, 0xe6, 0x6e, 0xf7,
-	0x7c, 0x66, 0x5b, 0x17, 0x33, 0xdb, 0xfa, 0x35, 0xb3, 0xad, 0x8f, 0x73, 0x3b, 0x73, 0x31, 0xb7,
-	0x33, 0x3f, 0xe6, 0x76, 0xe6, 0x4d, 0xcb, 0x0f, 0xe4, 0x68, 0x3c, 0x70, 0x3c, 0x16, 0x2e, 0xf8,
-	0xd4, 0x6f, 0xdc, 0x3e, 0x41, 0x67, 0x86, 0x55, 0x4e, 0x22, 0x22, 0x06, 0x39, 0xf5, 0xe1, 0x1e,
-	0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x27, 0xf3, 0xf5, 0xf1, 0x89, 0x04, 0x00, 0x00,
+	// 629 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x4f, 0x13, 0x4f,
+	0x18, 0xc6, 0xbb, 0x94, 0x7f, 0xf3, 0x67, 0x20, 0x40, 0x46, 0x84, 0xb2, 0xca, 0x16, 0x6b, 0x22,
+	0x55, 0xe3, 0x0e, 0x2d, 0xca, 0xc1, 0x9b, 0x25, 0x1e, 0x3c, 0x90, 0x98, 0x55, 0x13, 0xe3, 0x85,
+	0x4c, 0x77, 0x27, 0xc3, 0x06, 0x76, 0x66, 0xb3, 0x33, 0x6c, 0xe0, 0xe2, 0x81, 0x2f, 0xa0, 0x89,
+	0x1f, 0xc2, 0xab, 0x07, 0x3f, 0x04, 0xf1, 0x44, 0xf4, 0x62, 0x3c, 0x10, 0x43, 0x4d, 0xfc, 0x1a,
+	0x66, 0x67, 0x66, 0xdb, 0x6e, 0x5b, 0xc0, 0x78, 0x69, 0xfa, 0xce, 0xf3, 0xcc, 0xbc, 0xbf, 0x67,
+	0xe6, 0x6d, 0xc1, 0x12, 0x49, 0x23, 0x2e, 0x10, 0x49, 0xfc, 0xd6, 0x3a, 0x4a, 0x9b, 0x48, 0x1e,
+	0xba, 0x71, 0xc2, 0x25, 0x87, 0xb3, 0x4a, 0x70, 0x95, 0xe0, 0xa6, 0x4d, 0xdb, 0xf1, 0xb9, 0xc8,
+	0x9c, 0x1d, 0x2c, 0x08, 0x4a, 0x9b, 0x1d, 0x22, 0x71, 0x13, 0xf9, 0x3c, 0x64, 0xda, 0x6f, 0x2f,
+	0x19, 0x3d, 0x12, 0x34, 0x3b, 0x27, 0x12, 0xd4, 0x08, 0xcb, 0x5a, 0xd8, 0x51, 0x15, 0xd2, 0x85,
+	0x91, 0x6e, 0x0e, 0x35, 0xa7, 0x84, 0x11, 0x11, 0xe6, 0xea, 0x02, 0xe5, 0x94, 0xeb, 0x5d, 0xd9,
+	0xb7, 0x7c, 0x0f, 0xe5, 0x9c, 0xee, 0x13, 0x84, 0xe3, 0x10, 0x61, 0xc6, 0xb8, 0xc4, 0x32, 0xe4,
+	0xcc, 0xec, 0xa9, 0x1f, 0x81, 0xd9, 0x6d, 0x41, 0xb7, 0x38, 0x4b, 0x49, 0x22, 0xb7, 0x78, 0xc8,
+	0xe0, 0x06, 0x98, 0xcc, 0x28, 0xab, 0xd6, 0xaa, 0xd5, 0x98, 0x6e, 0x2d, 0xbb, 0x06, 0x20, 0x8b,
+	0xe1, 0x9a, 0x18, 0x6e, 0x66, 0x6c, 0x4f, 0x9e, 0x9c, 0xd5, 0x4a, 0x9e, 0x32, 0x43, 0x1b, 0xfc,
+	0x9f, 0x10, 0x9f, 0x84, 0x29, 0x49, 0xaa, 0x13, 0xab, 0x56, 0x63, 0xca, 0xeb, 0xd5, 0x70, 0x11,
+	0x54, 0x04, 0x61, 0x01, 0x49, 0xaa, 0x65, 0xa5, 0x98, 0xaa, 0x5e, 0x05, 0x8b, 0xc5, 0xd6, 0x1e,
+	0x11, 0x31, 0x67, 0x82, 0xd4, 0x3f, 0x5a, 0x60, 0xae, 0x2f, 0x3d, 0xf5, 0xb6, 0x5a, 0xeb, 0xf0,
+	0x2e, 0x98, 0xf7, 0x39, 0x93, 0x09, 0xf6, 0xe5, 0x0e, 0x0e, 0x82, 0x84, 0x08, 0xa1, 0x10, 0xa7,
+	0xbc, 0xb9, 0x7c, 0xfd, 0x89, 0x5e, 0x86, 0x8f, 0x40, 0x05, 0x47, 0xfc, 0x80, 0x49, 0x8d, 0xd2,
+	0x5e, 0xc9, 0x40, 0x7f, 0x9c, 0xd5, 0xae, 0xeb, 0x28, 0x22, 0xd8, 0x73, 0x43, 0x8e, 0x22, 0x2c,
+	0x77, 0xdd, 0x67, 0x4c, 0x7a, 0xc6, 0x5c, 0xc8, 0x50, 0xbe, 0x30, 0xc3, 0x64, 0x21, 0xc3, 0x32,
+	0x58, 0x1a, 0x02, 0xed, 0x85, 0x78, 0xa7, 0x43, 0xbc, 0x8a, 0x03, 0x2c, 0xc9, 0x73, 0x9c, 0xe0,
+	0x48, 0xc0, 0x4d, 0x30, 0x85, 0x0f, 0xe4, 0x2e, 0x4f, 0x42, 0x79, 0xa4, 0xe9, 0xdb, 0xd5, 0xaf,
+	0x9f, 0x1f, 0x2c, 0x98, 0x3b, 0x36, 0x01, 0x5e, 0xc8, 0x24, 0x64, 0xd4, 0xeb, 0x5b, 0xe1, 0x43,
+	0x50, 0x89, 0xd5, 0x09, 0x2a, 0xd1, 0x74, 0x6b, 0xd1, 0x2d, 0x0e, 0x9b, 0xab, 0xcf, 0x37, 0x4f,
+	0x62, 0xbc, 0x8f, 0x67, 0x8f, 0x7f, 0x7f, 0xba, 0xd7, 0x3f, 0xc5, 0xc0, 0x0e, 0x02, 0xf5, 0x60,
+	0x05, 0xb8, 0xb6, 0x2d, 0xe8, 0x4b, 0x4e, 0xe9, 0x3e, 0xd1, 0x69, 0x44, 0xc8, 0xd9, 0x3f, 0xf3,
+	0x2e, 0x80, 0xff, 0x24, 0xdf, 0x23, 0xcc, 0xcc, 0x82, 0x2e, 0x46, 0x78, 0x56, 0xc0, 0x8d, 0x31,
+	0x4d, 0x73, 0xa6, 0xd6, 0x97, 0x32, 0x28, 0x6f, 0x0b, 0x0a, 0xdf, 0x82, 0xe9, 0xc1, 0xf9, 0x74,
+	0x86, 0xb3, 0x17, 0x87, 0xc8, 0xbe, 0x73, 0xb9, 0xde, 0x8b, 0xbc, 0x76, 0xfc, 0xed, 0xd7, 0x87,
+	0x89, 0x5b, 0xb0, 0x86, 0x46, 0x7e, 0xd1, 0xc8, 0xd7, 0xfe, 0x1d, 0x35, 0xdb, 0xc7, 0x16, 0x98,
+	0x29, 0x8c, 0x62, 0xed, 0xe2, 0x0e, 0xca, 0x60, 0xaf, 0x5d, 0x61, 0xe8, 0x31, 0x34, 0x14, 0x43,
+	0x1d, 0xae, 0x5e, 0xc2, 0xa0, 0xd6, 0xe0, 0x6b, 0x30, 0x53, 0x98, 0xa4, 0x71, 0x0c, 0x83, 0x06,
+	0x7b, 0xed, 0x0a, 0x43, 0xce, 0x00, 0x03, 0x30, 0x3f, 0xf2, 0xee, 0xb7, 0xc7, 0x6c, 0x1e, 0x36,
+	0xd9, 0xf7, 0xff, 0xc2, 0x94, 0x77, 0x69, 0xb7, 0x4f, 0xce, 0x1d, 0xeb, 0xf4, 0xdc, 0xb1, 0x7e,
+	0x9e, 0x3b, 0xd6, 0xfb, 0xae, 0x53, 0x3a, 0xed, 0x3a, 0xa5, 0xef, 0x5d, 0xa7, 0xf4, 0xa6, 0x41,
+	0x43, 0xb9, 0x7b, 0xd0, 0x71, 0x7d, 0x1e, 0xe5, 0xb7, 0xa0, 0x3e, 0xd3, 0xe6, 0x26, 0x3a, 0x34,
+	0x37, 0x22, 0x8f, 0x62, 0x22, 0x3a, 0x15, 0xf5, 0x97, 0xb5, 0xf1, 0x67, 0x00, 0x79, 0xc9, 0x41,
+	0xc7, 0x83, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -407,6 +510,10 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/erc20 module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ToggleConversion defines a governance operation for enabling or disabling
+	// the conversion of a single token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	ToggleConversion(ctx context.Context, in *MsgToggleConversion, opts ...grpc.CallOption) (*MsgToggleConversionResponse, error)
 }
 
 type msgClient struct {
@@ -444,6 +551,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ToggleConversion(ctx context.Context, in *MsgToggleConversion, opts ...grpc.CallOption) (*MsgToggleConversionResponse, error) {
+	out := new(MsgToggleConversionResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/ToggleConversion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ConvertCoin mints a ERC20 representation of the native Cosmos coin denom
@@ -455,6 +571,10 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/erc20 module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ToggleConversion defines a governance operation for enabling or disabling
+	// the conversion of a single token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -470,6 +590,9 @@ func (*UnimplementedMsgServer) ConvertERC20(ctx context.Context, req *MsgConvert
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ToggleConversion(ctx context.Context, req *MsgToggleConversion) (*MsgToggleConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToggleConversion not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -529,6 +652,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ToggleConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgToggleConversion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ToggleConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/ToggleConversion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ToggleConversion(ctx, req.(*MsgToggleConversion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.erc20.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -545,6 +686,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ToggleConversion",
+			Handler:    _Msg_ToggleConversion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/erc20/v1/tx.proto",
@@ -760,6 +905,66 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgToggleConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgToggleConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgToggleConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgToggleConversionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgToggleConversionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgToggleConversionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -855,6 +1060,32 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgToggleConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgToggleConversionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1453,6 +1684,170 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgToggleConversion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgToggleConversion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgToggleConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgToggleConversionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgToggleConversionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgToggleConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
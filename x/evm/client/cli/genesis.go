@@ -0,0 +1,263 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	sdkmath "cosmossdk.io/math"
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+
+	evmostypes "github.com/evmos/evmos/v16/types"
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+const (
+	flagAddresses      = "addresses"
+	flagOutputDocument = "output-document"
+)
+
+// GethGenesisExporter is a function that writes the EVM state of the
+// application stored in the given database, at the given height or at the
+// latest one if it is -1, to the given writer as a geth genesis file. If
+// addresses are given, only these accounts are exported.
+type GethGenesisExporter func(
+	logger log.Logger,
+	db dbm.DB,
+	height int64,
+	appOpts servertypes.AppOptions,
+	w io.Writer,
+	addresses []common.Address,
+) error
+
+// GetGenesisCmd returns the genesis commands for the evm module.
+func GetGenesisCmd(gethGenesisExporter GethGenesisExporter, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Genesis subcommands for the evm module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		ImportGethGenesisCmd(defaultNodeHome),
+		ExportGethGenesisCmd(gethGenesisExporter, defaultNodeHome),
+	)
+	return cmd
+}
+
+// ImportGethGenesisCmd returns the import-genesis cobra Command.
+func ImportGethGenesisCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-genesis GETH_GENESIS_FILE",
+		Short: "Import the alloc section of a geth genesis file into genesis.json",
+		Long: `Import the accounts of the alloc section of a geth genesis file into genesis.json.
+Each account is added as an EthAccount with its nonce and code hash, its balance is added
+in the EVM denomination to the bank genesis state and its code and storage are added to
+the EVM genesis state. The imported accounts must not already exist in genesis.json.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read geth genesis file: %w", err)
+			}
+
+			alloc, err := types.ParseGethGenesisAlloc(bz)
+			if err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			var evmGenState types.GenesisState
+			if err := clientCtx.Codec.UnmarshalJSON(appState[types.ModuleName], &evmGenState); err != nil {
+				return fmt.Errorf("failed to unmarshal evm genesis state: %w", err)
+			}
+
+			authGenState := authtypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+			accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+			if err != nil {
+				return fmt.Errorf("failed to get accounts from any: %w", err)
+			}
+
+			bankGenState := banktypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+
+			// iterate over the sorted EVM genesis accounts to add the accounts in a
+			// deterministic order
+			evmAccounts := types.GenesisAccountsFromGethAlloc(alloc)
+			for _, evmAccount := range evmAccounts {
+				address := common.HexToAddress(evmAccount.Address)
+				account := alloc[address]
+
+				addr := sdk.AccAddress(address.Bytes())
+				if accs.Contains(addr) {
+					return fmt.Errorf("cannot import account at existing address %s", evmAccount.Address)
+				}
+
+				accs = append(accs, &evmostypes.EthAccount{
+					BaseAccount: authtypes.NewBaseAccount(addr, nil, 0, account.Nonce),
+					CodeHash:    crypto.Keccak256Hash(account.Code).Hex(),
+				})
+
+				if account.Balance == nil || account.Balance.Sign() == 0 {
+					continue
+				}
+
+				coins := sdk.NewCoins(sdk.NewCoin(evmGenState.Params.EvmDenom, sdkmath.NewIntFromBigInt(account.Balance)))
+				bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: addr.String(), Coins: coins})
+				bankGenState.Supply = bankGenState.Supply.Add(coins...)
+			}
+
+			accs = authtypes.SanitizeGenesisAccounts(accs)
+			genAccs, err := authtypes.PackAccounts(accs)
+			if err != nil {
+				return fmt.Errorf("failed to convert accounts into any's: %w", err)
+			}
+			authGenState.Accounts = genAccs
+
+			bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+
+			evmGenState.Accounts = append(evmGenState.Accounts, evmAccounts...)
+			if err := evmGenState.Validate(); err != nil {
+				return fmt.Errorf("invalid evm genesis state: %w", err)
+			}
+
+			authGenStateBz, err := clientCtx.Codec.MarshalJSON(&authGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+			}
+			appState[authtypes.ModuleName] = authGenStateBz
+
+			bankGenStateBz, err := clientCtx.Codec.MarshalJSON(bankGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+			}
+			appState[banktypes.ModuleName] = bankGenStateBz
+
+			evmGenStateBz, err := clientCtx.Codec.MarshalJSON(&evmGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal evm genesis state: %w", err)
+			}
+			appState[types.ModuleName] = evmGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+// ExportGethGenesisCmd returns the export-genesis cobra Command.
+func ExportGethGenesisCmd(gethGenesisExporter GethGenesisExporter, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-genesis",
+		Short: "Export the EVM state to a geth genesis file",
+		Long: `Export the EVM state of the node to a geth genesis file, with the chain config and the
+balance, nonce, code and storage of the accounts in the alloc section. The accounts are written
+as they are read from the state, so that the export does not need to hold the whole state in
+memory. The exported accounts can be limited with the --addresses flag.
+`,
+		Example: fmt.Sprintf(
+			"%s evm export-genesis --addresses 0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E --output-document geth.json",
+			version.AppName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			genDoc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			// use the chain id of the genesis file unless it is given as a flag
+			if serverCtx.Viper.GetString(flags.FlagChainID) == "" {
+				serverCtx.Viper.Set(flags.FlagChainID, genDoc.ChainID)
+			}
+
+			// the same account may be given more than once, e.g. in hex and bech32
+			accounts, _ := cmd.Flags().GetStringSlice(flagAddresses)
+			addresses, err := accountsToAddresses(accounts)
+			if err != nil {
+				return err
+			}
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			w := cmd.OutOrStdout()
+			outputDocument, _ := cmd.Flags().GetString(flagOutputDocument)
+			if outputDocument != "" {
+				f, err := os.Create(outputDocument)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				w = f
+			}
+
+			// log to stderr to keep the exported genesis apart from the logs
+			logger := log.NewTMLogger(log.NewSyncWriter(cmd.ErrOrStderr()))
+
+			bw := bufio.NewWriter(w)
+			height, _ := cmd.Flags().GetInt64(flags.FlagHeight)
+			if err := gethGenesisExporter(logger, db, height, serverCtx.Viper, bw, addresses); err != nil {
+				return fmt.Errorf("error exporting evm state: %w", err)
+			}
+			return bw.Flush()
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(flags.FlagHeight, -1, "Export the state at this height (-1 for the latest height)")
+	cmd.Flags().StringSlice(flagAddresses, nil, "Only export the given hex or bech32 addresses")
+	cmd.Flags().String(flagOutputDocument, "", "Exported geth genesis file (default stdout)")
+	return cmd
+}
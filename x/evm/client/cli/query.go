@@ -24,8 +24,10 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetStorageCmd(),
+		GetContractStorageCmd(),
 		GetCodeCmd(),
 		GetParamsCmd(),
+		GetBlockedAddressesCmd(),
 	)
 	return cmd
 }
@@ -70,6 +72,50 @@ func GetStorageCmd() *cobra.Command {
 	return cmd
 }
 
+// GetContractStorageCmd queries all the storage slots of an account
+func GetContractStorageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-storage ADDRESS",
+		Short: "Gets all the storage slots of an account",
+		Long:  "Gets all the storage slots of an account, with pagination. If the height is not provided, it will use the latest height from context.", //nolint:lll
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryContractStorageRequest{
+				Address:    address,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ContractStorage(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contract-storage")
+	return cmd
+}
+
 // GetCodeCmd queries the code field of a given address
 func GetCodeCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -134,3 +180,31 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetBlockedAddressesCmd queries the addresses blocked from ethereum transactions
+func GetBlockedAddressesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocked-addresses",
+		Short: "Get the blocked addresses",
+		Long:  "Get the hex addresses that are not allowed to send nor receive ethereum transactions.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlockedAddresses(cmd.Context(), &types.QueryBlockedAddressesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -38,6 +38,29 @@ func accountToHex(addr string) (string, error) {
 	return ethAddr.Hex(), nil
 }
 
+// accountsToAddresses converts the given hex or bech32 accounts to addresses,
+// dropping the repeated ones so that each account is only listed once.
+func accountsToAddresses(accounts []string) ([]common.Address, error) {
+	addresses := make([]common.Address, 0, len(accounts))
+	seen := make(map[common.Address]struct{}, len(accounts))
+	for _, account := range accounts {
+		hexAddr, err := accountToHex(account)
+		if err != nil {
+			return nil, err
+		}
+
+		address := common.HexToAddress(hexAddr)
+		if _, ok := seen[address]; ok {
+			continue
+		}
+
+		seen[address] = struct{}{}
+		addresses = append(addresses, address)
+	}
+
+	return addresses, nil
+}
+
 func formatKeyToHash(key string) string {
 	if !strings.HasPrefix(key, "0x") {
 		key = "0x" + key
@@ -57,6 +57,36 @@ func TestAddressFormats(t *testing.T) {
 	}
 }
 
+func TestAccountsToAddresses(t *testing.T) {
+	addr := common.HexToAddress("0x3B98c72760f7BBa69D62ED6f48278451251948e7")
+	otherAddr := common.HexToAddress("0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E")
+
+	testCases := []struct {
+		name         string
+		accounts     []string
+		expAddresses []common.Address
+		expectErr    bool
+	}{
+		{"no accounts", nil, []common.Address{}, false},
+		{"distinct accounts", []string{addr.Hex(), otherAddr.Hex()}, []common.Address{addr, otherAddr}, false},
+		{"repeated hex account", []string{addr.Hex(), otherAddr.Hex(), addr.Hex()}, []common.Address{addr, otherAddr}, false},
+		{"same account in hex and bech32", []string{"cosmos18wvvwfmq77a6d8tza4h5sfuy2yj3jj88yqg82a", strings.ToLower(addr.Hex())}, []common.Address{addr}, false},
+		{"invalid account", []string{addr.Hex(), "0x3B98"}, nil, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			addresses, err := accountsToAddresses(tc.accounts)
+			require.Equal(t, tc.expectErr, err != nil, err)
+
+			if !tc.expectErr {
+				require.Equal(t, tc.expAddresses, addresses)
+			}
+		})
+	}
+}
+
 func TestCosmosToEthereumTypes(t *testing.T) {
 	hexString := "0x3B98D72760f7bbA69d62Ed6F48278451251948E7"
 	cosmosAddr, err := sdk.AccAddressFromHexUnsafe(hexString[2:])
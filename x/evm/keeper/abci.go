@@ -10,9 +10,14 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
-// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper.
+// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper, and stores
+// the parent block hash in the EIP-2935 history storage contract if enabled.
 func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	k.WithChainID(ctx)
+
+	if err := k.SetParentBlockHash(ctx); err != nil {
+		k.Logger(ctx).Error("failed to set parent block hash in history storage", "error", err)
+	}
 }
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
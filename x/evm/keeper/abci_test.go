@@ -1,7 +1,16 @@
 package keeper_test
 
 import (
+	"encoding/json"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
 	"github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/evmos/evmos/v16/server/config"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 )
 
@@ -16,3 +25,96 @@ func (suite *KeeperTestSuite) TestEndBlock() {
 	suite.Require().Equal(1, len(em.Events()))
 	suite.Require().Equal(evmtypes.EventTypeBlockBloom, em.Events()[0].Type)
 }
+
+func (suite *KeeperTestSuite) TestBeginBlockHistoryStorage() {
+	parentHash := common.BytesToHash([]byte("parent block hash"))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expSet   bool
+	}{
+		{
+			"EIP-2935 not activated",
+			func() {},
+			false,
+		},
+		{
+			"EIP-2935 activated at a later block",
+			func() {
+				activation := sdkmath.NewInt(suite.ctx.BlockHeight() + 1)
+				suite.setEIP2935Block(&activation)
+			},
+			false,
+		},
+		{
+			"EIP-2935 activated",
+			func() {
+				activation := sdkmath.ZeroInt()
+				suite.setEIP2935Block(&activation)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			header := suite.ctx.BlockHeader()
+			header.LastBlockId = tmproto.BlockID{Hash: parentHash.Bytes()}
+			ctx := suite.ctx.WithBlockHeader(header)
+			parentNumber := uint64(ctx.BlockHeight() - 1)
+
+			suite.app.EvmKeeper.BeginBlock(ctx, types.RequestBeginBlock{})
+
+			stored := suite.app.EvmKeeper.GetState(ctx, evmtypes.HistoryStorageAddress, evmtypes.HistoryStorageSlot(parentNumber))
+			code := suite.app.EvmKeeper.GetCode(ctx, common.BytesToHash(suite.app.EvmKeeper.GetAccountOrEmpty(ctx, evmtypes.HistoryStorageAddress).CodeHash))
+			if !tc.expSet {
+				suite.Require().Equal(common.Hash{}, stored)
+				suite.Require().Empty(code)
+				return
+			}
+
+			suite.Require().Equal(parentHash, stored)
+			suite.Require().Equal(evmtypes.HistoryStorageCode, code)
+
+			// the history storage contract returns the parent block hash
+			res := suite.callHistoryStorage(ctx, new(big.Int).SetUint64(parentNumber))
+			suite.Require().Empty(res.VmError)
+			suite.Require().Equal(parentHash.Bytes(), res.Ret)
+
+			// the history storage contract reverts for the current block
+			res = suite.callHistoryStorage(ctx, big.NewInt(ctx.BlockHeight()))
+			suite.Require().NotEmpty(res.VmError)
+		})
+	}
+}
+
+// setEIP2935Block sets the EIP-2935 activation block in the chain config.
+func (suite *KeeperTestSuite) setEIP2935Block(block *sdkmath.Int) {
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.ChainConfig.EIP2935Block = block
+	err := suite.app.EvmKeeper.SetParams(suite.ctx, params)
+	suite.Require().NoError(err)
+}
+
+// callHistoryStorage calls the EIP-2935 history storage contract with the given
+// block number as input.
+func (suite *KeeperTestSuite) callHistoryStorage(ctx sdk.Context, number *big.Int) *evmtypes.MsgEthereumTxResponse {
+	input := common.LeftPadBytes(number.Bytes(), 32)
+	args, err := json.Marshal(&evmtypes.TransactionArgs{
+		To:   &evmtypes.HistoryStorageAddress,
+		Data: (*hexutil.Bytes)(&input),
+	})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.EthCall(ctx, &evmtypes.EthCallRequest{
+		Args:            args,
+		GasCap:          config.DefaultGasCap,
+		ProposerAddress: ctx.BlockHeader().ProposerAddress,
+	})
+	suite.Require().NoError(err)
+	return res
+}
@@ -47,11 +47,25 @@ func (k *Keeper) TxConfig(ctx sdk.Context, txHash common.Hash) statedb.TxConfig
 // VMConfig creates an EVM configuration from the debug setting and the extra EIPs enabled on the
 // module parameters. The config generated uses the default JumpTable from the EVM.
 func (k Keeper) VMConfig(ctx sdk.Context, _ core.Message, cfg *statedb.EVMConfig, tracer vm.EVMLogger) vm.Config {
+	isLondon := types.IsLondon(cfg.ChainConfig, ctx.BlockHeight())
+
 	noBaseFee := true
-	if types.IsLondon(cfg.ChainConfig, ctx.BlockHeight()) {
+	if isLondon {
 		noBaseFee = k.feeMarketKeeper.GetParams(ctx).NoBaseFee
 	}
 
+	// the gas refunds of SSTORE and SELFDESTRUCT follow the EnableEIP3529 param
+	// instead of the London hard fork
+	extraEIPs := cfg.Params.EIPs()
+	switch {
+	case cfg.Params.EnableEIP3529 && !isLondon:
+		extraEIPs = append(extraEIPs, 3529)
+	case !cfg.Params.EnableEIP3529 && isLondon:
+		// EIP-2929 restores the SSTORE and SELFDESTRUCT gas functions replaced
+		// by EIP-3529, while the other ones are the same as in London
+		extraEIPs = append(extraEIPs, 2929)
+	}
+
 	var debug bool
 	if _, ok := tracer.(types.NoOpTracer); !ok {
 		debug = true
@@ -61,6 +75,6 @@ func (k Keeper) VMConfig(ctx sdk.Context, _ core.Message, cfg *statedb.EVMConfig
 		Debug:     debug,
 		Tracer:    tracer,
 		NoBaseFee: noBaseFee,
-		ExtraEips: cfg.Params.EIPs(),
+		ExtraEips: extraEIPs,
 	}
 }
@@ -0,0 +1,130 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	epochstypes "github.com/evmos/evmos/v16/x/epochs/types"
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// BeforeEpochStart: noop, We don't need to do anything here
+func (k *Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {
+}
+
+// AfterEpochEnd calls each of the scheduled contracts at the end of the
+// scheduled epoch. Every call is executed on a cached context, so that a
+// failing contract call is discarded without affecting the other calls.
+func (k *Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	params := k.GetParams(ctx)
+	if len(params.ScheduledContracts) == 0 || epochIdentifier != params.ScheduledEpochIdentifier {
+		return
+	}
+
+	data, err := types.PackScheduledCall(epochIdentifier, epochNumber)
+	if err != nil {
+		k.Logger(ctx).Error("failed to pack scheduled call", "error", err)
+		return
+	}
+
+	for _, contract := range params.GetScheduledContractsAddrs() {
+		cacheCtx, writeFn := ctx.CacheContext()
+
+		errMsg := ""
+		if err := k.callScheduledContract(cacheCtx, contract, data, params.ScheduledCallGas); err != nil {
+			errMsg = err.Error()
+			k.Logger(ctx).Error(
+				"scheduled contract call failed",
+				"contract", contract.Hex(),
+				"epoch-id", epochIdentifier,
+				"epoch-number", epochNumber,
+				"error", err,
+			)
+		} else {
+			writeFn()
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeScheduledCall,
+				sdk.NewAttribute(types.AttributeKeyContractAddress, contract.Hex()),
+				sdk.NewAttribute(types.AttributeKeyEpochIdentifier, epochIdentifier),
+				sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", epochNumber)),
+				sdk.NewAttribute(types.AttributeKeyScheduledCallErr, errMsg),
+			),
+		)
+	}
+}
+
+// callScheduledContract calls the given contract from the EVM module account
+// with the given gas limit. It returns an error if the call reverts.
+func (k *Keeper) callScheduledContract(
+	ctx sdk.Context,
+	contract common.Address,
+	data []byte,
+	gasLimit uint64,
+) (err error) {
+	// NOTE: a panic in a scheduled call must not halt the chain
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("scheduled call panicked: %v", r)
+		}
+	}()
+
+	from := common.BytesToAddress(k.accountKeeper.GetModuleAddress(types.ModuleName))
+
+	msg := ethtypes.NewMessage(
+		from,
+		&contract,
+		k.GetNonce(ctx, from),
+		big.NewInt(0), // amount
+		gasLimit,      // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		data,
+		ethtypes.AccessList{}, // AccessList
+		false,                 // isFake
+	)
+
+	res, err := k.ApplyMessage(ctx, msg, types.NewNoOpTracer(), true)
+	if err != nil {
+		return err
+	}
+
+	if res.Failed() {
+		return errorsmod.Wrap(types.ErrVMExecution, res.VmError)
+	}
+
+	return nil
+}
+
+// ___________________________________________________________________________________________________
+
+// EpochHooks wrapper struct for the EVM keeper
+type EpochHooks struct {
+	k *Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks returns the wrapper struct of the epochs hooks
+func (k *Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// BeforeEpochStart implements EpochHooks
+func (h EpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	h.k.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+}
+
+// AfterEpochEnd implements EpochHooks
+func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+var (
+	// storerCode stores the epoch number argument of the scheduled call in slot 0:
+	// PUSH1 0x24 CALLDATALOAD PUSH1 0x00 SSTORE STOP
+	storerCode = common.FromHex("0x60243560005500")
+	// reverterCode reverts on every call:
+	// PUSH1 0x00 PUSH1 0x00 REVERT
+	reverterCode = common.FromHex("0x60006000fd")
+)
+
+func (suite *KeeperTestSuite) TestAfterEpochEndScheduledCalls() {
+	storer := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	reverter := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	epochNumber := int64(7)
+
+	testCases := []struct {
+		name            string
+		malleate        func(params *evmtypes.Params)
+		epochIdentifier string
+		expStored       bool
+		expEvents       int
+	}{
+		{
+			"no scheduled contracts",
+			func(params *evmtypes.Params) {
+				params.ScheduledContracts = []string{}
+			},
+			evmtypes.DefaultScheduledEpochIdentifier,
+			false,
+			0,
+		},
+		{
+			"different epoch identifier",
+			func(*evmtypes.Params) {},
+			"week",
+			false,
+			0,
+		},
+		{
+			"reverting contract doesn't block the other calls",
+			func(*evmtypes.Params) {},
+			evmtypes.DefaultScheduledEpochIdentifier,
+			true,
+			2,
+		},
+		{
+			"call out of gas is discarded",
+			func(params *evmtypes.Params) {
+				params.ScheduledCallGas = 30_000
+			},
+			evmtypes.DefaultScheduledEpochIdentifier,
+			false,
+			2,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.setContractCode(storer, storerCode)
+			suite.setContractCode(reverter, reverterCode)
+
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.ScheduledContracts = []string{reverter.Hex(), storer.Hex()}
+			tc.malleate(&params)
+			err := suite.app.EvmKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+			suite.app.EvmKeeper.EpochHooks().AfterEpochEnd(ctx, tc.epochIdentifier, epochNumber)
+
+			stored := suite.app.EvmKeeper.GetState(ctx, storer, common.Hash{})
+			if tc.expStored {
+				suite.Require().Equal(common.BigToHash(big.NewInt(epochNumber)), stored)
+			} else {
+				suite.Require().Equal(common.Hash{}, stored)
+			}
+
+			events := ctx.EventManager().Events()
+			suite.Require().Len(events, tc.expEvents)
+			for _, event := range events {
+				suite.Require().Equal(evmtypes.EventTypeScheduledCall, event.Type)
+			}
+		})
+	}
+}
+
+// setContractCode sets the given runtime code on the given address.
+func (suite *KeeperTestSuite) setContractCode(address common.Address, code []byte) {
+	codeHash := crypto.Keccak256(code)
+	suite.app.EvmKeeper.SetCode(suite.ctx, codeHash, code)
+
+	account := suite.app.EvmKeeper.GetAccountOrEmpty(suite.ctx, address)
+	account.Nonce = 1
+	account.CodeHash = codeHash
+	err := suite.app.EvmKeeper.SetAccount(suite.ctx, address, account)
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,77 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// WithFeegrantKeeper sets the fee grant keeper used to give back the refunded
+// fees of the ethereum transactions paid by a fee payer to its allowance.
+func (k *Keeper) WithFeegrantKeeper(feegrantKeeper types.FeegrantKeeper) *Keeper {
+	k.feegrantKeeper = feegrantKeeper
+	return k
+}
+
+// refundFeeAllowance gives back the refunded fees to the allowance granted by the
+// fee payer to the sender, which was charged the fees of the whole gas limit in
+// the AnteHandler. Nothing is given back if the allowance was used up and
+// removed, or if it has no spend limit. The amount left in the current period of
+// a periodic allowance is capped to its period spend limit.
+func (k Keeper) refundFeeAllowance(ctx sdk.Context, feePayer, sender sdk.AccAddress, refund sdk.Coins) error {
+	if k.feegrantKeeper == nil {
+		return nil
+	}
+
+	allowance, err := k.feegrantKeeper.GetAllowance(ctx, feePayer, sender)
+	switch {
+	case errortypes.ErrNotFound.Is(err):
+		// the allowance was removed when it was used up
+		return nil
+	case err != nil:
+		return err
+	}
+
+	refunded, err := addToAllowance(allowance, refund)
+	if err != nil || refunded == nil {
+		return err
+	}
+
+	return k.feegrantKeeper.UpdateAllowance(ctx, feePayer, sender, refunded)
+}
+
+// addToAllowance returns the given allowance with the given coins added to its
+// spend limits, or nil if the allowance has no spend limit to update.
+func addToAllowance(allowance feegrant.FeeAllowanceI, coins sdk.Coins) (feegrant.FeeAllowanceI, error) {
+	switch a := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		if a.SpendLimit.Empty() {
+			return nil, nil
+		}
+		a.SpendLimit = a.SpendLimit.Add(coins...)
+		return a, nil
+	case *feegrant.PeriodicAllowance:
+		// the period may have been reset since the fees were charged, so the
+		// refund can't raise the amount left above the period limit
+		a.PeriodCanSpend = a.PeriodCanSpend.Add(coins...).Min(a.PeriodSpendLimit)
+		if !a.Basic.SpendLimit.Empty() {
+			a.Basic.SpendLimit = a.Basic.SpendLimit.Add(coins...)
+		}
+		return a, nil
+	case *feegrant.AllowedMsgAllowance:
+		inner, err := a.GetAllowance()
+		if err != nil {
+			return nil, err
+		}
+		refunded, err := addToAllowance(inner, coins)
+		if err != nil || refunded == nil {
+			return nil, err
+		}
+		return feegrant.NewAllowedMsgAllowance(refunded, a.AllowedMessages)
+	default:
+		return nil, nil
+	}
+}
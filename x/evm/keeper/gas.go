@@ -42,13 +42,27 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees.
+		// If the fees were paid through a fee grant, the refund goes to the fee payer instead and is given back to
+		// the allowance.
+		sender := sdk.AccAddress(msg.From().Bytes())
+		recipient := sender
+		feePayer := k.GetTxFeePayerTransient(ctx)
+		if feePayer != nil {
+			recipient = feePayer
+		}
 
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins)
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, recipient, refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
 		}
+
+		if feePayer != nil {
+			if err := k.refundFeeAllowance(ctx, feePayer, sender, refundedCoins); err != nil {
+				return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s) to the fee allowance", leftoverGas, refundedCoins.String())
+			}
+		}
 	default:
 		// no refund, consume gas and update the tx gas meter
 	}
@@ -0,0 +1,114 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmostypes "github.com/evmos/evmos/v16/types"
+)
+
+// ExportGethGenesis writes the EVM state to the given writer as a geth
+// genesis file, with the chain config and the balance, nonce, code and
+// storage of the accounts in the alloc section. The accounts are written one
+// at a time to avoid loading the whole state into memory. If no addresses are
+// given, all the EthAccounts are exported, otherwise only the given addresses
+// that exist are.
+func (k *Keeper) ExportGethGenesis(ctx sdk.Context, w io.Writer, addresses []common.Address) error {
+	ethCfg := k.GetParams(ctx).ChainConfig.EthereumConfig(k.ChainID())
+	config, err := json.Marshal(ethCfg)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, `{"config":%s,"alloc":{`, config); err != nil {
+		return err
+	}
+
+	first := true
+	writeAccount := func(address common.Address) error {
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+		return k.writeGethGenesisAccount(ctx, w, address)
+	}
+
+	if len(addresses) > 0 {
+		for _, address := range addresses {
+			if k.GetAccountWithoutBalance(ctx, address) == nil {
+				continue
+			}
+			if err := writeAccount(address); err != nil {
+				return err
+			}
+		}
+	} else {
+		k.accountKeeper.IterateAccounts(ctx, func(account authtypes.AccountI) bool {
+			ethAccount, ok := account.(evmostypes.EthAccountI)
+			if !ok {
+				// ignore non EthAccounts
+				return false
+			}
+			err = writeAccount(ethAccount.EthAddress())
+			return err != nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = io.WriteString(w, "}}\n")
+	return err
+}
+
+// writeGethGenesisAccount writes the given account as an entry of the alloc
+// section of a geth genesis file. The storage slots are written as they are
+// iterated.
+func (k *Keeper) writeGethGenesisAccount(ctx sdk.Context, w io.Writer, address common.Address) error {
+	account := k.GetAccount(ctx, address)
+
+	if _, err := fmt.Fprintf(w, `%q:{"balance":%q,"nonce":%q`,
+		address.Hex(), hexutil.EncodeBig(account.Balance), hexutil.EncodeUint64(account.Nonce),
+	); err != nil {
+		return err
+	}
+
+	if account.IsContract() {
+		code := k.GetCode(ctx, common.BytesToHash(account.CodeHash))
+		if _, err := fmt.Fprintf(w, `,"code":%q`, hexutil.Encode(code)); err != nil {
+			return err
+		}
+	}
+
+	first := true
+	var err error
+	k.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
+		sep := ","
+		if first {
+			sep = `,"storage":{`
+			first = false
+		}
+		_, err = fmt.Fprintf(w, `%s%q:%q`, sep, key.Hex(), value.Hex())
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+
+	closing := "}"
+	if !first {
+		closing = "}}"
+	}
+	_, err = io.WriteString(w, closing)
+	return err
+}
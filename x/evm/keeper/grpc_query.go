@@ -16,8 +16,12 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -25,6 +29,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	evmostypes "github.com/evmos/evmos/v16/types"
@@ -164,6 +169,32 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*typ
 
 	ctx := sdk.UnwrapSDKContext(c)
 
+	if len(req.PendingTxs) > 0 {
+		if len(req.PendingTxs) > types.MaxStoragePendingTxs {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"too many pending txs: %d > %d", len(req.PendingTxs), types.MaxStoragePendingTxs,
+			)
+		}
+
+		var totalGas uint64
+		for _, tx := range req.PendingTxs {
+			gas := tx.GetGas()
+			if gas > req.GasCap-totalGas {
+				return nil, status.Errorf(
+					codes.InvalidArgument,
+					"pending txs gas exceeds the gas cap %d", req.GasCap,
+				)
+			}
+			totalGas += gas
+		}
+
+		var err error
+		if ctx, err = k.applyPendingTxs(ctx, req.PendingTxs); err != nil {
+			return nil, err
+		}
+	}
+
 	address := common.HexToAddress(req.Address)
 	key := common.HexToHash(req.Key)
 
@@ -175,6 +206,41 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*typ
 	}, nil
 }
 
+// ContractStorage implements the Query/ContractStorage gRPC method. It
+// iterates over the storage slots of the given account, which are empty for
+// externally owned accounts.
+func (k Keeper) ContractStorage(c context.Context, req *types.QueryContractStorageRequest) (*types.QueryContractStorageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmostypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address := common.HexToAddress(req.Address)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(address))
+
+	var storage []types.State
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		storage = append(storage, types.NewState(common.BytesToHash(key), common.BytesToHash(value)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryContractStorageResponse{
+		Storage:    storage,
+		Pagination: pageRes,
+	}, nil
+}
+
 // Code implements the Query/Code gRPC method
 func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
 	if req == nil {
@@ -235,6 +301,35 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	var blockOverrides *types.BlockOverrides
+	if len(req.BlockOverrides) > 0 {
+		if err := json.Unmarshal(req.BlockOverrides, &blockOverrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := blockOverrides.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	ctx = applyBlockOverrides(ctx, cfg, blockOverrides)
+
+	var overrides *types.StateOverride
+	if len(req.Overrides) > 0 {
+		if err := json.Unmarshal(req.Overrides, &overrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	// apply the state overrides on a cache context that is never written back, so that
+	// they are discarded after the call
+	if overrides != nil {
+		ctx, _ = ctx.CacheContext()
+		if err := k.applyStateOverrides(ctx, overrides, txConfig); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
@@ -244,8 +339,6 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
-
 	// pass false to not commit StateDB
 	res, err := k.ApplyMessageWithConfig(ctx, msg, nil, false, cfg, txConfig)
 	if err != nil {
@@ -287,6 +380,13 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	var overrides *types.StateOverride
+	if len(req.Overrides) > 0 {
+		if err := json.Unmarshal(req.Overrides, &overrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var (
 		lo     = ethparams.TxGas - 1
@@ -320,12 +420,21 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
 
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
+
+	// apply the state overrides once on a cache context that is never written back,
+	// before reading the sender nonce, so that an overridden nonce is used as is
+	if overrides != nil {
+		ctx, _ = ctx.CacheContext()
+		if err := k.applyStateOverrides(ctx, overrides, txConfig); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
-	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
-
 	// convert the tx args to an ethereum message
 	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
 	if err != nil {
@@ -335,8 +444,8 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 	// NOTE: the errors from the executable below should be consistent with go-ethereum,
 	// so we don't wrap them with the gRPC status code
 
-	// Create a helper to check if a gas allowance results in an executable transaction
-	executable := func(gas uint64) (vmError bool, rsp *types.MsgEthereumTxResponse, err error) {
+	// Create a helper to execute the transaction with the given gas allowance and tracer
+	execute := func(gas uint64, tracer vm.EVMLogger) (vmError bool, rsp *types.MsgEthereumTxResponse, err error) {
 		// update the message with the new gas value
 		msg = ethtypes.NewMessage(
 			msg.From(),
@@ -375,7 +484,7 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 			tmpCtx = evmante.BuildEvmExecutionCtx(tmpCtx).WithGasMeter(gasMeter)
 		}
 		// pass false to not commit StateDB
-		rsp, err = k.ApplyMessageWithConfig(tmpCtx, msg, nil, false, cfg, txConfig)
+		rsp, err = k.ApplyMessageWithConfig(tmpCtx, msg, tracer, false, cfg, txConfig)
 		if err != nil {
 			if errors.Is(err, core.ErrIntrinsicGas) {
 				return true, nil, nil // Special case, raise gas limit
@@ -385,6 +494,25 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		return len(rsp.VmError) > 0, rsp, nil
 	}
 
+	// Create a helper to check if a gas allowance results in an executable transaction
+	executable := func(gas uint64) (vmError bool, rsp *types.MsgEthereumTxResponse, err error) {
+		return execute(gas, nil)
+	}
+
+	// Trace the execution with the highest allowance to raise the lower bound of the
+	// search to the gas required by the internal calls. Otherwise, the search can
+	// settle on a gas limit for which an internal call runs out of gas because of the
+	// gas retained by its caller, while the transaction itself succeeds.
+	if fromType == types.RPC {
+		tracer := types.NewCallGasTracer()
+		failed, _, err := execute(hi, tracer)
+		if err == nil && !failed {
+			if required := tracer.RequiredGas(); required > lo+1 && required <= hi {
+				lo = required - 1
+			}
+		}
+	}
+
 	// Execute the binary search and hone in on an executable gas limit
 	hi, err = types.BinSearch(lo, hi, executable)
 	if err != nil {
@@ -412,6 +540,80 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 	return &types.EstimateGasResponse{Gas: hi}, nil
 }
 
+// CreateAccessList implements eth_createAccessList rpc api.
+// It executes the call repeatedly with an access list tracer until the access list
+// touched by the execution stops changing, and returns it along with the gas used.
+func (k Keeper) CreateAccessList(c context.Context, req *types.EthCallRequest) (*types.CreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var args types.TransactionArgs
+	err := json.Unmarshal(req.Args, &args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	// the sender, the recipient (or the created contract) and the precompiles
+	// are always warm, so they are excluded from the access list
+	from := args.GetFrom()
+	to := crypto.CreateAddress(from, nonce)
+	if args.To != nil {
+		to = *args.To
+	}
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil)
+	precompiles := append(vm.DefaultActivePrecompiles(rules), cfg.Params.GetActivePrecompilesAddrs()...)
+
+	prevTracer := logger.NewAccessListTracer(nil, from, to, precompiles)
+	if args.AccessList != nil {
+		prevTracer = logger.NewAccessListTracer(*args.AccessList, from, to, precompiles)
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	for {
+		// retrieve the current access list to expand
+		accessList := prevTracer.AccessList()
+		args.AccessList = &accessList
+
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		tracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+
+		// pass false to not commit StateDB
+		res, err := k.ApplyMessageWithConfig(ctx, msg, tracer, false, cfg, txConfig)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if tracer.Equal(prevTracer) {
+			return &types.CreateAccessListResponse{
+				AccessList: types.NewAccessList(&accessList),
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+		prevTracer = tracer
+	}
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -424,30 +626,87 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
 	}
 
+	ctx, cfg, err := k.prepareBlockContext(
+		sdk.UnwrapSDKContext(c),
+		req.BlockNumber,
+		req.BlockTime,
+		req.BlockHash,
+		req.BlockMaxGas,
+		req.ChainId,
+		req.ProposerAddress,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
+	ctx, txConfig = k.applyPredecessors(ctx, cfg, signer, txConfig, req.Predecessors)
+
+	tx := req.Msg.AsTransaction()
+	txConfig.TxHash = tx.Hash()
+	if len(req.Predecessors) > 0 {
+		txConfig.TxIndex++
+	}
+
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
+	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, false, tracerConfig)
+	if err != nil {
+		// error will be returned with detail status from traceTx
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceTxResponse{
+		Data: resultData,
+	}, nil
+}
+
+// prepareBlockContext returns the context and the EVM configuration at the
+// beginning of the block with the given header fields, to replay or trace the
+// transactions included in it.
+func (k Keeper) prepareBlockContext(
+	ctx sdk.Context,
+	blockNumber int64,
+	blockTime time.Time,
+	blockHash string,
+	blockMaxGas int64,
+	chainID int64,
+	proposerAddress sdk.ConsAddress,
+) (sdk.Context, *statedb.EVMConfig, error) {
 	// get the context of block beginning
-	contextHeight := req.BlockNumber
+	contextHeight := blockNumber
 	if contextHeight < 1 {
 		// 0 is a special value in `ContextWithHeight`
 		contextHeight = 1
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
 	ctx = ctx.WithBlockHeight(contextHeight)
-	ctx = ctx.WithBlockTime(req.BlockTime)
-	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+	ctx = ctx.WithBlockTime(blockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(blockHash))
 
 	// to get the base fee we only need the block max gas in the consensus params
 	ctx = ctx.WithConsensusParams(&tmproto.ConsensusParams{
-		Block: &tmproto.BlockParams{MaxGas: req.BlockMaxGas},
+		Block: &tmproto.BlockParams{MaxGas: blockMaxGas},
 	})
 
-	chainID, err := getChainID(ctx, req.ChainId)
+	eip155ChainID, err := getChainID(ctx, chainID)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return ctx, nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, proposerAddress), eip155ChainID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+		return ctx, nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
 	}
 
 	// compute and use base fee of the height that is being traced
@@ -456,15 +715,128 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		cfg.BaseFee = baseFee
 	}
 
-	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	return ctx, cfg, nil
+}
 
+// applyPendingTxs executes the given pending transactions on top of the state
+// of a branch of the given context, and returns the branched context. Each
+// transaction goes through the checks of the ante handler, and the
+// transactions that fail them or their execution are skipped, as they would
+// not modify the state.
+func (k *Keeper) applyPendingTxs(ctx sdk.Context, pendingTxs []*types.MsgEthereumTx) (sdk.Context, error) {
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, nil), k.ChainID())
+	if err != nil {
+		return ctx, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
 
+	ctx, _ = ctx.CacheContext()
+	for i, tx := range pendingTxs {
+		txConfig.TxHash = tx.AsTransaction().Hash()
+		txConfig.TxIndex = uint(i)
+
+		cacheCtx, commit := ctx.CacheContext()
+		rsp, err := k.applyPendingTx(cacheCtx, cfg, signer, txConfig, tx)
+		if err != nil {
+			continue
+		}
+		commit()
+		txConfig.LogIndex += uint(len(rsp.Logs))
+	}
+
+	return ctx, nil
+}
+
+// applyPendingTx runs the nonce, fee and balance checks of the ante handler on
+// a pending transaction, deducts its fees, increments the sender nonce, and
+// executes it, refunding the leftover gas.
+func (k *Keeper) applyPendingTx(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	signer ethtypes.Signer,
+	txConfig statedb.TxConfig,
+	tx *types.MsgEthereumTx,
+) (*types.MsgEthereumTxResponse, error) {
+	if err := tx.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	txData, err := types.UnpackTxData(tx.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	ethTx := tx.AsTransaction()
+	msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, err
+	}
+
+	from := msg.From()
+	account := k.GetAccountOrEmpty(ctx, from)
+	if msg.Nonce() != account.Nonce {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInvalidSequence,
+			"invalid nonce; got %d, expected %d", msg.Nonce(), account.Nonce,
+		)
+	}
+
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), true)
+	fees, err := VerifyFee(txData, cfg.Params.EvmDenom, cfg.BaseFee, rules.IsHomestead, rules.IsIstanbul, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.DeductTxCostsFromUserBalance(ctx, fees, from); err != nil {
+		return nil, err
+	}
+
+	if msg.Value().Cmp(k.GetBalance(ctx, from)) > 0 {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"failed to transfer %s from address %s", msg.Value(), from,
+		)
+	}
+
+	// the nonce is incremented by the ante handler for calls, and by the
+	// state transition for contract creations
+	account = k.GetAccountOrEmpty(ctx, from)
+	account.Nonce++
+	if err := k.SetAccount(ctx, from, account); err != nil {
+		return nil, err
+	}
+
+	ctx = evmante.BuildEvmExecutionCtx(ctx).
+		WithGasMeter(evmostypes.NewInfiniteGasMeterWithLimit(msg.Gas()))
+	rsp, err := k.ApplyMessageWithConfig(ctx, msg, types.NewNoOpTracer(), true, cfg, txConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.RefundGas(ctx, msg, msg.Gas()-rsp.GasUsed, cfg.Params.EvmDenom); err != nil {
+		return nil, err
+	}
+
+	return rsp, nil
+}
+
+// applyPredecessors executes the transactions preceding a traced or replayed
+// transaction in its block, and returns the context and the tx config to
+// execute it with.
+func (k *Keeper) applyPredecessors(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	signer ethtypes.Signer,
+	txConfig statedb.TxConfig,
+	predecessors []*types.MsgEthereumTx,
+) (sdk.Context, statedb.TxConfig) {
 	// gas used at this point corresponds to GetProposerAddress & CalculateBaseFee
 	// need to reset gas meter per transaction to be consistent with tx execution
 	// and avoid stacking the gas used of every predecessor in the same gas meter
 
-	for i, tx := range req.Predecessors {
+	for i, tx := range predecessors {
 		ethTx := tx.AsTransaction()
 		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
 		if err != nil {
@@ -482,32 +854,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		txConfig.LogIndex += uint(len(rsp.Logs))
 	}
 
-	tx := req.Msg.AsTransaction()
-	txConfig.TxHash = tx.Hash()
-	if len(req.Predecessors) > 0 {
-		txConfig.TxIndex++
-	}
-
-	var tracerConfig json.RawMessage
-	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
-		// ignore error. default to no traceConfig
-		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
-	}
-
-	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, false, tracerConfig)
-	if err != nil {
-		// error will be returned with detail status from traceTx
-		return nil, err
-	}
-
-	resultData, err := json.Marshal(result)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-
-	return &types.QueryTraceTxResponse{
-		Data: resultData,
-	}, nil
+	return ctx, txConfig
 }
 
 // TraceBlock configures a new tracer according to the provided configuration, and
@@ -562,8 +909,8 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
 
 	for i, tx := range req.Txs {
-		result := types.TxTraceResult{}
 		ethTx := tx.AsTransaction()
+		result := types.TxTraceResult{TxHash: ethTx.Hash()}
 		txConfig.TxHash = ethTx.Hash()
 		txConfig.TxIndex = uint(i)
 		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
@@ -641,6 +988,10 @@ func (k *Keeper) traceTx(
 		}
 	}
 
+	if traceConfig.EnableOpcodeProfile {
+		tracer = types.NewOpcodeProfileTracer(tracer)
+	}
+
 	// Define a meaningful timeout of a single transaction trace
 	if traceConfig.Timeout != "" {
 		if timeout, err = time.ParseDuration(traceConfig.Timeout); err != nil {
@@ -662,6 +1013,15 @@ func (k *Keeper) traceTx(
 	// Build EVM execution context
 	ctx = evmante.BuildEvmExecutionCtx(ctx).
 		WithGasMeter(evmostypes.NewInfiniteGasMeterWithLimit(msg.Gas()))
+
+	// The prestate tracer recovers the sender's balance and nonce from the state after the
+	// ante handler has run, so the gas purchase and nonce increment need to be applied first.
+	if traceConfig.Tracer == types.TracerPrestate {
+		if err := k.prepareTraceState(ctx, msg, cfg); err != nil {
+			return nil, 0, status.Error(codes.Internal, err.Error())
+		}
+	}
+
 	res, err := k.ApplyMessageWithConfig(ctx, msg, tracer, commitMessage, cfg, txConfig)
 	if err != nil {
 		return nil, 0, status.Error(codes.Internal, err.Error())
@@ -676,6 +1036,28 @@ func (k *Keeper) traceTx(
 	return &result, txConfig.LogIndex + uint(len(res.Logs)), nil
 }
 
+// prepareTraceState deducts the full gas cost from the sender and increments its nonce,
+// mirroring the state transitions performed by the EVM ante handler before execution.
+func (k *Keeper) prepareTraceState(ctx sdk.Context, msg core.Message, cfg *statedb.EVMConfig) error {
+	gasCost := new(big.Int).Mul(new(big.Int).SetUint64(msg.Gas()), msg.GasPrice())
+	fees := sdk.NewCoins(sdk.NewCoin(cfg.Params.EvmDenom, sdkmath.NewIntFromBigInt(gasCost)))
+	if !fees.IsZero() {
+		if err := k.DeductTxCostsFromUserBalance(ctx, fees, msg.From()); err != nil {
+			return err
+		}
+	}
+
+	account := k.accountKeeper.GetAccount(ctx, msg.From().Bytes())
+	if account == nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownAddress, "account not found for sender %s", msg.From())
+	}
+	if err := account.SetSequence(account.GetSequence() + 1); err != nil {
+		return errorsmod.Wrapf(err, "failed to increment nonce of sender %s", msg.From())
+	}
+	k.accountKeeper.SetAccount(ctx, account)
+	return nil
+}
+
 // BaseFee implements the Query/BaseFee gRPC method
 func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -693,6 +1075,52 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 	return res, nil
 }
 
+// BlockedAddresses implements the Query/BlockedAddresses gRPC method
+func (k Keeper) BlockedAddresses(c context.Context, _ *types.QueryBlockedAddressesRequest) (*types.QueryBlockedAddressesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryBlockedAddressesResponse{Addresses: params.BlockedAddresses}, nil
+}
+
+// applyStateOverrides applies the given state overrides to the provided context
+// through a temporary StateDB. The context is expected to be a cache context so
+// that the overrides are discarded once the call is executed.
+func (k *Keeper) applyStateOverrides(ctx sdk.Context, overrides *types.StateOverride, txConfig statedb.TxConfig) error {
+	if overrides == nil {
+		return nil
+	}
+
+	stateDB := statedb.New(ctx, k, txConfig)
+	if err := overrides.Apply(stateDB); err != nil {
+		return errorsmod.Wrap(err, "failed to apply state overrides")
+	}
+	return stateDB.Commit()
+}
+
+// applyBlockOverrides applies the given block overrides to the provided context and
+// EVM configuration, so that the EVM block context of the call is built from the
+// overridden values instead of the actual block header.
+func applyBlockOverrides(ctx sdk.Context, cfg *statedb.EVMConfig, overrides *types.BlockOverrides) sdk.Context {
+	if overrides == nil {
+		return ctx
+	}
+
+	if overrides.Number != nil {
+		ctx = ctx.WithBlockHeight(overrides.Number.ToInt().Int64())
+	}
+	if overrides.Time != nil {
+		ctx = ctx.WithBlockTime(time.Unix(int64(*overrides.Time), 0).UTC()) // #nosec G701 -- checked for int overflow on validation
+	}
+	if overrides.Coinbase != nil {
+		cfg.CoinBase = *overrides.Coinbase
+	}
+	if overrides.BaseFee != nil {
+		cfg.BaseFee = overrides.BaseFee.ToInt()
+	}
+	return ctx
+}
+
 // getChainID parse chainID from current context if not provided
 func getChainID(ctx sdk.Context, chainID int64) (*big.Int, error) {
 	if chainID == 0 {
@@ -4,12 +4,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
@@ -25,10 +30,10 @@ import (
 const invalidAddress = "0x0000"
 
 // expGasConsumed is the gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee)
-const expGasConsumed = 7700
+const expGasConsumed = 8195
 
 // expGasConsumedWithFeeMkt is the gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee) with enabled feemarket
-const expGasConsumedWithFeeMkt = 7694
+const expGasConsumedWithFeeMkt = 8189
 
 func (suite *KeeperTestSuite) TestQueryAccount() {
 	var (
@@ -293,6 +298,125 @@ func (suite *KeeperTestSuite) TestQueryStorage() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryStoragePendingTxs() {
+	suite.SetupTest()
+
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+	suite.Commit()
+
+	recipient := utiltx.GenerateAddress()
+	amount := big.NewInt(100)
+	// the balances mapping is the first slot of the contract
+	balanceKey := crypto.Keccak256Hash(
+		common.LeftPadBytes(recipient.Bytes(), 32),
+		common.LeftPadBytes(big.NewInt(0).Bytes(), 32),
+	)
+
+	transferData, err := types.ERC20Contract.ABI.Pack("transfer", recipient, amount)
+	suite.Require().NoError(err)
+
+	// fund the sender to pay for the fees of the pending txs
+	amt := sdk.Coins{sdk.NewInt64Coin(types.DefaultEVMDenom, 1_000_000_000_000_000_000)}
+	err = suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, amt)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.address.Bytes(), amt)
+	suite.Require().NoError(err)
+
+	chainID := suite.app.EvmKeeper.ChainID()
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	newPendingTx := func(nonce uint64) *types.MsgEthereumTx {
+		tx := types.NewTx(&types.EvmTxArgs{
+			ChainID:  chainID,
+			Nonce:    nonce,
+			To:       &contractAddr,
+			GasLimit: 100_000,
+			GasPrice: big.NewInt(1_000_000_000_000),
+			Input:    transferData,
+		})
+		tx.From = suite.address.Hex()
+		suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+		return tx
+	}
+	pendingTx := newPendingTx(nonce)
+
+	tooManyTxs := make([]*types.MsgEthereumTx, types.MaxStoragePendingTxs+1)
+	for i := range tooManyTxs {
+		tooManyTxs[i] = pendingTx
+	}
+
+	testCases := []struct {
+		msg        string
+		pendingTxs []*types.MsgEthereumTx
+		gasCap     uint64
+		expPass    bool
+		expValue   common.Hash
+	}{
+		{
+			"committed state",
+			nil,
+			0,
+			true,
+			common.Hash{},
+		},
+		{
+			"pending state",
+			[]*types.MsgEthereumTx{pendingTx},
+			100_000,
+			true,
+			common.BigToHash(amount),
+		},
+		{
+			"pending state - consecutive nonces are applied",
+			[]*types.MsgEthereumTx{pendingTx, newPendingTx(nonce + 1)},
+			200_000,
+			true,
+			common.BigToHash(new(big.Int).Mul(amount, big.NewInt(2))),
+		},
+		{
+			"pending state - tx with an invalid nonce is skipped",
+			[]*types.MsgEthereumTx{newPendingTx(nonce + 1)},
+			100_000,
+			true,
+			common.Hash{},
+		},
+		{
+			"fail - pending txs gas above the gas cap",
+			[]*types.MsgEthereumTx{pendingTx},
+			99_999,
+			false,
+			common.Hash{},
+		},
+		{
+			"fail - too many pending txs",
+			tooManyTxs,
+			1_000_000_000,
+			false,
+			common.Hash{},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			res, err := suite.queryClient.Storage(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageRequest{
+				Address:    contractAddr.String(),
+				Key:        balanceKey.String(),
+				PendingTxs: tc.pendingTxs,
+				GasCap:     tc.gasCap,
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expValue.String(), res.Value)
+		})
+	}
+
+	// the pending txs are not committed
+	suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, contractAddr, balanceKey))
+}
+
 func (suite *KeeperTestSuite) TestQueryCode() {
 	var (
 		req     *types.QueryCodeRequest
@@ -415,6 +539,23 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.Require().Equal(expParams, res.Params)
 }
 
+func (suite *KeeperTestSuite) TestQueryBlockedAddresses() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.queryClient.BlockedAddresses(ctx, &types.QueryBlockedAddressesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Addresses)
+
+	blocked := []string{"0x1000000000000000000000000000000000000001"}
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.BlockedAddresses = blocked
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	res, err = suite.queryClient.BlockedAddresses(ctx, &types.QueryBlockedAddressesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(blocked, res.Addresses)
+}
+
 func (suite *KeeperTestSuite) TestQueryValidatorAccount() {
 	var (
 		req        *types.QueryValidatorAccountRequest
@@ -499,8 +640,9 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 	hexBigInt := hexutil.Big(*big.NewInt(1))
 
 	var (
-		args   interface{}
-		gasCap uint64
+		args      interface{}
+		gasCap    uint64
+		overrides *types.StateOverride
 	)
 	testCases := []struct {
 		msg             string
@@ -539,6 +681,34 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 			0,
 			false,
 		},
+		// should success, because the balance of the zero address is overridden
+		{
+			"not enough balance - balance overridden",
+			func() {
+				args = types.TransactionArgs{To: &common.Address{}, Value: (*hexutil.Big)(big.NewInt(100))}
+				balance := (*hexutil.Big)(big.NewInt(1000))
+				overrides = &types.StateOverride{
+					common.Address{}: types.OverrideAccount{Balance: &balance},
+				}
+			},
+			true,
+			ethparams.TxGas,
+			false,
+		},
+		// should fail, because state and stateDiff can't be overridden at the same time
+		{
+			"invalid overrides - both state and stateDiff",
+			func() {
+				args = types.TransactionArgs{To: &common.Address{}}
+				state := map[common.Hash]common.Hash{}
+				overrides = &types.StateOverride{
+					common.Address{}: types.OverrideAccount{State: &state, StateDiff: &state},
+				}
+			},
+			false,
+			0,
+			false,
+		},
 		// should success, enough balance now
 		{
 			"enough balance",
@@ -740,6 +910,7 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 			suite.enableFeemarket = tc.enableFeemarket
 			suite.SetupTest()
 			gasCap = 25_000_000
+			overrides = nil
 			tc.malleate()
 
 			args, err := json.Marshal(&args)
@@ -749,6 +920,10 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 				GasCap:          gasCap,
 				ProposerAddress: suite.ctx.BlockHeader().ProposerAddress,
 			}
+			if overrides != nil {
+				req.Overrides, err = json.Marshal(overrides)
+				suite.Require().NoError(err)
+			}
 
 			rsp, err := suite.queryClient.EstimateGas(sdk.WrapSDKContext(suite.ctx), &req)
 			if tc.expPass {
@@ -762,6 +937,133 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestEstimateGasInternalCall() {
+	suite.SetupTest()
+
+	// callee runtime code storing 1 at slot 0 and emitting an empty log
+	callee := utiltx.GenerateAddress()
+	calleeCode := hexutil.Bytes(hexutil.MustDecode("0x600160005560006000a000"))
+	// caller runtime code calling the callee with all the available gas and
+	// ignoring the call result, so that the transaction succeeds even if the
+	// internal call runs out of gas
+	caller := utiltx.GenerateAddress()
+	callerCode := hexutil.Bytes(append(append(
+		hexutil.MustDecode("0x6000600060006000600073"), callee.Bytes()...),
+		hexutil.MustDecode("0x5af15000")...,
+	))
+
+	overrides, err := json.Marshal(&types.StateOverride{
+		caller: types.OverrideAccount{Code: &callerCode},
+		callee: types.OverrideAccount{Code: &calleeCode},
+	})
+	suite.Require().NoError(err)
+
+	args, err := json.Marshal(&types.TransactionArgs{From: &suite.address, To: &caller})
+	suite.Require().NoError(err)
+
+	rsp, err := suite.queryClient.EstimateGas(suite.ctx, &types.EthCallRequest{
+		Args:            args,
+		GasCap:          config.DefaultGasCap,
+		ProposerAddress: suite.ctx.BlockHeader().ProposerAddress,
+		Overrides:       overrides,
+	})
+	suite.Require().NoError(err)
+
+	// the internal call must succeed with the estimated gas
+	gas := hexutil.Uint64(rsp.Gas)
+	args, err = json.Marshal(&types.TransactionArgs{From: &suite.address, To: &caller, Gas: &gas})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.EthCall(suite.ctx, &types.EthCallRequest{
+		Args:            args,
+		GasCap:          config.DefaultGasCap,
+		ProposerAddress: suite.ctx.BlockHeader().ProposerAddress,
+		Overrides:       overrides,
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Len(res.Logs, 1)
+	suite.Require().Equal(callee.Hex(), res.Logs[0].Address)
+}
+
+func (suite *KeeperTestSuite) TestEstimateGasStateOverrides() {
+	var overrides []byte
+
+	initCode := hexutil.Bytes{0x00}
+	runtimeCode := hexutil.Bytes{0x00}
+	overriddenNonce := hexutil.Uint64(5)
+	emptyState := map[common.Hash]common.Hash{}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expCode  codes.Code
+	}{
+		{
+			"pass - the overridden sender nonce is used for the contract address",
+			func() {
+				// a contract at the address derived from the current nonce makes the
+				// creation fail unless the overridden nonce is used
+				nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+				collision := crypto.CreateAddress(suite.address, nonce)
+
+				var err error
+				overrides, err = json.Marshal(&types.StateOverride{
+					suite.address: types.OverrideAccount{Nonce: &overriddenNonce},
+					collision:     types.OverrideAccount{Code: &runtimeCode},
+				})
+				suite.Require().NoError(err)
+			},
+			codes.OK,
+		},
+		{
+			"fail - malformed overrides",
+			func() {
+				overrides = []byte("{")
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"fail - overrides with both state and state diff",
+			func() {
+				var err error
+				overrides, err = json.Marshal(&types.StateOverride{
+					suite.address: types.OverrideAccount{State: &emptyState, StateDiff: &emptyState},
+				})
+				suite.Require().NoError(err)
+			},
+			codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			args, err := json.Marshal(&types.TransactionArgs{From: &suite.address, Data: &initCode})
+			suite.Require().NoError(err)
+
+			rsp, err := suite.queryClient.EstimateGas(suite.ctx, &types.EthCallRequest{
+				Args:            args,
+				GasCap:          config.DefaultGasCap,
+				ProposerAddress: suite.ctx.BlockHeader().ProposerAddress,
+				Overrides:       overrides,
+			})
+			if tc.expCode != codes.OK {
+				suite.Require().Error(err)
+				suite.Require().Equal(tc.expCode, status.Code(err))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Greater(rsp.Gas, ethparams.TxGasContractCreation)
+
+			// the overrides are discarded after the estimation
+			suite.Require().NotEqual(uint64(overriddenNonce), suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestTraceTx() {
 	// TODO deploy contract that triggers internal transactions
 	var (
@@ -937,7 +1239,7 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 			},
 			expPass:       true,
 			traceResponse: "{\"gas\":34828,\"failed\":false,\"returnValue\":\"0000000000000000000000000000000000000000000000000000000000000001\",\"structLogs\":[{\"pc\":0,\"op\":\"PUSH1\",\"gas\":",
-			expFinalGas:   29708, // gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee) + gas consumed in malleate func
+			expFinalGas:   35120, // gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee) + gas consumed in malleate func
 		},
 		{
 			msg: "invalid chain id",
@@ -1000,6 +1302,130 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestTraceTxPrestate() {
+	suite.SetupTest()
+	// Deploy contract
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+	// Generate token transfer transaction
+	txMsg := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec"), sdkmath.NewIntWithDecimal(1, 18).BigInt())
+	suite.Commit()
+
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	balance := suite.app.EvmKeeper.GetBalance(suite.ctx, suite.address)
+
+	res, err := suite.queryClient.TraceTx(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceTxRequest{
+		Msg:         txMsg,
+		TraceConfig: &types.TraceConfig{Tracer: types.TracerPrestate},
+	})
+	suite.Require().NoError(err)
+
+	var prestate map[common.Address]struct {
+		Balance string `json:"balance"`
+		Nonce   uint64 `json:"nonce"`
+	}
+	suite.Require().NoError(json.Unmarshal(res.Data, &prestate))
+	suite.Require().Contains(prestate, suite.address)
+	suite.Require().Contains(prestate, contractAddr)
+	suite.Require().Equal(nonce, prestate[suite.address].Nonce)
+	suite.Require().Equal(hexutil.EncodeBig(balance), prestate[suite.address].Balance)
+}
+
+func (suite *KeeperTestSuite) TestTraceTxOpcodeProfile() {
+	suite.SetupTest()
+	// Deploy contract
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+	// Generate token transfer transaction
+	txMsg := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec"), sdkmath.NewIntWithDecimal(1, 18).BigInt())
+	suite.Commit()
+
+	// the profile is only added when enabled
+	res, err := suite.queryClient.TraceTx(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceTxRequest{
+		Msg: txMsg,
+	})
+	suite.Require().NoError(err)
+	suite.Require().NotContains(string(res.Data), types.OpcodeProfileKey)
+
+	res, err = suite.queryClient.TraceTx(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceTxRequest{
+		Msg:         txMsg,
+		TraceConfig: &types.TraceConfig{EnableOpcodeProfile: true},
+	})
+	suite.Require().NoError(err)
+
+	var result struct {
+		ethlogger.ExecutionResult
+		OpcodeProfile map[string]types.OpcodeStats `json:"opcodeProfile"`
+	}
+	suite.Require().NoError(json.Unmarshal(res.Data, &result))
+
+	// the profile aggregates the struct logs
+	suite.Require().NotEmpty(result.StructLogs)
+	expProfile := make(map[string]types.OpcodeStats)
+	for _, log := range result.StructLogs {
+		stats := expProfile[log.Op]
+		stats.Count++
+		stats.TotalGas += log.GasCost
+		expProfile[log.Op] = stats
+	}
+	suite.Require().Equal(expProfile, result.OpcodeProfile)
+	// a single Transfer event is emitted
+	suite.Require().Equal(uint64(1), result.OpcodeProfile[vm.LOG3.String()].Count)
+
+	// tracers whose result is not a JSON object cannot be profiled
+	_, err = suite.queryClient.TraceTx(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceTxRequest{
+		Msg: txMsg,
+		TraceConfig: &types.TraceConfig{
+			Tracer:              "{data: [], fault: function(log) {}, step: function(log) {}, result: function() { return this.data; }}",
+			EnableOpcodeProfile: true,
+		},
+	})
+	suite.Require().ErrorContains(err, "the opcode profile requires a tracer whose result is a JSON object")
+}
+
+func (suite *KeeperTestSuite) TestReplayTx() {
+	suite.SetupTest()
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	amount := sdkmath.NewIntWithDecimal(1, 18).BigInt()
+
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+	firstTx := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, amount)
+	txMsg := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, amount)
+	suite.Commit()
+
+	req := &types.QueryReplayTxRequest{
+		Msg:          txMsg,
+		Predecessors: []*types.MsgEthereumTx{firstTx},
+	}
+
+	// disabled by default
+	_, err := suite.queryClient.ReplayTx(sdk.WrapSDKContext(suite.ctx), req)
+	suite.Require().ErrorContains(err, "tx replay is disabled on this node")
+
+	suite.app.EvmKeeper.WithReplayTxEnabled(true)
+	defer suite.app.EvmKeeper.WithReplayTxEnabled(false)
+
+	_, err = suite.queryClient.ReplayTx(sdk.WrapSDKContext(suite.ctx), &types.QueryReplayTxRequest{})
+	suite.Require().ErrorContains(err, "empty request")
+
+	res, err := suite.queryClient.ReplayTx(sdk.WrapSDKContext(suite.ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Result.Failed(), "unexpected vm error: %s", res.Result.VmError)
+	suite.Require().Len(res.Result.Logs, 1, "expected transfer event")
+
+	// only the balances of the sender and the recipient in the contract storage
+	// are modified, as fees and nonce are handled by the ante handler
+	suite.Require().Len(res.StateDiff, 1)
+	diff := res.StateDiff[0]
+	suite.Require().Equal(contractAddr.Hex(), diff.Address)
+	suite.Require().Equal(diff.NonceBefore, diff.NonceAfter)
+	suite.Require().Len(diff.Storage, 2)
+	for _, slot := range diff.Storage {
+		suite.Require().NotEqual(slot.Before, slot.After)
+	}
+}
+
 func (suite *KeeperTestSuite) TestTraceBlock() {
 	var (
 		txs         []*types.MsgEthereumTx
@@ -1166,11 +1592,15 @@ func (suite *KeeperTestSuite) TestTraceBlock() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+				// each trace result starts with the hash of the traced transaction
+				hashPrefix := fmt.Sprintf("[{\"txHash\":\"%s\",", txs[0].AsTransaction().Hash().Hex())
+				suite.Require().True(strings.HasPrefix(string(res.Data), hashPrefix), "expected tx hash in trace result")
+				data := []byte("[{" + strings.TrimPrefix(string(res.Data), hashPrefix))
 				// if data is to big, slice the result
-				if len(res.Data) > 150 {
-					suite.Require().Equal(tc.traceResponse, string(res.Data[:150]))
+				if len(data) > 150 {
+					suite.Require().Equal(tc.traceResponse, string(data[:150]))
 				} else {
-					suite.Require().Equal(tc.traceResponse, string(res.Data))
+					suite.Require().Equal(tc.traceResponse, string(data))
 				}
 			} else {
 				suite.Require().Error(err)
@@ -1368,6 +1798,206 @@ func (suite *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEthCallBlockOverrides() {
+	// init code returning NUMBER, TIMESTAMP, COINBASE and BASEFEE as 32 byte words
+	data := hexutil.MustDecode("0x4360005242602052416040524860605260806000f3")
+	args, err := json.Marshal(&types.TransactionArgs{
+		From: &suite.address,
+		Data: (*hexutil.Bytes)(&data),
+	})
+	suite.Require().NoError(err)
+
+	number := big.NewInt(1_000_000)
+	blockTime := hexutil.Uint64(1_700_000_000)
+	coinbase := utiltx.GenerateAddress()
+	baseFee := big.NewInt(123_456_789)
+
+	testCases := []struct {
+		name      string
+		overrides []byte
+		expPass   bool
+	}{
+		{
+			"fail - invalid block overrides",
+			[]byte("invalid overrides"),
+			false,
+		},
+		{
+			"fail - negative block number",
+			[]byte(`{"number":"-0x1"}`),
+			false,
+		},
+		{
+			"fail - negative base fee",
+			[]byte(`{"baseFee":"-0x1"}`),
+			false,
+		},
+		{
+			"pass - block context built from the overrides",
+			func() []byte {
+				bz, err := json.Marshal(&types.BlockOverrides{
+					Number:   (*hexutil.Big)(number),
+					Time:     &blockTime,
+					Coinbase: &coinbase,
+					BaseFee:  (*hexutil.Big)(baseFee),
+				})
+				suite.Require().NoError(err)
+				return bz
+			}(),
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap, BlockOverrides: tc.overrides}
+			res, err := suite.queryClient.EthCall(suite.ctx, req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().False(res.Failed(), res.VmError)
+			suite.Require().Len(res.Ret, 128)
+			suite.Require().Equal(number, new(big.Int).SetBytes(res.Ret[:32]))
+			suite.Require().Equal(uint64(blockTime), new(big.Int).SetBytes(res.Ret[32:64]).Uint64())
+			suite.Require().Equal(coinbase, common.BytesToAddress(res.Ret[64:96]))
+			suite.Require().Equal(baseFee, new(big.Int).SetBytes(res.Ret[96:]))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEthCallStateOverrides() {
+	// runtime code returning the value stored at slot 0
+	code := hexutil.Bytes(hexutil.MustDecode("0x60005460005260206000f3"))
+	target := utiltx.GenerateAddress()
+	value := common.BigToHash(big.NewInt(42))
+	state := map[common.Hash]common.Hash{{}: value}
+
+	args, err := json.Marshal(&types.TransactionArgs{
+		From: &suite.address,
+		To:   &target,
+	})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		overrides []byte
+		expPass   bool
+	}{
+		{
+			"fail - invalid state overrides",
+			[]byte("invalid overrides"),
+			false,
+		},
+		{
+			"fail - both state and stateDiff set",
+			func() []byte {
+				bz, err := json.Marshal(&types.StateOverride{
+					target: types.OverrideAccount{Code: &code, State: &state, StateDiff: &state},
+				})
+				suite.Require().NoError(err)
+				return bz
+			}(),
+			false,
+		},
+		{
+			"pass - call executed against the overridden code and state",
+			func() []byte {
+				bz, err := json.Marshal(&types.StateOverride{
+					target: types.OverrideAccount{Code: &code, State: &state},
+				})
+				suite.Require().NoError(err)
+				return bz
+			}(),
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap, Overrides: tc.overrides}
+			res, err := suite.queryClient.EthCall(suite.ctx, req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().False(res.Failed(), res.VmError)
+			suite.Require().Equal(value.Bytes(), res.Ret)
+
+			// the overrides must not leak into the state
+			suite.Require().Empty(suite.app.EvmKeeper.GetCode(suite.ctx, crypto.Keccak256Hash(code)))
+			suite.Require().Nil(suite.app.EvmKeeper.GetAccount(suite.ctx, target))
+			suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, target, common.Hash{}))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCreateAccessList() {
+	var (
+		req          *types.EthCallRequest
+		contractAddr common.Address
+	)
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expPass      bool
+		expStorageKs int
+	}{
+		{
+			"invalid args",
+			func() {
+				req = &types.EthCallRequest{Args: []byte("invalid args"), GasCap: config.DefaultGasCap}
+			},
+			false,
+			0,
+		},
+		{
+			"success - erc20 transfer",
+			func() {
+				contractAddr = suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+				suite.Commit()
+
+				transferData, err := types.ERC20Contract.ABI.Pack("transfer", utiltx.GenerateAddress(), big.NewInt(1000))
+				suite.Require().NoError(err)
+				args, err := json.Marshal(&types.TransactionArgs{
+					From: &suite.address,
+					To:   &contractAddr,
+					Data: (*hexutil.Bytes)(&transferData),
+				})
+				suite.Require().NoError(err)
+				req = &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap}
+			},
+			true,
+			2,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			res, err := suite.queryClient.CreateAccessList(suite.ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Empty(res.VmError)
+				suite.Require().NotZero(res.GasUsed)
+				suite.Require().Len(res.AccessList, 1)
+				suite.Require().Equal(contractAddr.Hex(), res.AccessList[0].Address)
+				suite.Require().Len(res.AccessList[0].StorageKeys, tc.expStorageKs)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestEmptyRequest() {
 	k := suite.app.EvmKeeper
 
@@ -1423,6 +2053,12 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.EstimateGas(suite.ctx, nil)
 			},
 		},
+		{
+			"CreateAccessList method",
+			func() (interface{}, error) {
+				return k.CreateAccessList(suite.ctx, nil)
+			},
+		},
 		{
 			"TraceTx method",
 			func() (interface{}, error) {
@@ -0,0 +1,61 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"bytes"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// SetParentBlockHash stores the hash of the parent block in the EIP-2935 history
+// storage contract, so that contracts can access the last HistoryServeWindow block
+// hashes. The contract code is deployed on the first block after the fork.
+// It is a no-op if EIP-2935 is not activated.
+func (k *Keeper) SetParentBlockHash(ctx sdk.Context) error {
+	height := ctx.BlockHeight()
+	if height < 1 {
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+	if !params.ChainConfig.IsEIP2935(big.NewInt(height)) {
+		return nil
+	}
+
+	// NOTE: the parent block id is empty on the first block of the chain
+	parentHash := ctx.BlockHeader().LastBlockId.Hash
+	if len(parentHash) == 0 {
+		return nil
+	}
+
+	if err := k.setHistoryStorageCode(ctx); err != nil {
+		return err
+	}
+
+	slot := types.HistoryStorageSlot(uint64(height - 1))
+	k.SetState(ctx, types.HistoryStorageAddress, slot, parentHash)
+
+	return nil
+}
+
+// setHistoryStorageCode sets the EIP-2935 history storage contract code if it is
+// not set yet. The balance of the account is kept if it already exists.
+func (k *Keeper) setHistoryStorageCode(ctx sdk.Context) error {
+	codeHash := crypto.Keccak256(types.HistoryStorageCode)
+
+	account := k.GetAccountOrEmpty(ctx, types.HistoryStorageAddress)
+	if bytes.Equal(account.CodeHash, codeHash) {
+		return nil
+	}
+
+	k.SetCode(ctx, codeHash, types.HistoryStorageCode)
+
+	// NOTE: the nonce is set to 1 as for any contract created after EIP-161
+	account.Nonce = 1
+	account.CodeHash = codeHash
+	return k.SetAccount(ctx, types.HistoryStorageAddress, account)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -55,6 +56,18 @@ type Keeper struct {
 	// Tracer used to collect execution traces from the EVM transaction execution
 	tracer string
 
+	// log level at which the reverted ethereum transactions are logged
+	revertLogLevel string
+
+	// enables the ReplayTx gRPC query
+	replayTxEnabled bool
+
+	// observers notified of the state changes of the ethereum transactions
+	observers []types.EVMObserver
+
+	// feegrantKeeper gives back the refunded fees to the fee grant allowances
+	feegrantKeeper types.FeegrantKeeper
+
 	// Legacy subspace
 	ss paramstypes.Subspace
 
@@ -186,6 +199,30 @@ func (k Keeper) GetTxIndexTransient(ctx sdk.Context) uint64 {
 	return sdk.BigEndianToUint64(bz)
 }
 
+// SetTxFeePayerTransient sets the account paying the fees of the processing
+// transaction through a fee grant. An empty address removes the fee payer, so
+// that the fees are paid by the sender.
+func (k Keeper) SetTxFeePayerTransient(ctx sdk.Context, feePayer sdk.AccAddress) {
+	store := ctx.TransientStore(k.transientKey)
+	if feePayer.Empty() {
+		store.Delete(types.KeyPrefixTransientFeePayer)
+		return
+	}
+	store.Set(types.KeyPrefixTransientFeePayer, feePayer)
+}
+
+// GetTxFeePayerTransient returns the account paying the fees of the processing
+// transaction through a fee grant, or nil if the fees are paid by the sender.
+func (k Keeper) GetTxFeePayerTransient(ctx sdk.Context) sdk.AccAddress {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientFeePayer)
+	if len(bz) == 0 {
+		return nil
+	}
+
+	return sdk.AccAddress(bz)
+}
+
 // ----------------------------------------------------------------------------
 // Log
 // ----------------------------------------------------------------------------
@@ -208,6 +245,104 @@ func (k Keeper) SetLogSizeTransient(ctx sdk.Context, logSize uint64) {
 	store.Set(types.KeyPrefixTransientLogSize, sdk.Uint64ToBigEndian(logSize))
 }
 
+// ----------------------------------------------------------------------------
+// Precompile lock
+// ----------------------------------------------------------------------------
+
+// IsPrecompileLocked returns true if the precompile with the given address is
+// executing a non-reentrant method.
+func (k Keeper) IsPrecompileLocked(ctx sdk.Context, address common.Address) bool {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientPrecompileLock)
+	return store.Has(address.Bytes())
+}
+
+// LockPrecompile sets the lock of the precompile with the given address on the
+// transient store. It is released with UnlockPrecompile once the method returns.
+func (k Keeper) LockPrecompile(ctx sdk.Context, address common.Address) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientPrecompileLock)
+	store.Set(address.Bytes(), []byte{1})
+}
+
+// UnlockPrecompile removes the lock of the precompile with the given address
+// from the transient store.
+func (k Keeper) UnlockPrecompile(ctx sdk.Context, address common.Address) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientPrecompileLock)
+	store.Delete(address.Bytes())
+}
+
+// ----------------------------------------------------------------------------
+// Free precompile reads
+// ----------------------------------------------------------------------------
+
+// GetFreePrecompileReads returns the number of view-only precompile calls made
+// without gas charge by the given account in the current block.
+func (k Keeper) GetFreePrecompileReads(ctx sdk.Context, account common.Address) uint64 {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFreePrecompileReads)
+	bz := store.Get(account.Bytes())
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IncrementFreePrecompileReads increments the number of view-only precompile
+// calls made without gas charge by the given account in the current block. The
+// count is reset at the end of the block with the transient store.
+func (k Keeper) IncrementFreePrecompileReads(ctx sdk.Context, account common.Address) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFreePrecompileReads)
+	reads := k.GetFreePrecompileReads(ctx, account)
+	store.Set(account.Bytes(), sdk.Uint64ToBigEndian(reads+1))
+}
+
+// ----------------------------------------------------------------------------
+// Pending txs
+// ----------------------------------------------------------------------------
+
+// GetPendingTxGasPrice returns the effective gas price of the tx with the given
+// sender and nonce accepted in CheckTx since the last commit. It returns nil if
+// there is no such tx.
+func (k Keeper) GetPendingTxGasPrice(ctx sdk.Context, sender common.Address, nonce uint64) *big.Int {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientPendingTxGasPrice)
+	bz := store.Get(types.PendingTxKey(sender, nonce))
+	if len(bz) == 0 {
+		return nil
+	}
+
+	return new(big.Int).SetBytes(bz)
+}
+
+// SetPendingTxGasPrice sets the effective gas price of the tx with the given
+// sender and nonce accepted in CheckTx. This value is reset on every block.
+func (k Keeper) SetPendingTxGasPrice(ctx sdk.Context, sender common.Address, nonce uint64, gasPrice *big.Int) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientPendingTxGasPrice)
+	store.Set(types.PendingTxKey(sender, nonce), gasPrice.Bytes())
+}
+
+// GetPendingTxFee returns the account that paid the fees of the tx with the
+// given sender and nonce accepted in CheckTx since the last commit, and the
+// amount paid in the EVM denomination. It returns a nil fee if there is no such tx.
+func (k Keeper) GetPendingTxFee(ctx sdk.Context, sender common.Address, nonce uint64) (sdk.AccAddress, *big.Int) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientPendingTxFee)
+	bz := store.Get(types.PendingTxKey(sender, nonce))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	// the value is the length prefixed fee payer followed by the fee amount
+	payerLen := int(bz[0])
+	payer := sdk.AccAddress(bz[1 : 1+payerLen])
+	return payer, new(big.Int).SetBytes(bz[1+payerLen:])
+}
+
+// SetPendingTxFee sets the account that paid the fees of the tx with the given
+// sender and nonce accepted in CheckTx, and the amount paid in the EVM
+// denomination. This value is reset on every block.
+func (k Keeper) SetPendingTxFee(ctx sdk.Context, sender common.Address, nonce uint64, payer sdk.AccAddress, fee *big.Int) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientPendingTxFee)
+	store.Set(types.PendingTxKey(sender, nonce), append(address.MustLengthPrefix(payer), fee.Bytes()...))
+}
+
 // ----------------------------------------------------------------------------
 // Storage
 // ----------------------------------------------------------------------------
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	_ "embed"
 	"math/big"
 
@@ -172,3 +173,56 @@ func (suite *KeeperTestSuite) TestGetAccountOrEmpty() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestExportGethGenesis() {
+	suite.SetupTest()
+
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(100))
+	missing := common.HexToAddress("0x3000000000000000000000000000000000000003")
+
+	testCases := []struct {
+		name         string
+		addresses    []common.Address
+		expAddresses []common.Address
+	}{
+		{
+			"pass - filtered addresses, ignoring missing accounts",
+			[]common.Address{contractAddr, missing},
+			[]common.Address{contractAddr},
+		},
+		{
+			"pass - all accounts",
+			nil,
+			[]common.Address{suite.address, contractAddr},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			var buf bytes.Buffer
+			err := suite.app.EvmKeeper.ExportGethGenesis(suite.ctx, &buf, tc.addresses)
+			suite.Require().NoError(err)
+
+			alloc, err := evmtypes.ParseGethGenesisAlloc(buf.Bytes())
+			suite.Require().NoError(err)
+
+			for _, address := range tc.expAddresses {
+				suite.Require().Contains(alloc, address)
+			}
+			suite.Require().NotContains(alloc, missing)
+
+			account, ok := alloc[contractAddr]
+			if !ok {
+				return
+			}
+			acc := suite.app.EvmKeeper.GetAccount(suite.ctx, contractAddr)
+			suite.Require().Zero(acc.Balance.Cmp(account.Balance))
+			suite.Require().Equal(acc.Nonce, account.Nonce)
+			suite.Require().Equal(suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(acc.CodeHash)), account.Code)
+			suite.Require().Len(account.Storage, len(suite.app.EvmKeeper.GetAccountStorage(suite.ctx, contractAddr)))
+			for key, value := range account.Storage {
+				suite.Require().Equal(suite.app.EvmKeeper.GetState(suite.ctx, contractAddr, key), value)
+			}
+		})
+	}
+}
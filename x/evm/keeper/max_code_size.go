@@ -0,0 +1,57 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var _ vm.Interpreter = maxCodeSizeInterpreter{}
+
+// maxCodeSizeInterpreter wraps the EVM interpreter to enforce the MaxCodeSize
+// param on every contract creation, including the CREATE and CREATE2 opcodes
+// executed by contracts. The EVM only enforces the EIP-170 limit, so the param
+// can only lower it.
+type maxCodeSizeInterpreter struct {
+	vm.Interpreter
+	evm         *vm.EVM
+	maxCodeSize uint64
+}
+
+// withMaxCodeSize sets the interpreter of the given EVM to enforce the given
+// maximum contract code size, if it is lower than the EIP-170 limit.
+//
+// NOTE: params stored before the MaxCodeSize param was added leave it unset.
+func withMaxCodeSize(evm *vm.EVM, maxCodeSize uint64) *vm.EVM {
+	if maxCodeSize == 0 || maxCodeSize >= params.MaxCodeSize {
+		return evm
+	}
+
+	evm.WithInterpreter(maxCodeSizeInterpreter{
+		Interpreter: evm.Interpreter(),
+		evm:         evm,
+		maxCodeSize: maxCodeSize,
+	})
+	return evm
+}
+
+// Run runs the contract code and fails with ErrMaxCodeSizeExceeded if it is the
+// init code of a contract being created that returns a code above the limit.
+// The EVM then reverts the creation and consumes all its gas, as it does for
+// the EIP-170 limit.
+func (i maxCodeSizeInterpreter) Run(contract *vm.Contract, input []byte, readOnly bool) ([]byte, error) {
+	ret, err := i.Interpreter.Run(contract, input, readOnly)
+	if err != nil || uint64(len(ret)) <= i.maxCodeSize {
+		return ret, err
+	}
+
+	// The code is only executed from an account without code when it is the
+	// init code of a contract being created, since the EVM skips the calls to
+	// accounts without code and rejects the creations on accounts with code.
+	if contract.CodeAddr != nil && i.evm.StateDB.GetCodeSize(*contract.CodeAddr) == 0 {
+		return ret, vm.ErrMaxCodeSizeExceeded
+	}
+
+	return ret, nil
+}
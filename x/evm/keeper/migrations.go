@@ -7,6 +7,7 @@ import (
 	v4 "github.com/evmos/evmos/v16/x/evm/migrations/v4"
 	v5 "github.com/evmos/evmos/v16/x/evm/migrations/v5"
 	v6 "github.com/evmos/evmos/v16/x/evm/migrations/v6"
+	v7 "github.com/evmos/evmos/v16/x/evm/migrations/v7"
 	"github.com/evmos/evmos/v16/x/evm/types"
 )
 
@@ -38,3 +39,8 @@ func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 func (m Migrator) Migrate5to6(ctx sdk.Context) error {
 	return v6.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate6to7 migrates the store from consensus version 6 to 7
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
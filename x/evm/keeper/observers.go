@@ -0,0 +1,55 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// WithObservers registers the given observers, which are notified of the
+// state changes of the successful ethereum transactions, in the given order.
+func (k *Keeper) WithObservers(observers ...types.EVMObserver) *Keeper {
+	k.observers = append(k.observers, observers...)
+	return k
+}
+
+// notifyObservers notifies the registered observers of the state changes of
+// an ethereum transaction. Each observer runs on a cache context, which is
+// discarded if the observer panics.
+func (k Keeper) notifyObservers(ctx sdk.Context, msg core.Message, touched []common.Address, logs []*ethtypes.Log) {
+	for i, observer := range k.observers {
+		if err := k.notifyObserver(ctx, observer, msg, touched, logs); err != nil {
+			k.Logger(ctx).Error("evm observer failed", "index", i, "error", err.Error())
+		}
+	}
+}
+
+// notifyObserver notifies a single observer and commits its state changes. It
+// returns an error if the observer panics.
+func (k Keeper) notifyObserver(
+	ctx sdk.Context,
+	observer types.EVMObserver,
+	msg core.Message,
+	touched []common.Address,
+	logs []*ethtypes.Log,
+) (err error) {
+	// NOTE: a panic in an observer must not halt the chain
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("observer panicked: %v", r)
+		}
+	}()
+
+	cacheCtx, commit := ctx.CacheContext()
+	observer.AfterMessageApplied(cacheCtx, msg, touched, logs)
+
+	commit()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
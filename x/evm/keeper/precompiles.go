@@ -17,6 +17,7 @@ import (
 	"golang.org/x/exp/maps"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
@@ -24,15 +25,19 @@ import (
 	channelkeeper "github.com/cosmos/ibc-go/v7/modules/core/04-channel/keeper"
 	bankprecompile "github.com/evmos/evmos/v16/precompiles/bank"
 	distprecompile "github.com/evmos/evmos/v16/precompiles/distribution"
+	epochsprecompile "github.com/evmos/evmos/v16/precompiles/epochs"
 	erc20precompile "github.com/evmos/evmos/v16/precompiles/erc20"
 	ics20precompile "github.com/evmos/evmos/v16/precompiles/ics20"
+	inflationprecompile "github.com/evmos/evmos/v16/precompiles/inflation"
 	osmosisoutpost "github.com/evmos/evmos/v16/precompiles/outposts/osmosis"
 	strideoutpost "github.com/evmos/evmos/v16/precompiles/outposts/stride"
 	"github.com/evmos/evmos/v16/precompiles/p256"
 	stakingprecompile "github.com/evmos/evmos/v16/precompiles/staking"
 	vestingprecompile "github.com/evmos/evmos/v16/precompiles/vesting"
+	epochskeeper "github.com/evmos/evmos/v16/x/epochs/keeper"
 	erc20Keeper "github.com/evmos/evmos/v16/x/erc20/keeper"
 	transferkeeper "github.com/evmos/evmos/v16/x/ibc/transfer/keeper"
+	inflationkeeper "github.com/evmos/evmos/v16/x/inflation/v1/keeper"
 	vestingkeeper "github.com/evmos/evmos/v16/x/vesting/keeper"
 )
 
@@ -42,18 +47,22 @@ func AvailablePrecompiles(
 	chainID string,
 	stakingKeeper stakingkeeper.Keeper,
 	distributionKeeper distributionkeeper.Keeper,
+	accountKeeper authkeeper.AccountKeeper,
 	bankKeeper bankkeeper.Keeper,
 	erc20Keeper erc20Keeper.Keeper,
 	vestingKeeper vestingkeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
 	channelKeeper channelkeeper.Keeper,
+	epochsKeeper epochskeeper.Keeper,
+	inflationKeeper inflationkeeper.Keeper,
 ) map[common.Address]vm.PrecompiledContract {
 	// Clone the mapping from the latest EVM fork.
 	precompiles := maps.Clone(vm.PrecompiledContractsBerlin)
 
 	// secp256r1 precompile as per EIP-7212
 	p256Precompile := &p256.Precompile{}
+	p256BatchPrecompile := &p256.BatchPrecompile{}
 
 	bech32Precompile, err := bech32.NewPrecompile(6000)
 	if err != nil {
@@ -80,11 +89,21 @@ func AvailablePrecompiles(
 		panic(fmt.Errorf("failed to instantiate vesting precompile: %w", err))
 	}
 
-	bankPrecompile, err := bankprecompile.NewPrecompile(bankKeeper, erc20Keeper)
+	bankPrecompile, err := bankprecompile.NewPrecompile(accountKeeper, bankKeeper, erc20Keeper, authzKeeper)
 	if err != nil {
 		panic(fmt.Errorf("failed to instantiate bank precompile: %w", err))
 	}
 
+	epochsPrecompile, err := epochsprecompile.NewPrecompile(epochsKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate epochs precompile: %w", err))
+	}
+
+	inflationPrecompile, err := inflationprecompile.NewPrecompile(inflationKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate inflation precompile: %w", err))
+	}
+
 	var WEVMOSAddress common.Address
 	if utils.IsMainnet(chainID) {
 		WEVMOSAddress = common.HexToAddress(erc20precompile.WEVMOSContractMainnet)
@@ -119,6 +138,7 @@ func AvailablePrecompiles(
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
+	precompiles[p256BatchPrecompile.Address()] = p256BatchPrecompile
 
 	// Stateful precompiles
 	precompiles[stakingPrecompile.Address()] = stakingPrecompile
@@ -126,6 +146,8 @@ func AvailablePrecompiles(
 	precompiles[vestingPrecompile.Address()] = vestingPrecompile
 	precompiles[ibcTransferPrecompile.Address()] = ibcTransferPrecompile
 	precompiles[bankPrecompile.Address()] = bankPrecompile
+	precompiles[epochsPrecompile.Address()] = epochsPrecompile
+	precompiles[inflationPrecompile.Address()] = inflationPrecompile
 
 	// Outposts
 	precompiles[strideOutpost.Address()] = strideOutpost
@@ -0,0 +1,205 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	evmostypes "github.com/evmos/evmos/v16/types"
+	evmante "github.com/evmos/evmos/v16/x/evm/ante"
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+	"github.com/evmos/evmos/v16/x/evm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WithReplayTxEnabled enables the ReplayTx gRPC query, which is disabled by
+// default to keep it unavailable on public endpoints.
+func (k *Keeper) WithReplayTxEnabled(enabled bool) *Keeper {
+	k.replayTxEnabled = enabled
+	return k
+}
+
+// ReplayTx re-executes the given message on top of the state at the beginning
+// of its block and of its predecessors, and returns the execution result
+// together with the accounts and storage slots modified by the execution.
+//
+// NOTE: the fee deduction and the nonce increment of message calls are
+// performed by the ante handler, so they are not part of the state diff.
+func (k Keeper) ReplayTx(c context.Context, req *types.QueryReplayTxRequest) (*types.QueryReplayTxResponse, error) {
+	if req == nil || req.Msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !k.replayTxEnabled {
+		return nil, status.Error(codes.Unavailable, "tx replay is disabled on this node")
+	}
+
+	ctx, cfg, err := k.prepareBlockContext(
+		sdk.UnwrapSDKContext(c),
+		req.BlockNumber,
+		req.BlockTime,
+		req.BlockHash,
+		req.BlockMaxGas,
+		req.ChainId,
+		req.ProposerAddress,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
+	ctx, txConfig = k.applyPredecessors(ctx, cfg, signer, txConfig, req.Predecessors)
+
+	tx := req.Msg.AsTransaction()
+	txConfig.TxHash = tx.Hash()
+	if len(req.Predecessors) > 0 {
+		txConfig.TxIndex++
+	}
+
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// execute the message on a cache context to keep the state prior to the
+	// execution available for the diff
+	replayCtx, _ := ctx.CacheContext()
+	replayCtx = evmante.BuildEvmExecutionCtx(replayCtx).
+		WithGasMeter(evmostypes.NewInfiniteGasMeterWithLimit(msg.Gas()))
+
+	tracer := newStateDiffTracer()
+	res, err := k.ApplyMessageWithConfig(replayCtx, msg, tracer, true, cfg, txConfig)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryReplayTxResponse{
+		Result:    res,
+		StateDiff: k.stateDiff(ctx, replayCtx, tracer),
+	}, nil
+}
+
+// stateDiff returns the changes of the accounts and storage slots touched by
+// the tracer between the before and after contexts, sorted by address and key.
+func (k *Keeper) stateDiff(before, after sdk.Context, tracer *stateDiffTracer) []types.AccountDiff {
+	addresses := make([]common.Address, 0, len(tracer.touched))
+	for address := range tracer.touched {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	diffs := make([]types.AccountDiff, 0, len(addresses))
+	for _, address := range addresses {
+		accBefore := k.GetAccountOrEmpty(before, address)
+		accAfter := k.GetAccountOrEmpty(after, address)
+
+		keys := make([]common.Hash, 0, len(tracer.touched[address]))
+		for key := range tracer.touched[address] {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return bytes.Compare(keys[i].Bytes(), keys[j].Bytes()) < 0
+		})
+
+		var storage []types.StorageDiff
+		for _, key := range keys {
+			valueBefore := k.GetState(before, address, key)
+			valueAfter := k.GetState(after, address, key)
+			if valueBefore == valueAfter {
+				continue
+			}
+			storage = append(storage, types.StorageDiff{
+				Key:    key.Hex(),
+				Before: valueBefore.Hex(),
+				After:  valueAfter.Hex(),
+			})
+		}
+
+		if len(storage) == 0 &&
+			accBefore.Nonce == accAfter.Nonce &&
+			accBefore.Balance.Cmp(accAfter.Balance) == 0 &&
+			bytes.Equal(accBefore.CodeHash, accAfter.CodeHash) {
+			continue
+		}
+
+		diffs = append(diffs, types.AccountDiff{
+			Address:        address.Hex(),
+			BalanceBefore:  accBefore.Balance.String(),
+			BalanceAfter:   accAfter.Balance.String(),
+			NonceBefore:    accBefore.Nonce,
+			NonceAfter:     accAfter.Nonce,
+			CodeHashBefore: common.BytesToHash(accBefore.CodeHash).Hex(),
+			CodeHashAfter:  common.BytesToHash(accAfter.CodeHash).Hex(),
+			Storage:        storage,
+		})
+	}
+
+	return diffs
+}
+
+var _ vm.EVMLogger = &stateDiffTracer{}
+
+// stateDiffTracer is a vm.EVMLogger that records the accounts and storage
+// slots touched during the execution of a transaction.
+type stateDiffTracer struct {
+	types.NoOpTracer
+
+	touched map[common.Address]map[common.Hash]struct{}
+}
+
+// newStateDiffTracer creates a new stateDiffTracer
+func newStateDiffTracer() *stateDiffTracer {
+	return &stateDiffTracer{
+		touched: make(map[common.Address]map[common.Hash]struct{}),
+	}
+}
+
+// touch records the given account as touched
+func (t *stateDiffTracer) touch(address common.Address) map[common.Hash]struct{} {
+	slots, ok := t.touched[address]
+	if !ok {
+		slots = make(map[common.Hash]struct{})
+		t.touched[address] = slots
+	}
+	return slots
+}
+
+// CaptureStart implements vm.EVMLogger interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *stateDiffTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.touch(from)
+	t.touch(to)
+}
+
+// CaptureState implements vm.EVMLogger interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *stateDiffTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if op != vm.SSTORE || scope.Stack.Len() < 1 {
+		return
+	}
+
+	slots := t.touch(scope.Contract.Address())
+	slots[common.Hash(scope.Stack.Back(0).Bytes32())] = struct{}{}
+}
+
+// CaptureEnter implements vm.EVMLogger interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *stateDiffTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	t.touch(from)
+	t.touch(to)
+}
@@ -0,0 +1,138 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"math/big"
+	"time"
+
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// WithRevertLogLevel sets the log level at which the reverted ethereum
+// transactions are logged. An empty level or types.RevertLogLevelNone disables
+// the logging. The logging is also disabled if the given node log level
+// filters out the level for the evm module, so that the reverted transactions
+// are not traced for nothing.
+func (k *Keeper) WithRevertLogLevel(level, nodeLogLevel string) *Keeper {
+	switch level {
+	case "", types.RevertLogLevelNone, types.RevertLogLevelDebug, types.RevertLogLevelInfo, types.RevertLogLevelError:
+	default:
+		panic("invalid revert log level " + level)
+	}
+
+	if level != "" && level != types.RevertLogLevelNone && nodeLogLevel != "" {
+		// the node logger rejects invalid log levels on start
+		if filter, err := log.ParseLogLevel(nodeLogLevel); err == nil && filter(types.ModuleName, level) {
+			level = types.RevertLogLevelNone
+		}
+	}
+
+	k.revertLogLevel = level
+	return k
+}
+
+// revertLogEnabled returns true if the reverted ethereum transactions are
+// logged.
+func (k Keeper) revertLogEnabled() bool {
+	return k.revertLogLevel != "" && k.revertLogLevel != types.RevertLogLevelNone
+}
+
+// logRevert logs the details of a reverted ethereum transaction at the
+// configured revert log level. The call depth is only logged when it was
+// recorded by the given tracer.
+func (k Keeper) logRevert(
+	ctx sdk.Context,
+	msg core.Message,
+	res *types.MsgEthereumTxResponse,
+	tracer *revertDepthTracer,
+) {
+	if !k.revertLogEnabled() {
+		return
+	}
+
+	to := "contract creation"
+	if msg.To() != nil {
+		to = msg.To().Hex()
+	}
+
+	keyvals := []interface{}{
+		"tx_hash", res.Hash,
+		"sender", msg.From().Hex(),
+		"to", to,
+		"gas_used", res.GasUsed,
+		"error", res.VmError,
+	}
+
+	if res.VmError == vm.ErrExecutionReverted.Error() {
+		if reason, err := types.UnpackRevertReason(res.Ret); err == nil {
+			keyvals = append(keyvals, "reason", reason)
+		}
+	}
+
+	if tracer != nil && tracer.revertDepth >= 0 {
+		keyvals = append(keyvals, "depth", tracer.revertDepth)
+	}
+
+	logger := k.Logger(ctx)
+	switch k.revertLogLevel {
+	case types.RevertLogLevelDebug:
+		logger.Debug("ethereum tx reverted", keyvals...)
+	case types.RevertLogLevelInfo:
+		logger.Info("ethereum tx reverted", keyvals...)
+	case types.RevertLogLevelError:
+		logger.Error("ethereum tx reverted", keyvals...)
+	}
+}
+
+var _ vm.EVMLogger = &revertDepthTracer{}
+
+// revertDepthTracer is a vm.EVMLogger that records the call depth at which
+// the execution of a transaction failed. Calls that fail and whose failure is
+// handled by their caller are not taken into account.
+type revertDepthTracer struct {
+	types.NoOpTracer
+
+	depth       int
+	revertDepth int
+}
+
+// newRevertDepthTracer creates a new revertDepthTracer
+func newRevertDepthTracer() *revertDepthTracer {
+	return &revertDepthTracer{revertDepth: -1}
+}
+
+// CaptureEnter implements vm.EVMLogger interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *revertDepthTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	t.depth++
+}
+
+// CaptureExit implements vm.EVMLogger interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *revertDepthTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	switch {
+	case err != nil && t.revertDepth < 0:
+		t.revertDepth = t.depth
+	case err == nil && t.revertDepth > t.depth:
+		// the failure of a nested call was handled by the exiting call
+		t.revertDepth = -1
+	}
+	t.depth--
+}
+
+// CaptureEnd implements vm.EVMLogger interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *revertDepthTracer) CaptureEnd(output []byte, gasUsed uint64, tm time.Duration, err error) {
+	if err != nil && t.revertDepth < 0 {
+		t.revertDepth = 0
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
 	evmostypes "github.com/evmos/evmos/v16/types"
 	"github.com/evmos/evmos/v16/x/evm/statedb"
 	"github.com/evmos/evmos/v16/x/evm/types"
@@ -58,7 +59,8 @@ func (k *Keeper) NewEVM(
 		tracer = k.Tracer(ctx, msg, cfg.ChainConfig)
 	}
 	vmConfig := k.VMConfig(ctx, msg, cfg, tracer)
-	return vm.NewEVM(blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
+	evm := vm.NewEVM(blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
+	return withMaxCodeSize(evm, cfg.Params.MaxCodeSize)
 }
 
 // GetHashFn implements vm.GetHashFunc for Ethermint. It handles 3 cases:
@@ -155,8 +157,19 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	// thus restricted to be used only inside `ApplyMessage`.
 	tmpCtx, commit := ctx.CacheContext()
 
+	// record the call depth of reverted txs to be logged, unless the
+	// execution is traced by the node
+	var (
+		tracer       vm.EVMLogger
+		revertTracer *revertDepthTracer
+	)
+	if k.tracer == "" && k.revertLogEnabled() {
+		revertTracer = newRevertDepthTracer()
+		tracer = revertTracer
+	}
+
 	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
+	res, touched, err := k.applyMessageWithConfig(tmpCtx, msg, tracer, true, cfg, txConfig)
 	if err != nil {
 		// when a transaction contains multiple msg, as long as one of the msg fails
 		// all gas will be deducted. so is not msg.Gas()
@@ -175,6 +188,9 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	if !res.Failed() {
 		commit()
 		ctx.EventManager().EmitEvents(tmpCtx.EventManager().Events())
+		k.notifyObservers(ctx, msg, touched, logs)
+	} else {
+		k.logRevert(ctx, msg, res, revertTracer)
 	}
 
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
@@ -257,6 +273,20 @@ func (k *Keeper) ApplyMessageWithConfig(
 	cfg *statedb.EVMConfig,
 	txConfig statedb.TxConfig,
 ) (*types.MsgEthereumTxResponse, error) {
+	res, _, err := k.applyMessageWithConfig(ctx, msg, tracer, commit, cfg, txConfig)
+	return res, err
+}
+
+// applyMessageWithConfig implements ApplyMessageWithConfig. It also returns
+// the addresses of the accounts modified by the message, sorted.
+func (k *Keeper) applyMessageWithConfig(
+	ctx sdk.Context,
+	msg core.Message,
+	tracer vm.EVMLogger,
+	commit bool,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+) (*types.MsgEthereumTxResponse, []common.Address, error) {
 	var (
 		ret   []byte // return bytes from evm execution
 		vmErr error  // vm errors do not effect consensus and are therefore not assigned to err
@@ -264,9 +294,9 @@ func (k *Keeper) ApplyMessageWithConfig(
 
 	// return error if contract creation or call are disabled through governance
 	if !cfg.Params.EnableCreate && msg.To() == nil {
-		return nil, errorsmod.Wrap(types.ErrCreateDisabled, "failed to create new contract")
+		return nil, nil, errorsmod.Wrap(types.ErrCreateDisabled, "failed to create new contract")
 	} else if !cfg.Params.EnableCall && msg.To() != nil {
-		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
+		return nil, nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
 	}
 
 	stateDB := statedb.New(ctx, k, txConfig)
@@ -289,13 +319,33 @@ func (k *Keeper) ApplyMessageWithConfig(
 		if toAddr != nil &&
 			slices.Contains(types.AvailableEVMExtensions, toAddr.String()) &&
 			!slices.Contains(activePrecompiles, *toAddr) {
-			return nil, errorsmod.Wrap(types.ErrInactivePrecompile, "failed to call precompile")
+			return nil, nil, errorsmod.Wrap(types.ErrInactivePrecompile, "failed to call precompile")
 		}
 
 		// NOTE: this only adds active precompiles to the EVM.
 		// This means that evm.Precompile(addr) will return false for inactive precompiles
 		// even though this is actually a reserved address.
 		precompileMap := k.Precompiles(activePrecompiles...)
+
+		// charge the base gas costs overridden through the params, if any
+		for address, costs := range cfg.Params.GetPrecompileGasCostsMap() {
+			if precompile, ok := precompileMap[address]; ok {
+				precompileMap[address] = cmn.NewGasCostsPrecompile(precompile, costs)
+			}
+		}
+
+		// waive the base gas cost of the view-only calls within the free reads allowance.
+		// The allowance is only used by the committed messages, so that eth_call and
+		// eth_estimateGas charge the base gas costs, which bounds the gas used in the
+		// block, instead of depending on the calls made in the query context.
+		if commit && cfg.Params.FreePrecompileReads > 0 {
+			for _, address := range customPrecompiles {
+				methods, ok := k.precompiles[address].(cmn.MethodsChecker)
+				if precompile, found := precompileMap[address]; found && ok {
+					precompileMap[address] = cmn.NewFreeReadsPrecompile(precompile, methods, cfg.Params.FreePrecompileReads)
+				}
+			}
+		}
 		evm.WithPrecompiles(precompileMap, activePrecompiles)
 	}
 
@@ -312,18 +362,17 @@ func (k *Keeper) ApplyMessageWithConfig(
 
 	sender := vm.AccountRef(msg.From())
 	contractCreation := msg.To() == nil
-	isLondon := cfg.ChainConfig.IsLondon(evm.Context.BlockNumber)
 
 	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, contractCreation)
 	if err != nil {
 		// should have already been checked on Ante Handler
-		return nil, errorsmod.Wrap(err, "intrinsic gas failed")
+		return nil, nil, errorsmod.Wrap(err, "intrinsic gas failed")
 	}
 
 	// Should check again even if it is checked on Ante Handler, because eth_call don't go through Ante Handler.
 	if leftoverGas < intrinsicGas {
 		// eth_estimateGas will check for this exact error
-		return nil, errorsmod.Wrap(core.ErrIntrinsicGas, "apply message")
+		return nil, nil, errorsmod.Wrap(core.ErrIntrinsicGas, "apply message")
 	}
 	leftoverGas -= intrinsicGas
 
@@ -347,13 +396,13 @@ func (k *Keeper) ApplyMessageWithConfig(
 	refundQuotient := params.RefundQuotient
 
 	// After EIP-3529: refunds are capped to gasUsed / 5
-	if isLondon {
+	if cfg.Params.EnableEIP3529 {
 		refundQuotient = params.RefundQuotientEIP3529
 	}
 
 	// calculate gas refund
 	if msg.Gas() < leftoverGas {
-		return nil, errorsmod.Wrap(types.ErrGasOverflow, "apply message")
+		return nil, nil, errorsmod.Wrap(types.ErrGasOverflow, "apply message")
 	}
 	// refund gas
 	temporaryGasUsed := msg.Gas() - leftoverGas
@@ -372,7 +421,7 @@ func (k *Keeper) ApplyMessageWithConfig(
 	// The dirty states in `StateDB` is either committed or discarded after return
 	if commit {
 		if err := stateDB.Commit(); err != nil {
-			return nil, errorsmod.Wrap(err, "failed to commit stateDB")
+			return nil, nil, errorsmod.Wrap(err, "failed to commit stateDB")
 		}
 	}
 
@@ -384,11 +433,11 @@ func (k *Keeper) ApplyMessageWithConfig(
 	minimumGasUsed := gasLimit.Mul(minGasMultiplier)
 
 	if !minimumGasUsed.TruncateInt().IsUint64() {
-		return nil, errorsmod.Wrapf(types.ErrGasOverflow, "minimumGasUsed(%s) is not a uint64", minimumGasUsed.TruncateInt().String())
+		return nil, nil, errorsmod.Wrapf(types.ErrGasOverflow, "minimumGasUsed(%s) is not a uint64", minimumGasUsed.TruncateInt().String())
 	}
 
 	if msg.Gas() < leftoverGas {
-		return nil, errorsmod.Wrapf(types.ErrGasOverflow, "message gas limit < leftover gas (%d < %d)", msg.Gas(), leftoverGas)
+		return nil, nil, errorsmod.Wrapf(types.ErrGasOverflow, "message gas limit < leftover gas (%d < %d)", msg.Gas(), leftoverGas)
 	}
 
 	gasUsed := math.LegacyMaxDec(minimumGasUsed, math.LegacyNewDec(int64(temporaryGasUsed))).TruncateInt().Uint64()
@@ -401,5 +450,5 @@ func (k *Keeper) ApplyMessageWithConfig(
 		Ret:     ret,
 		Logs:    types.NewLogsFromEth(stateDB.Logs()),
 		Hash:    txConfig.TxHash.Hex(),
-	}, nil
+	}, stateDB.DirtyAccounts(), nil
 }
@@ -1,22 +1,33 @@
 package keeper_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/libs/log"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v16/precompiles/bech32"
+	"github.com/evmos/evmos/v16/precompiles/staking"
+	"github.com/evmos/evmos/v16/server/config"
 	utiltx "github.com/evmos/evmos/v16/testutil/tx"
 	"github.com/evmos/evmos/v16/x/evm/keeper"
 	"github.com/evmos/evmos/v16/x/evm/statedb"
@@ -459,6 +470,89 @@ func (suite *KeeperTestSuite) TestRefundGas() {
 	suite.mintFeeCollector = false
 }
 
+func (suite *KeeperTestSuite) TestRefundGasFeeAllowance() {
+	granter := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	grantee := sdk.AccAddress(suite.address.Bytes())
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(types.DefaultEVMDenom, amount))
+	}
+
+	testCases := []struct {
+		name         string
+		allowance    feegrant.FeeAllowanceI
+		expAllowance feegrant.FeeAllowanceI
+	}{
+		{
+			name:         "basic allowance",
+			allowance:    &feegrant.BasicAllowance{SpendLimit: coins(10_000)},
+			expAllowance: &feegrant.BasicAllowance{SpendLimit: coins(11_000)},
+		},
+		{
+			name:         "basic allowance without spend limit",
+			allowance:    &feegrant.BasicAllowance{},
+			expAllowance: &feegrant.BasicAllowance{},
+		},
+		{
+			name: "periodic allowance",
+			allowance: &feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: coins(10_000)},
+				Period:           time.Hour,
+				PeriodSpendLimit: coins(5_000),
+				PeriodCanSpend:   coins(100),
+			},
+			expAllowance: &feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: coins(11_000)},
+				Period:           time.Hour,
+				PeriodSpendLimit: coins(5_000),
+				PeriodCanSpend:   coins(1_100),
+			},
+		},
+		{
+			name: "refund exceeding a spent periodic allowance is capped to the period limit",
+			allowance: &feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: coins(10_000)},
+				Period:           time.Hour,
+				PeriodSpendLimit: coins(500),
+			},
+			expAllowance: &feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: coins(11_000)},
+				Period:           time.Hour,
+				PeriodSpendLimit: coins(500),
+				PeriodCanSpend:   coins(500),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest() // reset
+
+			err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter, grantee, tc.allowance)
+			suite.Require().NoError(err)
+			suite.app.EvmKeeper.SetTxFeePayerTransient(suite.ctx, granter)
+
+			// the leftover gas is refunded at a gas price of 1
+			m := ethtypes.NewMessage(
+				suite.address, &suite.address, 0, big.NewInt(0), params.TxGas,
+				big.NewInt(1), big.NewInt(0), big.NewInt(0), nil, nil, false,
+			)
+			err = suite.app.EvmKeeper.RefundGas(suite.ctx, m, 1_000, types.DefaultEVMDenom)
+			suite.Require().NoError(err)
+
+			allowance, err := suite.app.FeeGrantKeeper.GetAllowance(suite.ctx, granter, grantee)
+			suite.Require().NoError(err)
+			if periodic, ok := allowance.(*feegrant.PeriodicAllowance); ok {
+				// the period reset is set on grant
+				periodic.PeriodReset = time.Time{}
+			}
+			suite.Require().Equal(tc.expAllowance, allowance)
+			suite.Require().Equal(coins(1_000), suite.app.BankKeeper.GetAllBalances(suite.ctx, granter))
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
 func (suite *KeeperTestSuite) TestResetGasMeterAndConsumeGas() {
 	testCases := []struct {
 		name        string
@@ -687,6 +781,46 @@ func (suite *KeeperTestSuite) TestApplyMessageWithConfig() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestApplyMessageAccessListWarmStorage() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+
+	data, err := types.ERC20Contract.ABI.Pack("balanceOf", suite.address)
+	suite.Require().NoError(err)
+
+	// balances mapping is stored at slot 0 of the ERC20 contract
+	balanceSlot := crypto.Keccak256Hash(
+		common.LeftPadBytes(suite.address.Bytes(), 32),
+		common.LeftPadBytes(nil, 32),
+	)
+
+	// executionGas returns the gas used by the call, excluding the intrinsic gas
+	executionGas := func(accessList ethtypes.AccessList) uint64 {
+		nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+		msg := ethtypes.NewMessage(
+			suite.address, &contractAddr, nonce, big.NewInt(0), 40_000,
+			big.NewInt(0), big.NewInt(0), big.NewInt(0), data, accessList, false,
+		)
+		res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, types.NewNoOpTracer(), false)
+		suite.Require().NoError(err)
+		suite.Require().False(res.Failed())
+
+		intrinsicGas, err := core.IntrinsicGas(data, accessList, false, true, true)
+		suite.Require().NoError(err)
+		return res.GasUsed - intrinsicGas
+	}
+
+	coldGas := executionGas(nil)
+	warmGas := executionGas(ethtypes.AccessList{
+		{Address: contractAddr, StorageKeys: []common.Hash{balanceSlot}},
+	})
+
+	// the declared slot is pre-warmed, so the SLOAD is charged the warm read cost
+	expDiff := params.ColdSloadCostEIP2929 - params.WarmStorageReadCostEIP2929
+	suite.Require().Equal(expDiff, coldGas-warmGas)
+}
+
 func (suite *KeeperTestSuite) createContractGethMsg(nonce uint64, signer ethtypes.Signer, cfg *params.ChainConfig, gasPrice *big.Int) (core.Message, error) {
 	ethMsg, err := suite.createContractMsgTx(nonce, signer, gasPrice)
 	if err != nil {
@@ -714,6 +848,451 @@ func (suite *KeeperTestSuite) createContractMsgTx(nonce uint64, signer ethtypes.
 	return ethMsg, ethMsg.Sign(signer, suite.signer)
 }
 
+func (suite *KeeperTestSuite) TestApplyMessageMaxCodeSize() {
+	suite.SetupTest()
+
+	ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.address, big.NewInt(1000))
+	suite.Require().NoError(err)
+	data := types.ERC20Contract.Bin
+	data = append(data, ctorArgs...)
+	args, err := json.Marshal(&types.TransactionArgs{
+		From: &suite.address,
+		Data: (*hexutil.Bytes)(&data),
+	})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name        string
+		maxCodeSize uint64
+		expVMError  string
+	}{
+		{
+			name:        "default limit",
+			maxCodeSize: types.DefaultMaxCodeSize,
+		},
+		{
+			name:        "lowered limit",
+			maxCodeSize: 100,
+			expVMError:  vm.ErrMaxCodeSizeExceeded.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.MaxCodeSize = tc.maxCodeSize
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			res, err := suite.app.EvmKeeper.EthCall(suite.ctx, &types.EthCallRequest{
+				Args:   args,
+				GasCap: config.DefaultGasCap,
+			})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expVMError, res.VmError)
+			if tc.expVMError != "" {
+				// all the gas is consumed, as in the EVM
+				suite.Require().Equal(config.DefaultGasCap, res.GasUsed)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestApplyMessageMaxCodeSizeFromContract() {
+	suite.SetupTest()
+
+	// factory contract that creates a contract with the calldata as init code
+	// and returns its address:
+	// CALLDATASIZE PUSH1 0 PUSH1 0 CALLDATACOPY
+	// CALLDATASIZE PUSH1 0 PUSH1 0 CREATE
+	// PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	factoryAddr := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	vmdb := suite.StateDB()
+	vmdb.SetCode(factoryAddr, common.FromHex("0x3660006000373660006000f060005260206000f3"))
+	suite.Require().NoError(vmdb.Commit())
+
+	// initCode returns init code that deploys a code of the given size:
+	// PUSH2 size PUSH1 0 RETURN
+	initCode := func(size uint16) []byte {
+		return []byte{0x61, byte(size >> 8), byte(size), 0x60, 0x00, 0xf3}
+	}
+
+	testCases := []struct {
+		name        string
+		maxCodeSize uint64
+		codeSize    uint16
+		expCreated  bool
+	}{
+		{
+			name:        "default limit",
+			maxCodeSize: types.DefaultMaxCodeSize,
+			codeSize:    200,
+			expCreated:  true,
+		},
+		{
+			name:        "code below the lowered limit",
+			maxCodeSize: 100,
+			codeSize:    100,
+			expCreated:  true,
+		},
+		{
+			name:        "code above the lowered limit",
+			maxCodeSize: 100,
+			codeSize:    101,
+			expCreated:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.MaxCodeSize = tc.maxCodeSize
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			data := initCode(tc.codeSize)
+			args, err := json.Marshal(&types.TransactionArgs{
+				From: &suite.address,
+				To:   &factoryAddr,
+				Data: (*hexutil.Bytes)(&data),
+			})
+			suite.Require().NoError(err)
+
+			res, err := suite.app.EvmKeeper.EthCall(suite.ctx, &types.EthCallRequest{
+				Args:   args,
+				GasCap: config.DefaultGasCap,
+			})
+			suite.Require().NoError(err)
+			// the factory call succeeds, while CREATE returns the zero address
+			// if the creation failed
+			suite.Require().Empty(res.VmError)
+			created := common.BytesToAddress(res.Ret)
+			suite.Require().Equal(tc.expCreated, created != common.Address{})
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestApplyMessagePrecompileGasCosts() {
+	suite.SetupTest()
+
+	bech32Precompile, err := bech32.NewPrecompile(6000)
+	suite.Require().NoError(err)
+	input, err := bech32Precompile.Pack(bech32.HexToBech32Method, suite.address, "evmos")
+	suite.Require().NoError(err)
+
+	to := bech32Precompile.Address()
+	args, err := json.Marshal(&types.TransactionArgs{
+		From: &suite.address,
+		To:   &to,
+		Data: (*hexutil.Bytes)(&input),
+	})
+	suite.Require().NoError(err)
+
+	estimate := func() uint64 {
+		res, err := suite.app.EvmKeeper.EstimateGas(suite.ctx, &types.EthCallRequest{
+			Args:   args,
+			GasCap: config.DefaultGasCap,
+		})
+		suite.Require().NoError(err)
+		return res.Gas
+	}
+	defaultGas := estimate()
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.PrecompileGasCosts = []types.PrecompileGasCost{
+		{Address: to.Hex(), MethodSelector: hexutil.Encode(input[:4]), Gas: 500_000},
+	}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	// the overridden cost replaces the compiled base cost of the method
+	suite.Require().Equal(defaultGas-6000+500_000, estimate())
+}
+
+func (suite *KeeperTestSuite) TestApplyMessageFreePrecompileReads() {
+	suite.SetupTest()
+
+	// charge the gas used instead of the minimum share of the gas limit
+	feemarketParams := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	feemarketParams.MinGasMultiplier = sdkmath.LegacyZeroDec()
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, feemarketParams))
+
+	// the validator query consumes gas reading the store on top of its base cost
+	stakingPrecompile, err := staking.NewPrecompile(suite.app.StakingKeeper, suite.app.AuthzKeeper)
+	suite.Require().NoError(err)
+	validators := suite.app.StakingKeeper.GetAllValidators(suite.ctx)
+	suite.Require().NotEmpty(validators)
+	input, err := stakingPrecompile.Pack(staking.ValidatorMethod, common.BytesToAddress(validators[0].GetOperator()))
+	suite.Require().NoError(err)
+	baseGas := stakingPrecompile.RequiredGas(input)
+	suite.Require().NotZero(baseGas)
+
+	to := stakingPrecompile.Address()
+	call := func(commit bool) uint64 {
+		nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+		msg := ethtypes.NewMessage(
+			suite.address, &to, nonce, big.NewInt(0), 100_000,
+			big.NewInt(0), big.NewInt(0), big.NewInt(0), input, nil, true,
+		)
+
+		// the precompile gas meter starts from the gas consumed by the context
+		ctx := suite.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		res, err := suite.app.EvmKeeper.ApplyMessage(ctx, msg, nil, commit)
+		suite.Require().NoError(err)
+		suite.Require().False(res.Failed(), res.VmError)
+		return res.GasUsed
+	}
+	chargedGas := call(true)
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.FreePrecompileReads = 2
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	// the queries (e.g. eth_call and eth_estimateGas) are charged the base cost
+	// and don't use the allowance
+	suite.Require().Equal(chargedGas, call(false))
+	suite.Require().Zero(suite.app.EvmKeeper.GetFreePrecompileReads(suite.ctx, suite.address))
+
+	// only the base cost is waived for the calls within the allowance, while
+	// the gas consumed by their execution is still charged
+	freeGas := call(true)
+	suite.Require().Equal(chargedGas-baseGas, freeGas)
+	intrinsicGas, err := core.IntrinsicGas(input, nil, false, true, true)
+	suite.Require().NoError(err)
+	suite.Require().Greater(freeGas, intrinsicGas)
+	suite.Require().Equal(freeGas, call(true))
+	suite.Require().Equal(uint64(2), suite.app.EvmKeeper.GetFreePrecompileReads(suite.ctx, suite.address))
+
+	// the allowance is exhausted
+	suite.Require().Equal(chargedGas, call(true))
+
+	// the allowance is reset on the next block
+	suite.Commit()
+	suite.Require().Equal(freeGas, call(true))
+}
+
+func (suite *KeeperTestSuite) TestApplyMessageEIP3529Refunds() {
+	suite.SetupTest()
+
+	// charge the gas used instead of the minimum share of the gas limit
+	feemarketParams := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	feemarketParams.MinGasMultiplier = sdkmath.LegacyZeroDec()
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, feemarketParams))
+
+	// PUSH1 0x00 PUSH1 0x00 SSTORE: clears the storage slot 0
+	contractAddr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	vmdb := suite.StateDB()
+	vmdb.SetCode(contractAddr, common.FromHex("0x6000600055"))
+	vmdb.SetState(contractAddr, common.Hash{}, common.BigToHash(big.NewInt(1)))
+	suite.Require().NoError(vmdb.Commit())
+
+	// intrinsic gas + 2 PUSH1 + SSTORE reset of a cold slot
+	gasUsed := uint64(21_000 + 2*3 + 5_000)
+
+	testCases := []struct {
+		name          string
+		enableEIP3529 bool
+		expRefund     uint64
+	}{
+		{
+			name:          "EIP-3529 enabled - reduced clearing refund",
+			enableEIP3529: true,
+			expRefund:     4_800,
+		},
+		{
+			name:          "EIP-3529 disabled - clearing refund capped to half of the gas used",
+			enableEIP3529: false,
+			expRefund:     gasUsed / 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.EnableEIP3529 = tc.enableEIP3529
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			msg := ethtypes.NewMessage(
+				suite.address, &contractAddr, nonce, big.NewInt(0), 100_000,
+				big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true,
+			)
+
+			res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, false)
+			suite.Require().NoError(err)
+			suite.Require().False(res.Failed(), res.VmError)
+			suite.Require().Equal(gasUsed-tc.expRefund, res.GasUsed)
+		})
+	}
+}
+
+// recordingObserver is an EVMObserver that records the notified state changes
+// and sets a marker storage slot to check that its state changes are committed.
+type recordingObserver struct {
+	touched [][]common.Address
+	logs    [][]*ethtypes.Log
+	marker  func(ctx sdk.Context)
+}
+
+func (o *recordingObserver) AfterMessageApplied(ctx sdk.Context, _ core.Message, touched []common.Address, logs []*ethtypes.Log) {
+	o.touched = append(o.touched, touched)
+	o.logs = append(o.logs, logs)
+	o.marker(ctx)
+}
+
+// panickingObserver is an EVMObserver that panics after modifying the state.
+type panickingObserver struct {
+	marker func(ctx sdk.Context)
+}
+
+func (o panickingObserver) AfterMessageApplied(ctx sdk.Context, _ core.Message, _ []common.Address, _ []*ethtypes.Log) {
+	o.marker(ctx)
+	panic("observer failure")
+}
+
+func (suite *KeeperTestSuite) TestApplyTransactionObservers() {
+	suite.SetupTest()
+
+	observerAddr := utiltx.GenerateAddress()
+	panickingAddr := utiltx.GenerateAddress()
+	markerKey := common.BytesToHash([]byte("key"))
+	markerValue := common.BytesToHash([]byte("value"))
+	setMarker := func(address common.Address) func(ctx sdk.Context) {
+		return func(ctx sdk.Context) {
+			suite.app.EvmKeeper.SetState(ctx, address, markerKey, markerValue.Bytes())
+		}
+	}
+
+	observer := &recordingObserver{marker: setMarker(observerAddr)}
+	suite.app.EvmKeeper.WithObservers(panickingObserver{marker: setMarker(panickingAddr)}, observer)
+
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+	suite.Commit()
+
+	recipient := utiltx.GenerateAddress()
+	suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, big.NewInt(100))
+	suite.Commit()
+
+	// the observer is notified of both txs, despite the panic of the first one
+	suite.Require().Len(observer.touched, 2)
+	suite.Require().Contains(observer.touched[0], contractAddr)
+	suite.Require().Contains(observer.touched[0], suite.address)
+	suite.Require().Equal([]common.Address{contractAddr}, observer.touched[1])
+
+	// the transfer emits a single Transfer event
+	suite.Require().Len(observer.logs[1], 1)
+	suite.Require().Equal(contractAddr, observer.logs[1][0].Address)
+
+	// only the state changes of the observer that didn't panic are committed
+	suite.Require().Equal(markerValue, suite.app.EvmKeeper.GetState(suite.ctx, observerAddr, markerKey))
+	suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, panickingAddr, markerKey))
+}
+
+func (suite *KeeperTestSuite) TestApplyTransactionRevertLog() {
+	testCases := []struct {
+		name         string
+		level        string
+		nodeLogLevel string
+		expLog       bool
+	}{
+		{
+			name:   "disabled",
+			level:  types.RevertLogLevelNone,
+			expLog: false,
+		},
+		{
+			name:   "enabled",
+			level:  types.RevertLogLevelInfo,
+			expLog: true,
+		},
+		{
+			name:         "enabled - filtered out by the node log level",
+			level:        types.RevertLogLevelDebug,
+			nodeLogLevel: "info",
+			expLog:       false,
+		},
+		{
+			name:         "enabled - allowed by the evm module log level",
+			level:        types.RevertLogLevelDebug,
+			nodeLogLevel: types.ModuleName + ":debug,*:error",
+			expLog:       true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.app.EvmKeeper.WithRevertLogLevel(tc.level, tc.nodeLogLevel)
+			defer suite.app.EvmKeeper.WithRevertLogLevel("", "")
+
+			contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(100))
+			suite.Commit()
+
+			// transfer more tokens than the sender owns
+			recipient := utiltx.GenerateAddress()
+			input, err := types.ERC20Contract.ABI.Pack("transfer", recipient, big.NewInt(1000))
+			suite.Require().NoError(err)
+
+			chainID := suite.app.EvmKeeper.ChainID()
+			tx := types.NewTx(&types.EvmTxArgs{
+				ChainID:  chainID,
+				Nonce:    suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+				To:       &contractAddr,
+				GasLimit: 100_000,
+				Input:    input,
+			})
+			tx.From = suite.address.Hex()
+			suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+			var buf bytes.Buffer
+			ctx := suite.ctx.WithLogger(log.NewTMLogger(&buf))
+
+			res, err := suite.app.EvmKeeper.ApplyTransaction(ctx, tx.AsTransaction())
+			suite.Require().NoError(err)
+			suite.Require().True(res.Failed(), "expected tx to revert")
+
+			if !tc.expLog {
+				suite.Require().NotContains(buf.String(), "ethereum tx reverted")
+				return
+			}
+
+			logs := buf.String()
+			suite.Require().Contains(logs, "ethereum tx reverted")
+			suite.Require().Contains(logs, "tx_hash="+tx.AsTransaction().Hash().Hex())
+			suite.Require().Contains(logs, "sender="+suite.address.Hex())
+			suite.Require().Contains(logs, "to="+contractAddr.Hex())
+			suite.Require().Contains(logs, fmt.Sprintf("gas_used=%d", res.GasUsed))
+			suite.Require().Contains(logs, "depth=0")
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestApplyMessageNoRevertLog() {
+	suite.SetupTest()
+	suite.app.EvmKeeper.WithRevertLogLevel(types.RevertLogLevelInfo, "")
+	defer suite.app.EvmKeeper.WithRevertLogLevel("", "")
+
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(100))
+	suite.Commit()
+
+	recipient := utiltx.GenerateAddress()
+	input, err := types.ERC20Contract.ABI.Pack("transfer", recipient, big.NewInt(1000))
+	suite.Require().NoError(err)
+
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	msg := ethtypes.NewMessage(
+		suite.address, &contractAddr, nonce, big.NewInt(0), 100_000,
+		big.NewInt(0), big.NewInt(0), big.NewInt(0), input, nil, true,
+	)
+
+	var buf bytes.Buffer
+	ctx := suite.ctx.WithLogger(log.NewTMLogger(&buf))
+
+	// messages applied outside of a transaction, as on queries and replays,
+	// are not logged even when committed
+	res, err := suite.app.EvmKeeper.ApplyMessage(ctx, msg, nil, true)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Failed(), "expected tx to revert")
+	suite.Require().NotContains(buf.String(), "ethereum tx reverted")
+}
+
 func (suite *KeeperTestSuite) TestGetProposerAddress() {
 	var a sdk.ConsAddress
 	address := sdk.ConsAddress(suite.address.Bytes())
@@ -745,3 +1324,43 @@ func (suite *KeeperTestSuite) TestGetProposerAddress() {
 		})
 	}
 }
+
+// TestApplyMessageBlockedAddressInternalCall documents that the blocked
+// addresses are only checked against the sender and recipient of the ethereum
+// tx by the ante handler: a non-blocked contract can still forward value to a
+// blocked address through an internal call.
+func (suite *KeeperTestSuite) TestApplyMessageBlockedAddressInternalCall() {
+	suite.SetupTest()
+
+	blockedAddr := common.HexToAddress("0x100000000000000000000000000000000000dEaD")
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.BlockedAddresses = []string{blockedAddr.Hex()}
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	// forwarder contract that sends the call value to the blocked address:
+	// PUSH1 0 PUSH1 0 PUSH1 0 PUSH1 0 CALLVALUE PUSH20 blockedAddr GAS CALL STOP
+	forwarderAddr := common.HexToAddress("0x1000000000000000000000000000000000000003")
+	code := append(common.FromHex("0x60006000600060003473"), blockedAddr.Bytes()...)
+	code = append(code, common.FromHex("0x5af100")...)
+
+	amount := big.NewInt(1000)
+	vmdb := suite.StateDB()
+	vmdb.SetCode(forwarderAddr, code)
+	vmdb.AddBalance(suite.address, amount)
+	suite.Require().NoError(vmdb.Commit())
+
+	// the tx itself passes the blocked addresses check
+	suite.Require().False(params.IsBlockedAddress(suite.address))
+	suite.Require().False(params.IsBlockedAddress(forwarderAddr))
+
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	msg := ethtypes.NewMessage(
+		suite.address, &forwarderAddr, nonce, amount, 100_000,
+		big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true,
+	)
+
+	res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(amount, suite.app.EvmKeeper.GetBalance(suite.ctx, blockedAddr))
+}
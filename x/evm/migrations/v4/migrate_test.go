@@ -85,5 +85,7 @@ func TestMigrate(t *testing.T) {
 	require.Equal(t, legacySubspace.ps.EnableCreate, params.EnableCreate)
 	require.Equal(t, legacySubspace.ps.AllowUnprotectedTxs, params.AllowUnprotectedTxs)
 	require.Equal(t, legacySubspace.ps.ExtraEIPs, params.ExtraEIPs.EIPs)
-	require.EqualValues(t, legacySubspace.ps.ChainConfig, params.V4ChainConfig)
+	// NOTE: compare the encoded chain configs, since the current ChainConfig
+	// type has fields that were added after the v4 migration.
+	require.Equal(t, cdc.MustMarshal(&legacySubspace.ps.ChainConfig), cdc.MustMarshal(&params.V4ChainConfig))
 }
@@ -55,6 +55,9 @@ func MigrateStore(
 	store.Delete(types.ParamStoreKeyEnableCall)
 	store.Delete(types.ParamStoreKeyAllowUnprotectedTxs)
 
+	// NOTE: the MaxCodeSize param, added on v7, cannot be zero
+	params.MaxCodeSize = types.DefaultMaxCodeSize
+
 	if err := params.Validate(); err != nil {
 		return err
 	}
@@ -64,6 +64,9 @@ func MigrateStore(
 		params.EVMChannels = []string{}
 	}
 
+	// NOTE: the MaxCodeSize param, added on v7, cannot be zero
+	params.MaxCodeSize = types.DefaultMaxCodeSize
+
 	if err := params.Validate(); err != nil {
 		return err
 	}
@@ -0,0 +1,65 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package v7
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v16/precompiles/p256"
+	"github.com/evmos/evmos/v16/x/evm/types"
+	"golang.org/x/exp/slices"
+)
+
+// NewPrecompiles are the addresses of the p256 batch, epochs and inflation
+// precompiles, which are activated by this migration.
+var NewPrecompiles = []string{
+	p256.BatchPrecompileAddress,                  // P256 batch precompile
+	"0x0000000000000000000000000000000000000805", // Epochs precompile
+	"0x0000000000000000000000000000000000000806", // Inflation precompile
+}
+
+// MigrateStore migrates the x/evm module state from the consensus version 6 to
+// version 7. Specifically, it adds the new ScheduledContracts, ScheduledCallGas,
+// ScheduledEpochIdentifier, MaxCodeSize and EnableEIP3529 params, and activates
+// the p256 batch, epochs and inflation precompiles. No contracts are scheduled by default.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	var params types.Params
+
+	store := ctx.KVStore(storeKey)
+
+	// NOTE: the new fields are appended to the Params proto message, so the
+	// stored params can be decoded into the current type.
+	paramsBz := store.Get(types.KeyPrefixParams)
+	cdc.MustUnmarshal(paramsBz, &params)
+
+	params.ScheduledContracts = types.DefaultScheduledContracts
+	params.ScheduledCallGas = types.DefaultScheduledCallGas
+	params.ScheduledEpochIdentifier = types.DefaultScheduledEpochIdentifier
+	params.MaxCodeSize = types.DefaultMaxCodeSize
+	params.EnableEIP3529 = types.DefaultEnableEIP3529
+
+	for _, precompile := range NewPrecompiles {
+		if !slices.Contains(params.ActivePrecompiles, precompile) {
+			params.ActivePrecompiles = append(params.ActivePrecompiles, precompile)
+		}
+	}
+
+	// NOTE: the active precompiles need to be sorted
+	sort.Strings(params.ActivePrecompiles)
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz := cdc.MustMarshal(&params)
+
+	store.Set(types.KeyPrefixParams, bz)
+	return nil
+}
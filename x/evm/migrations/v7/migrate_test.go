@@ -0,0 +1,66 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package v7_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v16/app"
+	"github.com/evmos/evmos/v16/encoding"
+	v7 "github.com/evmos/evmos/v16/x/evm/migrations/v7"
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := ctx.KVStore(storeKey)
+
+	// params stored before the scheduled call params were added
+	v6Params := types.DefaultParams()
+	v6Params.ScheduledContracts = nil
+	v6Params.ScheduledCallGas = 0
+	v6Params.ScheduledEpochIdentifier = ""
+	v6Params.MaxCodeSize = 0
+	v6Params.EnableEIP3529 = false
+	v6Params.ActivePrecompiles = []string{
+		"0x0000000000000000000000000000000000000400",
+		"0x0000000000000000000000000000000000000804",
+		"0x0000000000000000000000000000000000000900",
+	}
+
+	kvStore.Set(types.KeyPrefixParams, cdc.MustMarshal(&v6Params))
+
+	err := v7.MigrateStore(ctx, storeKey, cdc)
+	require.NoError(t, err)
+
+	paramsBz := kvStore.Get(types.KeyPrefixParams)
+	var params types.Params
+	cdc.MustUnmarshal(paramsBz, &params)
+
+	// test that the params have been migrated correctly
+	require.Equal(t, v6Params.EvmDenom, params.EvmDenom)
+	require.Equal(t, v6Params.ChainConfig, params.ChainConfig)
+	require.Equal(t, []string{
+		"0x0000000000000000000000000000000000000101",
+		"0x0000000000000000000000000000000000000400",
+		"0x0000000000000000000000000000000000000804",
+		"0x0000000000000000000000000000000000000805",
+		"0x0000000000000000000000000000000000000806",
+		"0x0000000000000000000000000000000000000900",
+	}, params.ActivePrecompiles)
+	require.Equal(t, v6Params.EVMChannels, params.EVMChannels)
+	require.Empty(t, params.ScheduledContracts)
+	require.Equal(t, types.DefaultScheduledCallGas, params.ScheduledCallGas)
+	require.Equal(t, types.DefaultScheduledEpochIdentifier, params.ScheduledEpochIdentifier)
+	require.Equal(t, types.DefaultMaxCodeSize, params.MaxCodeSize)
+	require.Equal(t, types.DefaultEnableEIP3529, params.EnableEIP3529)
+}
@@ -93,6 +93,12 @@ func (s *StateDB) Logs() []*ethtypes.Log {
 	return s.logs
 }
 
+// DirtyAccounts returns the addresses of the accounts modified by the current
+// transaction, sorted.
+func (s *StateDB) DirtyAccounts() []common.Address {
+	return s.journal.sortedDirties()
+}
+
 // AddRefund adds gas to the refund counter
 func (s *StateDB) AddRefund(gas uint64) {
 	s.journal.append(refundChange{prev: s.refund})
@@ -385,6 +385,64 @@ func (suite *StateDBTestSuite) TestNestedSnapshot() {
 	suite.Require().Equal(common.Hash{}, db.GetState(address, key))
 }
 
+func (suite *StateDBTestSuite) TestSnapshotRestoresIntermediateState() {
+	key := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(1))
+	value2 := common.BigToHash(big.NewInt(2))
+	code := []byte("hello world")
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+
+	// mutate the state before the snapshot
+	db.SetNonce(address, 1)
+	db.AddBalance(address, big.NewInt(100))
+	db.SetCode(address, code)
+	db.SetState(address, key, value1)
+	db.AddLog(&ethtypes.Log{Address: address})
+	db.AddRefund(10)
+	db.AddAddressToAccessList(address)
+
+	rev := db.Snapshot()
+
+	// mutate the state further
+	db.SetNonce(address, 2)
+	db.SubBalance(address, big.NewInt(50))
+	db.SetCode(address, []byte("other code"))
+	db.SetState(address, key, value2)
+	db.SetState(address2, key, value2)
+	db.AddLog(&ethtypes.Log{Address: address2})
+	db.AddRefund(5)
+	db.AddSlotToAccessList(address, key)
+	db.AddAddressToAccessList(address2)
+
+	db.RevertToSnapshot(rev)
+
+	// the intermediate state is restored exactly
+	suite.Require().Equal(uint64(1), db.GetNonce(address))
+	suite.Require().Equal(big.NewInt(100), db.GetBalance(address))
+	suite.Require().Equal(code, db.GetCode(address))
+	suite.Require().Equal(value1, db.GetState(address, key))
+	suite.Require().False(db.Exist(address2))
+	suite.Require().Len(db.Logs(), 1)
+	suite.Require().Equal(address, db.Logs()[0].Address)
+	suite.Require().Equal(uint64(10), db.GetRefund())
+	suite.Require().True(db.AddressInAccessList(address))
+	suite.Require().False(db.AddressInAccessList(address2))
+	addrOk, slotOk := db.SlotInAccessList(address, key)
+	suite.Require().True(addrOk)
+	suite.Require().False(slotOk)
+
+	// only the intermediate state is committed
+	suite.Require().NoError(db.Commit())
+	acct := keeper.GetAccount(sdk.Context{}, address)
+	suite.Require().NotNil(acct)
+	suite.Require().Equal(uint64(1), acct.Nonce)
+	suite.Require().Equal(big.NewInt(100), acct.Balance)
+	suite.Require().Equal(value1, keeper.GetState(sdk.Context{}, address, key))
+	suite.Require().Nil(keeper.GetAccount(sdk.Context{}, address2))
+}
+
 func (suite *StateDBTestSuite) TestInvalidSnapshotId() {
 	db := statedb.New(sdk.Context{}, NewMockKeeper(), emptyTxConfig)
 	suite.Require().Panics(func() {
@@ -0,0 +1,46 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"errors"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// BlockOverrides is a set of header fields to override when building the EVM block
+// context of a message call.
+// Duplicate struct definition since geth struct is in internal package
+// Ref: https://github.com/ethereum/go-ethereum/blob/v1.11.0/internal/ethapi/api.go#L938
+type BlockOverrides struct {
+	Number   *hexutil.Big    `json:"number"`
+	Time     *hexutil.Uint64 `json:"time"`
+	Coinbase *common.Address `json:"coinbase"`
+	BaseFee  *hexutil.Big    `json:"baseFee"`
+}
+
+// Validate performs a basic validation of the block overrides fields.
+func (diff *BlockOverrides) Validate() error {
+	if diff == nil {
+		return nil
+	}
+
+	if diff.Number != nil {
+		number := diff.Number.ToInt()
+		if number.Sign() <= 0 || !number.IsInt64() {
+			return errors.New("block number override must be a positive 64-bit integer")
+		}
+	}
+
+	if diff.Time != nil && uint64(*diff.Time) > math.MaxInt64 {
+		return errors.New("block time override overflows int64")
+	}
+
+	if diff.BaseFee != nil && diff.BaseFee.ToInt().Sign() < 0 {
+		return errors.New("base fee override cannot be negative")
+	}
+
+	return nil
+}
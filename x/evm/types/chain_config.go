@@ -45,6 +45,7 @@ func (cc ChainConfig) EthereumConfig(chainID *big.Int) *params.ChainConfig {
 }
 
 // DefaultChainConfig returns default evm parameters.
+// NOTE: EIP-2935 is disabled by default, since it writes to the state on every block.
 func DefaultChainConfig() ChainConfig {
 	homesteadBlock := sdkmath.ZeroInt()
 	daoForkBlock := sdkmath.ZeroInt()
@@ -87,6 +88,18 @@ func DefaultChainConfig() ChainConfig {
 	}
 }
 
+// IsEIP2935 returns whether the given block number is either equal to the EIP-2935
+// fork block or greater. EIP-2935 is not part of the go-ethereum chain config, so it
+// is handled separately.
+func (cc ChainConfig) IsEIP2935(num *big.Int) bool {
+	block := getBlockValue(cc.EIP2935Block)
+	if block == nil || num == nil {
+		return false
+	}
+
+	return block.Cmp(num) <= 0
+}
+
 func getBlockValue(block *sdkmath.Int) *big.Int {
 	if block == nil || block.IsNegative() {
 		return nil
@@ -152,6 +165,9 @@ func (cc ChainConfig) Validate() error {
 	if err := validateBlock(cc.CancunBlock); err != nil {
 		return errorsmod.Wrap(err, "CancunBlock")
 	}
+	if err := validateBlock(cc.EIP2935Block); err != nil {
+		return errorsmod.Wrap(err, "EIP2935Block")
+	}
 	// NOTE: chain ID is not needed to check config order
 	if err := cc.EthereumConfig(nil).CheckConfigForkOrder(); err != nil {
 		return errorsmod.Wrap(err, "invalid config fork order")
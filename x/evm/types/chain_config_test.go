@@ -1,6 +1,7 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -367,6 +368,31 @@ func TestChainConfigValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid EIP2935Block",
+			ChainConfig{
+				HomesteadBlock:      newIntPtr(0),
+				DAOForkBlock:        newIntPtr(0),
+				EIP150Block:         newIntPtr(0),
+				EIP150Hash:          defaultEIP150Hash,
+				EIP155Block:         newIntPtr(0),
+				EIP158Block:         newIntPtr(0),
+				ByzantiumBlock:      newIntPtr(0),
+				ConstantinopleBlock: newIntPtr(0),
+				PetersburgBlock:     newIntPtr(0),
+				IstanbulBlock:       newIntPtr(0),
+				MuirGlacierBlock:    newIntPtr(0),
+				BerlinBlock:         newIntPtr(0),
+				LondonBlock:         newIntPtr(0),
+				ArrowGlacierBlock:   newIntPtr(0),
+				GrayGlacierBlock:    newIntPtr(0),
+				MergeNetsplitBlock:  newIntPtr(0),
+				ShanghaiBlock:       newIntPtr(0),
+				CancunBlock:         newIntPtr(0),
+				EIP2935Block:        newIntPtr(-1),
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -379,3 +405,23 @@ func TestChainConfigValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestIsEIP2935(t *testing.T) {
+	testCases := []struct {
+		name   string
+		block  *sdkmath.Int
+		num    *big.Int
+		expRes bool
+	}{
+		{"nil fork block", nil, big.NewInt(10), false},
+		{"negative fork block", newIntPtr(-1), big.NewInt(10), false},
+		{"before fork block", newIntPtr(10), big.NewInt(9), false},
+		{"at fork block", newIntPtr(10), big.NewInt(10), true},
+		{"after fork block", newIntPtr(10), big.NewInt(11), true},
+	}
+
+	for _, tc := range testCases {
+		cc := ChainConfig{EIP2935Block: tc.block}
+		require.Equal(t, tc.expRes, cc.IsEIP2935(tc.num), tc.name)
+	}
+}
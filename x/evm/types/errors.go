@@ -3,6 +3,7 @@
 package types
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 
@@ -11,6 +12,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 const (
@@ -30,6 +32,8 @@ const (
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
 	codeErrInactivePrecompile
+	codeErrEVMPaused
+	codeErrBlockedAddress
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
@@ -82,13 +86,48 @@ var (
 
 	// ErrInactivePrecompile returns an error if a call is made to an inactive precompile
 	ErrInactivePrecompile = errorsmod.Register(ModuleName, codeErrInactivePrecompile, "precompile not enabled")
+
+	// ErrEVMPaused returns an error if an ethereum tx is processed while the EVM is paused by governance
+	ErrEVMPaused = errorsmod.Register(ModuleName, codeErrEVMPaused, "EVM temporarily disabled")
+
+	// ErrBlockedAddress returns an error if an ethereum tx is sent from or to a blocked address
+	ErrBlockedAddress = errorsmod.Register(ModuleName, codeErrBlockedAddress, "address is blocked")
 )
 
+// revertSelector is the 4-byte selector of the Solidity `Error(string)` function
+// used to encode revert reasons.
+var revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+// UnpackRevertReason strips the `Error(string)` selector from the given return
+// bytes of a reverted call and ABI-decodes the revert reason.
+func UnpackRevertReason(ret []byte) (string, error) {
+	if len(ret) < len(revertSelector) {
+		return "", fmt.Errorf("invalid revert data length %d", len(ret))
+	}
+	if !bytes.Equal(ret[:len(revertSelector)], revertSelector) {
+		return "", fmt.Errorf("invalid revert selector %s", hexutil.Encode(ret[:len(revertSelector)]))
+	}
+
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		return "", err
+	}
+	unpacked, err := (abi.Arguments{{Type: stringType}}).Unpack(ret[len(revertSelector):])
+	if err != nil {
+		return "", errorsmod.Wrap(err, "failed to unpack revert reason")
+	}
+	reason, ok := unpacked[0].(string)
+	if !ok {
+		return "", fmt.Errorf("invalid revert reason type %T", unpacked[0])
+	}
+	return reason, nil
+}
+
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
 // with the return reason.
 func NewExecErrorWithReason(revertReason []byte) *RevertError {
 	result := common.CopyBytes(revertReason)
-	reason, errUnpack := abi.UnpackRevert(result)
+	reason, errUnpack := UnpackRevertReason(result)
 	err := errors.New("execution reverted")
 	if errUnpack == nil {
 		err = fmt.Errorf("execution reverted: %v", reason)
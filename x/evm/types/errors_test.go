@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnpackRevertReason(t *testing.T) {
+	stringType, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	packed, err := (abi.Arguments{{Type: stringType}}).Pack("ERC20: transfer amount exceeds balance")
+	require.NoError(t, err)
+	selector := crypto.Keccak256([]byte("Error(string)"))[:4]
+
+	testCases := []struct {
+		name     string
+		ret      []byte
+		expPass  bool
+		expError string
+		reason   string
+	}{
+		{
+			"fail - empty return data",
+			nil,
+			false,
+			"invalid revert data length",
+			"",
+		},
+		{
+			"fail - invalid selector",
+			append(hexutil.MustDecode("0x4e487b71"), packed...),
+			false,
+			"invalid revert selector",
+			"",
+		},
+		{
+			"fail - selector without reason",
+			selector,
+			false,
+			"failed to unpack revert reason",
+			"",
+		},
+		{
+			"pass - revert reason",
+			append(append([]byte{}, selector...), packed...),
+			true,
+			"",
+			"ERC20: transfer amount exceeds balance",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reason, err := evmtypes.UnpackRevertReason(tc.ret)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.reason, reason)
+			} else {
+				require.ErrorContains(t, err, tc.expError)
+			}
+		})
+	}
+}
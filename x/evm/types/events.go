@@ -4,9 +4,10 @@ package types
 
 // Evm module events
 const (
-	EventTypeEthereumTx = TypeMsgEthereumTx
-	EventTypeBlockBloom = "block_bloom"
-	EventTypeTxLog      = "tx_log"
+	EventTypeEthereumTx    = TypeMsgEthereumTx
+	EventTypeBlockBloom    = "block_bloom"
+	EventTypeTxLog         = "tx_log"
+	EventTypeScheduledCall = "scheduled_call"
 
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
@@ -20,6 +21,9 @@ const (
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
 	AttributeValueCategory       = ModuleName
 	AttributeKeyEthereumBloom    = "bloom"
+	AttributeKeyEpochIdentifier  = "epoch_identifier"
+	AttributeKeyEpochNumber      = "epoch_number"
+	AttributeKeyScheduledCallErr = "error"
 
 	MetricKeyTransitionDB = "transition_db"
 	MetricKeyStaticCall   = "static_call"
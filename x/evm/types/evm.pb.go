@@ -45,6 +45,42 @@ type Params struct {
 	ActivePrecompiles []string `protobuf:"bytes,7,rep,name=active_precompiles,json=activePrecompiles,proto3" json:"active_precompiles,omitempty"`
 	// evm_channels is the list of channel identifiers from EVM compatible chains
 	EVMChannels []string `protobuf:"bytes,8,rep,name=evm_channels,json=evmChannels,proto3" json:"evm_channels,omitempty"`
+	// scheduled_contracts defines the slice of hex addresses of the contracts
+	// that are called by the EVM module at the end of each scheduled epoch, up
+	// to 10 contracts
+	ScheduledContracts []string `protobuf:"bytes,9,rep,name=scheduled_contracts,json=scheduledContracts,proto3" json:"scheduled_contracts,omitempty"`
+	// scheduled_call_gas defines the gas limit of each scheduled contract call,
+	// up to 3,000,000
+	ScheduledCallGas uint64 `protobuf:"varint,10,opt,name=scheduled_call_gas,json=scheduledCallGas,proto3" json:"scheduled_call_gas,omitempty"`
+	// scheduled_epoch_identifier defines the identifier of the epoch at whose end
+	// the scheduled contracts are called
+	ScheduledEpochIdentifier string `protobuf:"bytes,11,opt,name=scheduled_epoch_identifier,json=scheduledEpochIdentifier,proto3" json:"scheduled_epoch_identifier,omitempty"`
+	// precompile_gas_costs defines the base gas costs charged by the precompiled
+	// contracts for specific methods, overriding the compiled defaults
+	PrecompileGasCosts []PrecompileGasCost `protobuf:"bytes,12,rep,name=precompile_gas_costs,json=precompileGasCosts,proto3" json:"precompile_gas_costs"`
+	// paused halts the processing of ethereum transactions, which are rejected
+	// until the flag is unset by governance. Cosmos transactions are not affected.
+	Paused bool `protobuf:"varint,13,opt,name=paused,proto3" json:"paused,omitempty"`
+	// blocked_addresses defines the slice of hex addresses that are not allowed
+	// to send nor receive ethereum transactions. Only the sender and the
+	// recipient of the transaction are checked: value transfers made by internal
+	// calls during the EVM execution are not blocked.
+	BlockedAddresses []string `protobuf:"bytes,14,rep,name=blocked_addresses,json=blockedAddresses,proto3" json:"blocked_addresses,omitempty"`
+	// free_precompile_reads defines the number of view-only precompile calls
+	// that each account can make per block without being charged their base gas
+	// cost. The gas consumed by their execution is still charged. Zero disables
+	// the allowance, which cannot exceed 100 calls.
+	FreePrecompileReads uint64 `protobuf:"varint,15,opt,name=free_precompile_reads,json=freePrecompileReads,proto3" json:"free_precompile_reads,omitempty"`
+	// max_code_size defines the maximum size in bytes of the code of the
+	// created contracts, including the ones created by contracts with the CREATE
+	// and CREATE2 opcodes. It cannot be zero, and can only lower the EIP-170
+	// limit hardcoded in the EVM.
+	MaxCodeSize uint64 `protobuf:"varint,16,opt,name=max_code_size,json=maxCodeSize,proto3" json:"max_code_size,omitempty"`
+	// enable_eip3529 defines if the reduced gas refunds of EIP-3529 are applied,
+	// regardless of the London hard fork: the SSTORE clearing refund is reduced,
+	// the SELFDESTRUCT refund is removed and the refunds are capped to a fifth of
+	// the gas used instead of half of it.
+	EnableEIP3529 bool `protobuf:"varint,17,opt,name=enable_eip3529,json=enableEip3529,proto3" json:"enable_eip3529,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -136,6 +172,134 @@ func (m *Params) GetEVMChannels() []string {
 	return nil
 }
 
+func (m *Params) GetScheduledContracts() []string {
+	if m != nil {
+		return m.ScheduledContracts
+	}
+	return nil
+}
+
+func (m *Params) GetScheduledCallGas() uint64 {
+	if m != nil {
+		return m.ScheduledCallGas
+	}
+	return 0
+}
+
+func (m *Params) GetScheduledEpochIdentifier() string {
+	if m != nil {
+		return m.ScheduledEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetPrecompileGasCosts() []PrecompileGasCost {
+	if m != nil {
+		return m.PrecompileGasCosts
+	}
+	return nil
+}
+
+func (m *Params) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *Params) GetBlockedAddresses() []string {
+	if m != nil {
+		return m.BlockedAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetFreePrecompileReads() uint64 {
+	if m != nil {
+		return m.FreePrecompileReads
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCodeSize() uint64 {
+	if m != nil {
+		return m.MaxCodeSize
+	}
+	return 0
+}
+
+func (m *Params) GetEnableEIP3529() bool {
+	if m != nil {
+		return m.EnableEIP3529
+	}
+	return false
+}
+
+// PrecompileGasCost defines the base gas cost charged by a precompiled contract
+// when one of its methods is called
+type PrecompileGasCost struct {
+	// address is the hex address of the precompiled contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// method_selector is the hex encoded 4-byte selector of the method
+	MethodSelector string `protobuf:"bytes,2,opt,name=method_selector,json=methodSelector,proto3" json:"method_selector,omitempty"`
+	// gas is the base gas cost charged for the method
+	Gas uint64 `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *PrecompileGasCost) Reset()         { *m = PrecompileGasCost{} }
+func (m *PrecompileGasCost) String() string { return proto.CompactTextString(m) }
+func (*PrecompileGasCost) ProtoMessage()    {}
+func (*PrecompileGasCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+}
+func (m *PrecompileGasCost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrecompileGasCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrecompileGasCost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrecompileGasCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrecompileGasCost.Merge(m, src)
+}
+func (m *PrecompileGasCost) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrecompileGasCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrecompileGasCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrecompileGasCost proto.InternalMessageInfo
+
+func (m *PrecompileGasCost) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PrecompileGasCost) GetMethodSelector() string {
+	if m != nil {
+		return m.MethodSelector
+	}
+	return ""
+}
+
+func (m *PrecompileGasCost) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -178,13 +342,15 @@ type ChainConfig struct {
 	ShanghaiBlock *cosmossdk_io_math.Int `protobuf:"bytes,22,opt,name=shanghai_block,json=shanghaiBlock,proto3,customtype=cosmossdk.io/math.Int" json:"shanghai_block,omitempty" yaml:"shanghai_block"`
 	// cancun_block switch block (nil = no fork, 0 = already on cancun)
 	CancunBlock *cosmossdk_io_math.Int `protobuf:"bytes,23,opt,name=cancun_block,json=cancunBlock,proto3,customtype=cosmossdk.io/math.Int" json:"cancun_block,omitempty" yaml:"cancun_block"`
+	// eip2935_block: EIP-2935 (historical block hashes in state) switch block (nil = no fork, 0 = already activated)
+	EIP2935Block *cosmossdk_io_math.Int `protobuf:"bytes,24,opt,name=eip2935_block,json=eip2935Block,proto3,customtype=cosmossdk.io/math.Int" json:"eip2935_block,omitempty" yaml:"eip2935_block"`
 }
 
 func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+	return fileDescriptor_d21ecc92c8c8583e, []int{2}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -239,7 +405,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+	return fileDescriptor_d21ecc92c8c8583e, []int{3}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +462,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+	return fileDescriptor_d21ecc92c8c8583e, []int{4}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -372,7 +538,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+	return fileDescriptor_d21ecc92c8c8583e, []int{5}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +653,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{5}
+	return fileDescriptor_d21ecc92c8c8583e, []int{6}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -528,7 +694,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{6}
+	return fileDescriptor_d21ecc92c8c8583e, []int{7}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -582,13 +748,16 @@ type TraceConfig struct {
 	EnableReturnData bool `protobuf:"varint,12,opt,name=enable_return_data,json=enableReturnData,proto3" json:"enableReturnData"`
 	// tracer_json_config configures the tracer using a JSON string
 	TracerJsonConfig string `protobuf:"bytes,13,opt,name=tracer_json_config,json=tracerJsonConfig,proto3" json:"tracerConfig"`
+	// enable_opcode_profile adds the number of executions and the total gas cost
+	// of each opcode to the result of the tracer
+	EnableOpcodeProfile bool `protobuf:"varint,14,opt,name=enable_opcode_profile,json=enableOpcodeProfile,proto3" json:"enableOpcodeProfile"`
 }
 
 func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{7}
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -694,8 +863,16 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 	return ""
 }
 
+func (m *TraceConfig) GetEnableOpcodeProfile() bool {
+	if m != nil {
+		return m.EnableOpcodeProfile
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
+	proto.RegisterType((*PrecompileGasCost)(nil), "ethermint.evm.v1.PrecompileGasCost")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
 	proto.RegisterType((*State)(nil), "ethermint.evm.v1.State")
 	proto.RegisterType((*TransactionLogs)(nil), "ethermint.evm.v1.TransactionLogs")
@@ -708,111 +885,132 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1662 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0xcd, 0x4f, 0x23, 0xc9,
-	0x15, 0x07, 0x6c, 0xa0, 0x5d, 0x36, 0x76, 0x53, 0x18, 0xd6, 0x3b, 0xa3, 0xd0, 0xa4, 0x0f, 0x11,
-	0x91, 0x76, 0x61, 0x60, 0xc2, 0xee, 0x68, 0x57, 0xf9, 0x98, 0x9e, 0x61, 0x13, 0xc8, 0xec, 0x06,
-	0x15, 0x6c, 0xa2, 0x44, 0x89, 0x5a, 0xe5, 0xee, 0xda, 0x76, 0x2f, 0xdd, 0x5d, 0x56, 0x55, 0xb5,
-	0xc7, 0xce, 0x5f, 0x10, 0x29, 0x97, 0xfc, 0x09, 0xfb, 0xe7, 0xac, 0x72, 0x9a, 0x63, 0x94, 0x43,
-	0x2b, 0x62, 0x6e, 0x28, 0x27, 0xee, 0x91, 0xa2, 0xfa, 0x70, 0xfb, 0x03, 0x42, 0x7c, 0x81, 0xfa,
-	0xbd, 0x8f, 0xdf, 0xab, 0xf7, 0xea, 0xb5, 0x5f, 0x15, 0x78, 0x42, 0x44, 0x8f, 0xb0, 0x34, 0xce,
-	0xc4, 0x21, 0x19, 0xa4, 0x87, 0x83, 0x23, 0xf9, 0xef, 0xa0, 0xcf, 0xa8, 0xa0, 0xd0, 0x2e, 0x75,
-	0x07, 0x52, 0x38, 0x38, 0x7a, 0xd2, 0x8e, 0x68, 0x44, 0x95, 0xf2, 0x50, 0xae, 0xb4, 0x9d, 0xfb,
-	0xef, 0x0a, 0x58, 0xbb, 0xc0, 0x0c, 0xa7, 0x1c, 0x1e, 0x81, 0x1a, 0x19, 0xa4, 0x7e, 0x48, 0x32,
-	0x9a, 0x76, 0x96, 0xf7, 0x96, 0xf7, 0x6b, 0x5e, 0xfb, 0xae, 0x70, 0xec, 0x11, 0x4e, 0x93, 0xcf,
-	0xdc, 0x52, 0xe5, 0x22, 0x8b, 0x0c, 0xd2, 0xd7, 0x72, 0x09, 0x7f, 0x0a, 0x36, 0x48, 0x86, 0xbb,
-	0x09, 0xf1, 0x03, 0x46, 0xb0, 0x20, 0x9d, 0x95, 0xbd, 0xe5, 0x7d, 0xcb, 0xeb, 0xdc, 0x15, 0x4e,
-	0xdb, 0xb8, 0x4d, 0xab, 0x5d, 0xd4, 0xd0, 0xf8, 0x95, 0x82, 0xf0, 0x53, 0x50, 0x1f, 0xeb, 0x71,
-	0x92, 0x74, 0x2a, 0xca, 0x79, 0xe7, 0xae, 0x70, 0xe0, 0xac, 0x33, 0x4e, 0x12, 0x17, 0x01, 0xe3,
-	0x8a, 0x93, 0x04, 0xbe, 0x04, 0x80, 0x0c, 0x05, 0xc3, 0x3e, 0x89, 0xfb, 0xbc, 0x53, 0xdd, 0xab,
-	0xec, 0x57, 0x3c, 0xf7, 0xa6, 0x70, 0x6a, 0xa7, 0x52, 0x7a, 0x7a, 0x76, 0xc1, 0xef, 0x0a, 0x67,
-	0xd3, 0x90, 0x94, 0x86, 0x2e, 0xaa, 0x29, 0x70, 0x1a, 0xf7, 0x39, 0xfc, 0x13, 0x68, 0x04, 0x3d,
-	0x1c, 0x67, 0x7e, 0x40, 0xb3, 0x6f, 0xe2, 0xa8, 0xb3, 0xba, 0xb7, 0xbc, 0x5f, 0x3f, 0xfe, 0xc1,
-	0xc1, 0x7c, 0xdd, 0x0e, 0x5e, 0x49, 0xab, 0x57, 0xca, 0xc8, 0x7b, 0xfa, 0x7d, 0xe1, 0x2c, 0xdd,
-	0x15, 0xce, 0x96, 0xa6, 0x9e, 0x26, 0x70, 0x51, 0x3d, 0x98, 0x58, 0xc2, 0x63, 0xb0, 0x8d, 0x93,
-	0x84, 0xbe, 0xf5, 0xf3, 0x4c, 0x16, 0x9a, 0x04, 0x82, 0x84, 0xbe, 0x18, 0xf2, 0xce, 0x9a, 0x4c,
-	0x12, 0x6d, 0x29, 0xe5, 0xd7, 0x13, 0xdd, 0xd5, 0x90, 0xc3, 0x8f, 0x01, 0xc4, 0x81, 0x88, 0x07,
-	0xc4, 0xef, 0x33, 0x12, 0xd0, 0xb4, 0x1f, 0x27, 0x84, 0x77, 0xd6, 0xf7, 0x2a, 0xfb, 0x35, 0xb4,
-	0xa9, 0x35, 0x17, 0x13, 0x05, 0x3c, 0x06, 0x0d, 0x79, 0x28, 0x41, 0x0f, 0x67, 0x19, 0x49, 0x78,
-	0xc7, 0x92, 0x86, 0x5e, 0xeb, 0xa6, 0x70, 0xea, 0xa7, 0xbf, 0xfd, 0xf2, 0x95, 0x11, 0xa3, 0x3a,
-	0x19, 0xa4, 0x63, 0xe0, 0xfe, 0xa7, 0x09, 0xea, 0x53, 0x09, 0xc1, 0x3f, 0x82, 0x56, 0x8f, 0xa6,
-	0x84, 0x0b, 0x82, 0x43, 0xbf, 0x9b, 0xd0, 0xe0, 0xda, 0x9c, 0xfc, 0xf3, 0x7f, 0x16, 0xce, 0x76,
-	0x40, 0x79, 0x4a, 0x39, 0x0f, 0xaf, 0x0f, 0x62, 0x7a, 0x98, 0x62, 0xd1, 0x3b, 0x38, 0xcb, 0xc4,
-	0x5d, 0xe1, 0xec, 0xe8, 0xf4, 0xe7, 0x3c, 0x5d, 0xd4, 0x2c, 0x25, 0x9e, 0x14, 0xc0, 0x1e, 0x68,
-	0x86, 0x98, 0xfa, 0xdf, 0x50, 0x76, 0x6d, 0xc8, 0x57, 0x14, 0xb9, 0xf7, 0x3f, 0xc9, 0x6f, 0x0a,
-	0xa7, 0xf1, 0xfa, 0xe5, 0x6f, 0xbe, 0xa0, 0xec, 0x5a, 0x51, 0xdc, 0x15, 0xce, 0xb6, 0x0e, 0x36,
-	0x4b, 0xe4, 0xa2, 0x46, 0x88, 0x69, 0x69, 0x06, 0x7f, 0x07, 0xec, 0xd2, 0x80, 0xe7, 0xfd, 0x3e,
-	0x65, 0xc2, 0xb4, 0xd3, 0xc7, 0x37, 0x85, 0xd3, 0x34, 0x94, 0x97, 0x5a, 0x73, 0x57, 0x38, 0x1f,
-	0xcc, 0x91, 0x1a, 0x1f, 0x17, 0x35, 0x0d, 0xad, 0x31, 0x85, 0x5d, 0xd0, 0x20, 0x71, 0xff, 0xe8,
-	0xe4, 0x99, 0x49, 0xa0, 0xaa, 0x12, 0xf8, 0xf9, 0x63, 0x09, 0xd4, 0x4f, 0xcf, 0x2e, 0x8e, 0x4e,
-	0x9e, 0x8d, 0xf7, 0x6f, 0x7a, 0x65, 0x9a, 0xc5, 0x45, 0x75, 0x0d, 0xf5, 0xe6, 0xcf, 0x80, 0x81,
-	0x7e, 0x0f, 0xf3, 0x9e, 0xea, 0xc4, 0x9a, 0xb7, 0x7f, 0x53, 0x38, 0x40, 0x33, 0xfd, 0x0a, 0xf3,
-	0xde, 0xa4, 0xea, 0xdd, 0xd1, 0x9f, 0x71, 0x26, 0xe2, 0x3c, 0x1d, 0x73, 0x01, 0xed, 0x2c, 0xad,
-	0xca, 0xed, 0x9e, 0x98, 0xed, 0xae, 0x2d, 0xba, 0xdd, 0x93, 0x87, 0xb6, 0x7b, 0x32, 0xbb, 0x5d,
-	0x6d, 0x53, 0xc6, 0x78, 0x61, 0x62, 0xac, 0x2f, 0x1a, 0xe3, 0xc5, 0x43, 0x31, 0x5e, 0xcc, 0xc6,
-	0xd0, 0x36, 0xb2, 0x2f, 0xe7, 0xf2, 0xec, 0x58, 0x0b, 0xf7, 0xe5, 0xbd, 0x0a, 0x35, 0x4b, 0x89,
-	0x66, 0xbf, 0x06, 0xed, 0x80, 0x66, 0x5c, 0x48, 0x59, 0x46, 0xfb, 0x09, 0x31, 0x21, 0x6a, 0x2a,
-	0xc4, 0x8b, 0xc7, 0x42, 0x3c, 0x35, 0x5f, 0xfe, 0x03, 0xee, 0x2e, 0xda, 0x9a, 0x15, 0xeb, 0x60,
-	0x3e, 0xb0, 0xfb, 0x44, 0x10, 0xc6, 0xbb, 0x39, 0x8b, 0x4c, 0x20, 0xa0, 0x02, 0xfd, 0xe4, 0xb1,
-	0x40, 0xa6, 0x43, 0xe7, 0x5d, 0x5d, 0xd4, 0x9a, 0x88, 0x74, 0x80, 0xdf, 0x83, 0x66, 0x2c, 0xa3,
-	0x76, 0xf3, 0xc4, 0xd0, 0xd7, 0x15, 0xfd, 0xf1, 0x63, 0xf4, 0xe6, 0xab, 0x9a, 0x75, 0x74, 0xd1,
-	0xc6, 0x58, 0xa0, 0xa9, 0x43, 0x00, 0xd3, 0x3c, 0x66, 0x7e, 0x94, 0xe0, 0x20, 0x26, 0xcc, 0xd0,
-	0x37, 0x14, 0xfd, 0x27, 0x8f, 0xd1, 0x7f, 0xa8, 0xe9, 0xef, 0x3b, 0xbb, 0xc8, 0x96, 0xc2, 0x5f,
-	0x6a, 0x99, 0x8e, 0x72, 0x09, 0x1a, 0x5d, 0xc2, 0x92, 0x38, 0x33, 0xfc, 0x1b, 0x8a, 0xff, 0xd9,
-	0x63, 0xfc, 0xa6, 0x83, 0xa6, 0xdd, 0x5c, 0x54, 0xd7, 0xb0, 0x24, 0x4d, 0x68, 0x16, 0xd2, 0x31,
-	0xe9, 0xe6, 0xc2, 0xa4, 0xd3, 0x6e, 0x2e, 0xaa, 0x6b, 0xa8, 0x49, 0x23, 0xb0, 0x85, 0x19, 0xa3,
-	0x6f, 0xe7, 0x0a, 0x02, 0x15, 0xf7, 0xa7, 0x8f, 0x71, 0x3f, 0xd1, 0xdc, 0x0f, 0x78, 0xbb, 0x68,
-	0x53, 0x49, 0x67, 0x4a, 0x12, 0x02, 0x18, 0x31, 0x3c, 0x9a, 0x8b, 0xd3, 0x5e, 0xb8, 0xf0, 0xf7,
-	0x9d, 0x5d, 0x64, 0x4b, 0xe1, 0x4c, 0x94, 0x6f, 0x41, 0x3b, 0x25, 0x2c, 0x22, 0x7e, 0x46, 0x04,
-	0xef, 0x27, 0xb1, 0x30, 0x71, 0xb6, 0x17, 0xfe, 0x0e, 0x1e, 0x72, 0x77, 0x11, 0x54, 0xe2, 0xaf,
-	0x8c, 0xb4, 0xec, 0x52, 0xde, 0xc3, 0x59, 0xd4, 0xc3, 0xb1, 0x89, 0xb2, 0xb3, 0x70, 0x97, 0xce,
-	0x3a, 0xba, 0x68, 0x63, 0x2c, 0x28, 0x8f, 0x3a, 0xc0, 0x59, 0x90, 0x8f, 0x8f, 0xfa, 0x83, 0x85,
-	0x8f, 0x7a, 0xda, 0x4d, 0x0e, 0x70, 0x05, 0x15, 0xe9, 0x79, 0xd5, 0x6a, 0xda, 0xad, 0xf3, 0xaa,
-	0xd5, 0xb2, 0xed, 0xf3, 0xaa, 0x65, 0xdb, 0x9b, 0xe7, 0x55, 0x6b, 0xcb, 0x6e, 0xa3, 0x8d, 0x11,
-	0x4d, 0xa8, 0x3f, 0x78, 0xae, 0x9d, 0x50, 0x9d, 0xbc, 0xc5, 0xdc, 0xfc, 0xd0, 0xa0, 0x66, 0x80,
-	0x05, 0x4e, 0x46, 0xdc, 0x14, 0x02, 0xd9, 0xba, 0x3c, 0x53, 0x63, 0xeb, 0x10, 0xac, 0x5e, 0x0a,
-	0x79, 0xf5, 0xb1, 0x41, 0xe5, 0x9a, 0x8c, 0xf4, 0xb0, 0x45, 0x72, 0x09, 0xdb, 0x60, 0x75, 0x80,
-	0x93, 0x5c, 0xdf, 0xa1, 0x6a, 0x48, 0x03, 0xf7, 0x02, 0xb4, 0xae, 0x18, 0xce, 0xb8, 0x1c, 0xff,
-	0x34, 0x7b, 0x43, 0x23, 0x0e, 0x21, 0xa8, 0xaa, 0x39, 0xa1, 0x7d, 0xd5, 0x1a, 0xfe, 0x18, 0x54,
-	0x13, 0x1a, 0xf1, 0xce, 0xca, 0x5e, 0x65, 0xbf, 0x7e, 0xbc, 0x7d, 0xff, 0x16, 0xf3, 0x86, 0x46,
-	0x48, 0x99, 0xb8, 0x7f, 0x5f, 0x01, 0x95, 0x37, 0x34, 0x82, 0x1d, 0xb0, 0x8e, 0xc3, 0x90, 0x11,
-	0xce, 0x0d, 0xd3, 0x18, 0xc2, 0x1d, 0xb0, 0x26, 0x68, 0x3f, 0x0e, 0x34, 0x5d, 0x0d, 0x19, 0x24,
-	0x03, 0x87, 0x58, 0x60, 0x35, 0x58, 0x1b, 0x48, 0xad, 0xe5, 0x25, 0x44, 0x65, 0xe6, 0x67, 0x79,
-	0xda, 0x25, 0x4c, 0xcd, 0xc7, 0xaa, 0xd7, 0xba, 0x2d, 0x9c, 0xba, 0x92, 0x7f, 0xa5, 0xc4, 0x68,
-	0x1a, 0xc0, 0x8f, 0xc0, 0xba, 0x18, 0x4e, 0xcf, 0xba, 0xad, 0xdb, 0xc2, 0x69, 0x89, 0x49, 0x9a,
-	0x72, 0x94, 0xa1, 0x35, 0x31, 0x54, 0x23, 0xed, 0x10, 0x58, 0x62, 0xe8, 0xc7, 0x59, 0x48, 0x86,
-	0x6a, 0x9c, 0x55, 0xbd, 0xf6, 0x6d, 0xe1, 0xd8, 0x53, 0xe6, 0x67, 0x52, 0x87, 0xd6, 0xc5, 0x50,
-	0x2d, 0xe0, 0x47, 0x00, 0xe8, 0x2d, 0xa9, 0x08, 0x7a, 0x3a, 0x6d, 0xdc, 0x16, 0x4e, 0x4d, 0x49,
-	0x15, 0xf7, 0x64, 0x09, 0x5d, 0xb0, 0xaa, 0xb9, 0x2d, 0xc5, 0xdd, 0xb8, 0x2d, 0x1c, 0x2b, 0xa1,
-	0x91, 0xe6, 0xd4, 0x2a, 0x59, 0x2a, 0x46, 0x52, 0x3a, 0x20, 0xa1, 0x1a, 0x11, 0x16, 0x1a, 0x43,
-	0xf7, 0xaf, 0x2b, 0xc0, 0xba, 0x1a, 0x22, 0xc2, 0xf3, 0x44, 0xc0, 0x2f, 0x80, 0x1d, 0xd0, 0x4c,
-	0x30, 0x1c, 0x08, 0x7f, 0xa6, 0xb4, 0xde, 0xd3, 0xc9, 0x0f, 0xfa, 0xbc, 0x85, 0x8b, 0x5a, 0x63,
-	0xd1, 0x4b, 0x53, 0xff, 0x36, 0x58, 0xed, 0x26, 0x94, 0xa6, 0xaa, 0x13, 0x1a, 0x48, 0x03, 0x88,
-	0x54, 0xd5, 0xd4, 0x29, 0x57, 0xd4, 0x5d, 0xf5, 0x87, 0xf7, 0x4f, 0x79, 0xae, 0x55, 0xbc, 0x1d,
-	0x73, 0x5f, 0x6d, 0xea, 0xd8, 0xc6, 0xdf, 0x95, 0xb5, 0x55, 0xad, 0x64, 0x83, 0x0a, 0x23, 0x42,
-	0x1d, 0x5a, 0x03, 0xc9, 0x25, 0x7c, 0x02, 0x2c, 0x46, 0x06, 0x84, 0x09, 0x12, 0xaa, 0xc3, 0xb1,
-	0x50, 0x89, 0xe1, 0x87, 0xc0, 0x8a, 0x30, 0xf7, 0x73, 0x4e, 0x42, 0x7d, 0x12, 0x68, 0x3d, 0xc2,
-	0xfc, 0x6b, 0x4e, 0xc2, 0xcf, 0xaa, 0x7f, 0xf9, 0xce, 0x59, 0x72, 0x31, 0xa8, 0xbf, 0x0c, 0x02,
-	0xc2, 0xf9, 0x55, 0xde, 0x4f, 0xc8, 0x23, 0x1d, 0x76, 0x0c, 0x1a, 0x5c, 0x50, 0x86, 0x23, 0xe2,
-	0x5f, 0x93, 0x91, 0xe9, 0x33, 0xdd, 0x35, 0x46, 0xfe, 0x6b, 0x32, 0xe2, 0x68, 0x1a, 0x98, 0x10,
-	0xdf, 0x55, 0x41, 0xfd, 0x8a, 0xe1, 0x80, 0x98, 0x0b, 0xac, 0xec, 0x55, 0x09, 0x99, 0x09, 0x61,
-	0x90, 0x8c, 0x2d, 0xe2, 0x94, 0xd0, 0x5c, 0x98, 0xef, 0x69, 0x0c, 0xa5, 0x07, 0x23, 0x64, 0x48,
-	0x02, 0x55, 0xc6, 0x2a, 0x32, 0x08, 0x9e, 0x80, 0x8d, 0x30, 0xe6, 0xea, 0xc1, 0xc1, 0x05, 0x0e,
-	0xae, 0x75, 0xfa, 0x9e, 0x7d, 0x5b, 0x38, 0x0d, 0xa3, 0xb8, 0x94, 0x72, 0x34, 0x83, 0xe0, 0xe7,
-	0xa0, 0x35, 0x71, 0x53, 0xbb, 0xd5, 0x57, 0x7c, 0x0f, 0xde, 0x16, 0x4e, 0xb3, 0x34, 0x55, 0x1a,
-	0x34, 0x87, 0xe5, 0x49, 0x87, 0xa4, 0x9b, 0x47, 0xaa, 0xf9, 0x2c, 0xa4, 0x81, 0x94, 0x26, 0x71,
-	0x1a, 0x0b, 0xd5, 0x6c, 0xab, 0x48, 0x03, 0xf8, 0x39, 0xa8, 0xd1, 0x01, 0x61, 0x2c, 0x0e, 0x09,
-	0x57, 0x17, 0x88, 0xff, 0xf7, 0x5a, 0x41, 0x13, 0x7b, 0x99, 0x9c, 0x79, 0x4c, 0xa5, 0x24, 0xa5,
-	0x6c, 0xa4, 0xae, 0x08, 0x26, 0x39, 0xad, 0xf8, 0x52, 0xc9, 0xd1, 0x0c, 0x82, 0x1e, 0x80, 0xc6,
-	0x8d, 0x11, 0x91, 0xb3, 0xcc, 0x57, 0xdf, 0x7f, 0x43, 0xf9, 0xaa, 0xaf, 0x50, 0x6b, 0x91, 0x52,
-	0xbe, 0xc6, 0x02, 0xa3, 0x7b, 0x12, 0xf8, 0x33, 0x00, 0xf5, 0x99, 0xf8, 0xdf, 0x72, 0x5a, 0x3e,
-	0xb7, 0xf4, 0x8c, 0x57, 0xf1, 0xb5, 0xd6, 0xec, 0xd9, 0xd6, 0xe8, 0x9c, 0x53, 0x93, 0xc5, 0x79,
-	0xd5, 0xaa, 0xda, 0xab, 0xe7, 0x55, 0x6b, 0xdd, 0xb6, 0xca, 0xfa, 0x99, 0x2c, 0xd0, 0xd6, 0x18,
-	0x4f, 0x6d, 0xcf, 0xfb, 0xc5, 0xf7, 0x37, 0xbb, 0xcb, 0xef, 0x6e, 0x76, 0x97, 0xff, 0x75, 0xb3,
-	0xbb, 0xfc, 0xb7, 0xf7, 0xbb, 0x4b, 0xef, 0xde, 0xef, 0x2e, 0xfd, 0xe3, 0xfd, 0xee, 0xd2, 0x1f,
-	0x7e, 0x14, 0xc5, 0xa2, 0x97, 0x77, 0x0f, 0x02, 0x9a, 0xca, 0xa7, 0x32, 0xe5, 0xe6, 0xef, 0xe0,
-	0xe8, 0x93, 0xc3, 0xa1, 0x7a, 0x43, 0x8b, 0x51, 0x9f, 0xf0, 0xee, 0x9a, 0x7a, 0x1b, 0x3f, 0xff,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x73, 0x0c, 0x86, 0x61, 0x61, 0x0f, 0x00, 0x00,
+	// 1992 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0x5b, 0x4f, 0x24, 0xc7,
+	0x15, 0x5e, 0x76, 0x06, 0xe8, 0xa9, 0xb9, 0x35, 0xc5, 0xc0, 0xb6, 0x77, 0x15, 0x9a, 0x74, 0xa4,
+	0x84, 0x28, 0x6b, 0x58, 0xd8, 0x60, 0xaf, 0xed, 0xdc, 0x76, 0x58, 0xbc, 0x01, 0xaf, 0x6d, 0x54,
+	0xac, 0x13, 0xe5, 0xa6, 0x56, 0x4d, 0x77, 0x31, 0xd3, 0xa6, 0xbb, 0xab, 0x55, 0x55, 0x33, 0x3b,
+	0xec, 0x2f, 0x88, 0x94, 0x97, 0xfc, 0x84, 0xfc, 0x91, 0xbc, 0x5b, 0x79, 0xf2, 0x63, 0x94, 0x87,
+	0x56, 0xc4, 0x2a, 0x2f, 0x48, 0x79, 0xe1, 0x17, 0x44, 0x75, 0x99, 0x9e, 0x0b, 0x64, 0xcc, 0x0b,
+	0xd4, 0xb9, 0x7d, 0xe7, 0xd4, 0x39, 0xa7, 0xba, 0x4e, 0x0d, 0x78, 0x48, 0x44, 0x8f, 0xb0, 0x24,
+	0x4a, 0xc5, 0x0e, 0x19, 0x24, 0x3b, 0x83, 0x5d, 0xf9, 0x6f, 0x3b, 0x63, 0x54, 0x50, 0x68, 0x17,
+	0xb2, 0x6d, 0xc9, 0x1c, 0xec, 0x3e, 0x6c, 0x75, 0x69, 0x97, 0x2a, 0xe1, 0x8e, 0x5c, 0x69, 0x3d,
+	0xef, 0xef, 0xcb, 0x60, 0xe9, 0x04, 0x33, 0x9c, 0x70, 0xb8, 0x0b, 0x2a, 0x64, 0x90, 0xf8, 0x21,
+	0x49, 0x69, 0xe2, 0x2c, 0x6c, 0x2e, 0x6c, 0x55, 0xda, 0xad, 0xeb, 0xdc, 0xb5, 0x2f, 0x70, 0x12,
+	0x7f, 0xec, 0x15, 0x22, 0x0f, 0x59, 0x64, 0x90, 0xbc, 0x90, 0x4b, 0xf8, 0x73, 0x50, 0x27, 0x29,
+	0xee, 0xc4, 0xc4, 0x0f, 0x18, 0xc1, 0x82, 0x38, 0xf7, 0x37, 0x17, 0xb6, 0xac, 0xb6, 0x73, 0x9d,
+	0xbb, 0x2d, 0x63, 0x36, 0x29, 0xf6, 0x50, 0x4d, 0xd3, 0x07, 0x8a, 0x84, 0x1f, 0x82, 0xea, 0x48,
+	0x8e, 0xe3, 0xd8, 0x29, 0x29, 0xe3, 0xf5, 0xeb, 0xdc, 0x85, 0xd3, 0xc6, 0x38, 0x8e, 0x3d, 0x04,
+	0x8c, 0x29, 0x8e, 0x63, 0xf8, 0x1c, 0x00, 0x32, 0x14, 0x0c, 0xfb, 0x24, 0xca, 0xb8, 0x53, 0xde,
+	0x2c, 0x6d, 0x95, 0xda, 0xde, 0x65, 0xee, 0x56, 0x0e, 0x25, 0xf7, 0xf0, 0xe8, 0x84, 0x5f, 0xe7,
+	0xee, 0x8a, 0x01, 0x29, 0x14, 0x3d, 0x54, 0x51, 0xc4, 0x61, 0x94, 0x71, 0xf8, 0x27, 0x50, 0x0b,
+	0x7a, 0x38, 0x4a, 0xfd, 0x80, 0xa6, 0x67, 0x51, 0xd7, 0x59, 0xdc, 0x5c, 0xd8, 0xaa, 0xee, 0x7d,
+	0x6f, 0x7b, 0x36, 0x6f, 0xdb, 0x07, 0x52, 0xeb, 0x40, 0x29, 0xb5, 0x1f, 0x7d, 0x93, 0xbb, 0xf7,
+	0xae, 0x73, 0x77, 0x55, 0x43, 0x4f, 0x02, 0x78, 0xa8, 0x1a, 0x8c, 0x35, 0xe1, 0x1e, 0x58, 0xc3,
+	0x71, 0x4c, 0xdf, 0xf8, 0xfd, 0x54, 0x26, 0x9a, 0x04, 0x82, 0x84, 0xbe, 0x18, 0x72, 0x67, 0x49,
+	0x6e, 0x12, 0xad, 0x2a, 0xe1, 0x57, 0x63, 0xd9, 0xeb, 0x21, 0x87, 0xef, 0x03, 0x88, 0x03, 0x11,
+	0x0d, 0x88, 0x9f, 0x31, 0x12, 0xd0, 0x24, 0x8b, 0x62, 0xc2, 0x9d, 0xe5, 0xcd, 0xd2, 0x56, 0x05,
+	0xad, 0x68, 0xc9, 0xc9, 0x58, 0x00, 0xf7, 0x40, 0x4d, 0x16, 0x25, 0xe8, 0xe1, 0x34, 0x25, 0x31,
+	0x77, 0x2c, 0xa9, 0xd8, 0x6e, 0x5e, 0xe6, 0x6e, 0xf5, 0xf0, 0x37, 0x9f, 0x1f, 0x18, 0x36, 0xaa,
+	0x92, 0x41, 0x32, 0x22, 0xe0, 0x0e, 0x58, 0xe5, 0x41, 0x8f, 0x84, 0xfd, 0x98, 0x84, 0x32, 0x70,
+	0xc1, 0x70, 0x20, 0xb8, 0x53, 0x51, 0x3e, 0x60, 0x21, 0x3a, 0x18, 0x49, 0xe0, 0x63, 0x00, 0x27,
+	0x0c, 0x70, 0x1c, 0xfb, 0x5d, 0xcc, 0x1d, 0xb0, 0xb9, 0xb0, 0x55, 0x46, 0xf6, 0x58, 0x1f, 0xc7,
+	0xf1, 0x4b, 0xcc, 0xe1, 0xcf, 0xc0, 0xc3, 0xb1, 0x36, 0xc9, 0x68, 0xd0, 0xf3, 0xa3, 0x90, 0xa4,
+	0x22, 0x3a, 0x8b, 0x08, 0x73, 0xaa, 0xb2, 0xa7, 0x90, 0x53, 0x68, 0x1c, 0x4a, 0x85, 0xa3, 0x42,
+	0x0e, 0xff, 0x00, 0x5a, 0xe3, 0x8d, 0x4b, 0x3f, 0x7e, 0x40, 0xb9, 0xe0, 0x4e, 0x6d, 0xb3, 0xb4,
+	0x55, 0xdd, 0xfb, 0xc1, 0xcd, 0xd2, 0x8c, 0xb3, 0xf1, 0x12, 0xf3, 0x03, 0xca, 0x45, 0xbb, 0x2c,
+	0x0b, 0x84, 0x60, 0x36, 0x2b, 0xe0, 0x70, 0x1d, 0x2c, 0x65, 0xb8, 0xcf, 0x49, 0xe8, 0xd4, 0x55,
+	0x05, 0x0c, 0x05, 0x7f, 0x02, 0x56, 0x3a, 0x31, 0x0d, 0xce, 0x49, 0xe8, 0xe3, 0x30, 0x64, 0x84,
+	0x73, 0xc2, 0x9d, 0x86, 0xca, 0x87, 0x6d, 0x04, 0xcf, 0x47, 0x7c, 0x59, 0xd5, 0x33, 0x46, 0x26,
+	0xeb, 0xe3, 0x33, 0x82, 0x43, 0xee, 0x34, 0x55, 0x42, 0x56, 0xa5, 0x70, 0x1c, 0x14, 0x92, 0x22,
+	0xe8, 0x81, 0x7a, 0x82, 0x87, 0x7e, 0x40, 0x43, 0xe2, 0xf3, 0xe8, 0x2d, 0x71, 0x6c, 0xa5, 0x5b,
+	0x4d, 0xf0, 0xf0, 0x80, 0x86, 0xe4, 0x34, 0x7a, 0x4b, 0xe0, 0x33, 0xd0, 0x30, 0xbd, 0x4e, 0xa2,
+	0xec, 0xe9, 0xfe, 0xde, 0x47, 0xce, 0x8a, 0x3a, 0x0b, 0x2b, 0x97, 0xb9, 0x5b, 0x3f, 0x54, 0x92,
+	0xc3, 0xa3, 0x13, 0x29, 0x40, 0xe6, 0xc0, 0x1d, 0x6a, 0x3d, 0xaf, 0x07, 0x56, 0x6e, 0x64, 0x01,
+	0x3a, 0x60, 0xd9, 0xec, 0x45, 0x9f, 0x63, 0x34, 0x22, 0xe1, 0x8f, 0x40, 0x33, 0x21, 0xa2, 0x47,
+	0x43, 0x9f, 0x93, 0x98, 0x04, 0x82, 0x32, 0x75, 0x64, 0x2b, 0xa8, 0xa1, 0xd9, 0xa7, 0x86, 0x0b,
+	0x6d, 0x50, 0x92, 0x85, 0x2e, 0xa9, 0x58, 0xe5, 0xd2, 0xfb, 0x4f, 0x13, 0x54, 0x27, 0xce, 0x02,
+	0xfc, 0x23, 0x68, 0xf6, 0x68, 0x42, 0xb8, 0x20, 0x38, 0xf4, 0x55, 0xa6, 0xcc, 0x47, 0xe3, 0xe9,
+	0xbf, 0x72, 0x77, 0x2d, 0xa0, 0x3c, 0xa1, 0x9c, 0x87, 0xe7, 0xdb, 0x11, 0xdd, 0x49, 0xb0, 0xe8,
+	0x6d, 0x1f, 0xa5, 0xe2, 0x3a, 0x77, 0xd7, 0xf5, 0xc9, 0x99, 0xb1, 0xf4, 0x50, 0xa3, 0xe0, 0xb4,
+	0x25, 0x03, 0xf6, 0x40, 0x23, 0xc4, 0xd4, 0x3f, 0xa3, 0xec, 0xdc, 0x80, 0xab, 0x38, 0xdb, 0xed,
+	0xff, 0x0b, 0x7e, 0x99, 0xbb, 0xb5, 0x17, 0xcf, 0xbf, 0xfc, 0x94, 0xb2, 0x73, 0x05, 0x71, 0x9d,
+	0xbb, 0x6b, 0xda, 0xd9, 0x34, 0x90, 0x87, 0x6a, 0x21, 0xa6, 0x85, 0x1a, 0xfc, 0x2d, 0xb0, 0x0b,
+	0x05, 0xde, 0xcf, 0x32, 0xca, 0x84, 0xf9, 0x12, 0xbd, 0x7f, 0x99, 0xbb, 0x0d, 0x03, 0x79, 0xaa,
+	0x25, 0xd7, 0xb9, 0xfb, 0x60, 0x06, 0xd4, 0xd8, 0x78, 0xa8, 0x61, 0x60, 0x8d, 0x2a, 0xec, 0x80,
+	0x1a, 0x89, 0xb2, 0xdd, 0xfd, 0x27, 0x66, 0x03, 0x65, 0xb5, 0x81, 0x5f, 0xce, 0xdb, 0x40, 0xf5,
+	0xf0, 0xe8, 0x64, 0x77, 0xff, 0xc9, 0x28, 0x7e, 0xf3, 0x99, 0x99, 0x44, 0xf1, 0x50, 0x55, 0x93,
+	0x3a, 0xf8, 0x23, 0x60, 0x48, 0xbf, 0x87, 0x79, 0x4f, 0x7d, 0xc4, 0x2a, 0xed, 0xad, 0xcb, 0xdc,
+	0x05, 0x1a, 0xe9, 0xd7, 0x98, 0xf7, 0xc6, 0x59, 0xef, 0x5c, 0xbc, 0xc5, 0xa9, 0x88, 0xfa, 0xc9,
+	0x08, 0x0b, 0x68, 0x63, 0xa9, 0x55, 0x84, 0xbb, 0x6f, 0xc2, 0x5d, 0xba, 0x6b, 0xb8, 0xfb, 0xb7,
+	0x85, 0xbb, 0x3f, 0x1d, 0xae, 0xd6, 0x29, 0x7c, 0x3c, 0x33, 0x3e, 0x96, 0xef, 0xea, 0xe3, 0xd9,
+	0x6d, 0x3e, 0x9e, 0x4d, 0xfb, 0xd0, 0x3a, 0xb2, 0x2f, 0x67, 0xf6, 0xe9, 0x58, 0x77, 0xee, 0xcb,
+	0x1b, 0x19, 0x6a, 0x14, 0x1c, 0x8d, 0x7e, 0x0e, 0x5a, 0x01, 0x4d, 0xb9, 0x90, 0xbc, 0x94, 0x66,
+	0x31, 0x31, 0x2e, 0x2a, 0xca, 0xc5, 0xb3, 0x79, 0x2e, 0x1e, 0x99, 0x4b, 0xe3, 0x16, 0x73, 0x0f,
+	0xad, 0x4e, 0xb3, 0xb5, 0x33, 0x1f, 0xd8, 0x19, 0x11, 0x84, 0xf1, 0x4e, 0x9f, 0x75, 0x8d, 0x23,
+	0xa0, 0x1c, 0xfd, 0x74, 0x9e, 0x23, 0xd3, 0xa1, 0xb3, 0xa6, 0x1e, 0x6a, 0x8e, 0x59, 0xda, 0xc1,
+	0xef, 0x40, 0x23, 0x92, 0x5e, 0x3b, 0xfd, 0xd8, 0xc0, 0xab, 0x6f, 0x74, 0x7b, 0x6f, 0x1e, 0xbc,
+	0x39, 0x55, 0xd3, 0x86, 0x1e, 0xaa, 0x8f, 0x18, 0x1a, 0x3a, 0x04, 0x30, 0xe9, 0x47, 0xcc, 0xef,
+	0xc6, 0x38, 0x88, 0x08, 0x33, 0xf0, 0x35, 0x05, 0xff, 0xc1, 0x3c, 0xf8, 0xf7, 0x34, 0xfc, 0x4d,
+	0x63, 0x0f, 0xd9, 0x92, 0xf9, 0x52, 0xf3, 0xb4, 0x97, 0x53, 0x50, 0xeb, 0x10, 0x16, 0x47, 0xa9,
+	0xc1, 0xaf, 0x2b, 0xfc, 0x27, 0xf3, 0xf0, 0x4d, 0x07, 0x4d, 0x9a, 0x79, 0xa8, 0xaa, 0xc9, 0x02,
+	0x34, 0xa6, 0x69, 0x48, 0x47, 0xa0, 0x2b, 0x77, 0x06, 0x9d, 0x34, 0xf3, 0x50, 0x55, 0x93, 0x1a,
+	0xb4, 0x0b, 0x56, 0x31, 0x63, 0xf4, 0xcd, 0x4c, 0x42, 0xa0, 0xc2, 0xfe, 0x70, 0x1e, 0xf6, 0x43,
+	0x8d, 0x7d, 0x8b, 0xb5, 0x87, 0x56, 0x14, 0x77, 0x2a, 0x25, 0x21, 0x80, 0x5d, 0x86, 0x2f, 0x66,
+	0xfc, 0xb4, 0xee, 0x9c, 0xf8, 0x9b, 0xc6, 0x1e, 0xb2, 0x25, 0x73, 0xca, 0xcb, 0xd7, 0xa0, 0x95,
+	0x10, 0xd6, 0x25, 0x7e, 0x4a, 0x04, 0xcf, 0xe2, 0x48, 0x18, 0x3f, 0x6b, 0x77, 0x3e, 0x07, 0xb7,
+	0x99, 0x7b, 0x08, 0x2a, 0xf6, 0x17, 0x86, 0x5b, 0x74, 0x29, 0xef, 0xe1, 0xb4, 0xdb, 0xc3, 0x91,
+	0xf1, 0xb2, 0x7e, 0xe7, 0x2e, 0x9d, 0x36, 0xf4, 0x50, 0x7d, 0xc4, 0x28, 0x4a, 0x1d, 0xe0, 0x34,
+	0xe8, 0x8f, 0x4a, 0xfd, 0xe0, 0xce, 0xa5, 0x9e, 0x34, 0x93, 0xb3, 0x9f, 0x22, 0x35, 0xe8, 0x19,
+	0xa8, 0x93, 0x28, 0xdb, 0xfb, 0xe8, 0xe9, 0xe8, 0x53, 0xea, 0x28, 0xd4, 0xe7, 0x73, 0xaf, 0xae,
+	0xc3, 0xa3, 0x13, 0x69, 0x31, 0xfa, 0xce, 0xb5, 0x8a, 0xef, 0xdc, 0x18, 0x47, 0x8e, 0xcf, 0x51,
+	0x56, 0x68, 0x1d, 0x97, 0xad, 0x86, 0xdd, 0x3c, 0x2e, 0x5b, 0x4d, 0xdb, 0x3e, 0x2e, 0x5b, 0xb6,
+	0xbd, 0x72, 0x5c, 0xb6, 0x56, 0xed, 0x16, 0xaa, 0x5f, 0xd0, 0x98, 0xfa, 0x83, 0xa7, 0xda, 0x0a,
+	0x55, 0xc9, 0x1b, 0xcc, 0xcd, 0x07, 0x0d, 0x35, 0x02, 0x2c, 0x70, 0x7c, 0xc1, 0x4d, 0xc2, 0x91,
+	0xad, 0xcb, 0x30, 0x71, 0x3d, 0xee, 0x80, 0xc5, 0x53, 0x21, 0xa7, 0x73, 0x1b, 0x94, 0xce, 0xc9,
+	0x85, 0x99, 0x20, 0xe4, 0x12, 0xb6, 0xc0, 0xe2, 0x00, 0xc7, 0x7d, 0x62, 0x66, 0x06, 0x4d, 0x78,
+	0x27, 0xa0, 0xf9, 0x9a, 0xe1, 0x94, 0xcb, 0x09, 0x95, 0xa6, 0xaf, 0x68, 0x97, 0x43, 0x08, 0xca,
+	0xea, 0x3e, 0xd2, 0xb6, 0x6a, 0x0d, 0x7f, 0x0c, 0xca, 0x31, 0xed, 0x72, 0xe7, 0xbe, 0x9a, 0xe6,
+	0xd6, 0x6e, 0x4e, 0x73, 0xaf, 0x68, 0x17, 0x29, 0x15, 0xef, 0x1f, 0xf7, 0x41, 0xe9, 0x15, 0xed,
+	0xce, 0x99, 0x63, 0xd6, 0xc1, 0x92, 0xa0, 0x59, 0x14, 0x68, 0xb8, 0x0a, 0x32, 0x94, 0x74, 0x1c,
+	0x62, 0x81, 0xd5, 0x05, 0x5e, 0x43, 0x6a, 0x2d, 0xe7, 0x64, 0xb5, 0x33, 0x3f, 0xed, 0x27, 0x1d,
+	0xc2, 0xd4, 0x3d, 0x5c, 0x6e, 0x37, 0xaf, 0x72, 0xb7, 0xaa, 0xf8, 0x5f, 0x28, 0x36, 0x9a, 0x24,
+	0xe0, 0x63, 0xb0, 0x2c, 0x86, 0x93, 0x77, 0xea, 0xea, 0x55, 0xee, 0x36, 0xc5, 0x78, 0x9b, 0xf2,
+	0xca, 0x44, 0x4b, 0x62, 0x28, 0xff, 0xc3, 0x1d, 0x60, 0x89, 0xa1, 0x1f, 0xa5, 0x21, 0x19, 0xaa,
+	0x6b, 0xb3, 0xdc, 0x6e, 0x5d, 0xe5, 0xae, 0x3d, 0xa1, 0x7e, 0x24, 0x65, 0x68, 0x59, 0x0c, 0xd5,
+	0x02, 0x3e, 0x06, 0x40, 0x87, 0xa4, 0x3c, 0xe8, 0x5b, 0xb0, 0x7e, 0x95, 0xbb, 0x15, 0xc5, 0x55,
+	0xd8, 0xe3, 0x25, 0xf4, 0xc0, 0xa2, 0xc6, 0xb6, 0x14, 0x76, 0xed, 0x2a, 0x77, 0xad, 0x98, 0x76,
+	0x35, 0xa6, 0x16, 0xc9, 0x54, 0x31, 0x92, 0xd0, 0x01, 0x09, 0xd5, 0x55, 0x64, 0xa1, 0x11, 0xe9,
+	0xfd, 0xe5, 0x3e, 0xb0, 0x5e, 0x0f, 0x11, 0xe1, 0xfd, 0x58, 0xc0, 0x4f, 0x81, 0x3d, 0x9a, 0xfa,
+	0xfd, 0xa9, 0xd4, 0xb6, 0x1f, 0x8d, 0x2f, 0x8e, 0x59, 0x0d, 0x0f, 0x35, 0x47, 0x2c, 0x33, 0x0a,
+	0xcb, 0x4e, 0xe8, 0xc4, 0x94, 0x26, 0xaa, 0x13, 0x6a, 0x48, 0x13, 0x10, 0xa9, 0xac, 0xa9, 0x2a,
+	0x97, 0xd4, 0x73, 0xea, 0xfb, 0x37, 0xab, 0x3c, 0xd3, 0x2a, 0xed, 0x75, 0xf3, 0xa4, 0x6a, 0x68,
+	0xdf, 0xc6, 0xde, 0x93, 0xb9, 0x55, 0xad, 0x64, 0x83, 0x12, 0x23, 0x42, 0x15, 0xad, 0x86, 0xe4,
+	0x12, 0x3e, 0x04, 0x16, 0x23, 0x03, 0xc2, 0x04, 0x09, 0x55, 0x71, 0x2c, 0x54, 0xd0, 0xf0, 0x3d,
+	0x60, 0xc9, 0x77, 0x83, 0x9a, 0xf3, 0x55, 0x25, 0xd0, 0x72, 0x17, 0xf3, 0xaf, 0x38, 0x09, 0x3f,
+	0x2e, 0xff, 0xf9, 0x6f, 0xee, 0x3d, 0x0f, 0x83, 0xea, 0xf3, 0x20, 0x20, 0x9c, 0xbf, 0xee, 0x67,
+	0x31, 0x99, 0xd3, 0x61, 0x7b, 0xa0, 0xc6, 0x05, 0x65, 0xb8, 0x4b, 0xfc, 0x73, 0x72, 0x61, 0xfa,
+	0x4c, 0x77, 0x8d, 0xe1, 0x7f, 0x46, 0x2e, 0x38, 0x9a, 0x24, 0x8c, 0x8b, 0xff, 0x96, 0x41, 0xf5,
+	0x35, 0xc3, 0x01, 0x31, 0x83, 0xb2, 0xec, 0x55, 0x49, 0x32, 0xe3, 0xc2, 0x50, 0xd2, 0xb7, 0x88,
+	0x12, 0x42, 0xfb, 0xc2, 0x9c, 0xa7, 0x11, 0x29, 0x2d, 0x18, 0x21, 0x43, 0x12, 0x98, 0xf9, 0xdb,
+	0x50, 0x70, 0x1f, 0xd4, 0xc3, 0x88, 0xab, 0x77, 0x02, 0x17, 0x38, 0x38, 0xd7, 0xdb, 0x6f, 0xdb,
+	0x57, 0xb9, 0x5b, 0x33, 0x82, 0x53, 0xc9, 0x47, 0x53, 0x14, 0xfc, 0x04, 0x34, 0xc7, 0x66, 0x2a,
+	0x5a, 0xfd, 0x0a, 0x6d, 0xc3, 0xab, 0xdc, 0x6d, 0x14, 0xaa, 0x4a, 0x82, 0x66, 0x68, 0x59, 0xe9,
+	0x90, 0x74, 0xfa, 0x5d, 0xd5, 0x7c, 0x16, 0xd2, 0x84, 0xe4, 0xc6, 0x51, 0x12, 0x09, 0xd5, 0x6c,
+	0x8b, 0x48, 0x13, 0xf0, 0x13, 0x50, 0xa1, 0x03, 0xc2, 0x58, 0x14, 0x12, 0xfd, 0x46, 0xfc, 0xae,
+	0x07, 0x35, 0x1a, 0xeb, 0xcb, 0xcd, 0x99, 0x37, 0x50, 0x42, 0x12, 0xca, 0x2e, 0x9c, 0xea, 0x78,
+	0x73, 0x5a, 0xf0, 0xb9, 0xe2, 0xa3, 0x29, 0x0a, 0xb6, 0x01, 0x34, 0x66, 0x8c, 0x88, 0x3e, 0x4b,
+	0x7d, 0x75, 0xfe, 0x6b, 0xca, 0x56, 0x9d, 0x42, 0x2d, 0x45, 0x4a, 0xf8, 0x02, 0x0b, 0x8c, 0x6e,
+	0x70, 0xe0, 0x2f, 0x00, 0xd4, 0x35, 0xf1, 0xbf, 0xe6, 0xb4, 0xf8, 0x45, 0x40, 0xcf, 0x12, 0xca,
+	0xbf, 0x96, 0x9a, 0x98, 0x6d, 0x4d, 0x1d, 0x73, 0x3a, 0x7a, 0x0a, 0x7d, 0x06, 0xd6, 0x4c, 0x0c,
+	0x34, 0x53, 0xef, 0xbc, 0x8c, 0xd1, 0xb3, 0x28, 0x26, 0x4e, 0x43, 0x85, 0xf1, 0xe0, 0x2a, 0x77,
+	0x57, 0xb5, 0xc2, 0x97, 0x4a, 0x7e, 0xa2, 0xc5, 0xe8, 0x36, 0xe6, 0x71, 0xd9, 0x2a, 0xdb, 0x8b,
+	0xc7, 0x65, 0x6b, 0xd9, 0xb6, 0x8a, 0x62, 0x98, 0x94, 0xa0, 0xd5, 0x11, 0x3d, 0xb1, 0xd7, 0xf6,
+	0xaf, 0xbe, 0xb9, 0xdc, 0x58, 0xf8, 0xf6, 0x72, 0x63, 0xe1, 0xdf, 0x97, 0x1b, 0x0b, 0x7f, 0x7d,
+	0xb7, 0x71, 0xef, 0xdb, 0x77, 0x1b, 0xf7, 0xfe, 0xf9, 0x6e, 0xe3, 0xde, 0xef, 0x7f, 0xd8, 0x8d,
+	0x44, 0xaf, 0xdf, 0xd9, 0x0e, 0x68, 0x22, 0x7f, 0x1a, 0xa2, 0xdc, 0xfc, 0x1d, 0xec, 0x7e, 0xb0,
+	0x33, 0x94, 0xeb, 0x1d, 0x71, 0x91, 0x11, 0xde, 0x59, 0x52, 0xbf, 0x05, 0x3d, 0xfd, 0xdf, 0x00,
+	0x66, 0xdc, 0xb7, 0x83, 0x51, 0x12, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -835,6 +1033,84 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EnableEIP3529 {
+		i--
+		if m.EnableEIP3529 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MaxCodeSize != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxCodeSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.FreePrecompileReads != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.FreePrecompileReads))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.BlockedAddresses) > 0 {
+		for iNdEx := len(m.BlockedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedAddresses[iNdEx])
+			copy(dAtA[i:], m.BlockedAddresses[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.BlockedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.PrecompileGasCosts) > 0 {
+		for iNdEx := len(m.PrecompileGasCosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrecompileGasCosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ScheduledEpochIdentifier) > 0 {
+		i -= len(m.ScheduledEpochIdentifier)
+		copy(dAtA[i:], m.ScheduledEpochIdentifier)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.ScheduledEpochIdentifier)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ScheduledCallGas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ScheduledCallGas))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ScheduledContracts) > 0 {
+		for iNdEx := len(m.ScheduledContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScheduledContracts[iNdEx])
+			copy(dAtA[i:], m.ScheduledContracts[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.ScheduledContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.EVMChannels) > 0 {
 		for iNdEx := len(m.EVMChannels) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EVMChannels[iNdEx])
@@ -922,6 +1198,48 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PrecompileGasCost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrecompileGasCost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrecompileGasCost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MethodSelector) > 0 {
+		i -= len(m.MethodSelector)
+		copy(dAtA[i:], m.MethodSelector)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.MethodSelector)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -942,6 +1260,20 @@ func (m *ChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EIP2935Block != nil {
+		{
+			size := m.EIP2935Block.Size()
+			i -= size
+			if _, err := m.EIP2935Block.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
 	if m.CancunBlock != nil {
 		{
 			size := m.CancunBlock.Size()
@@ -1472,6 +1804,16 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EnableOpcodeProfile {
+		i--
+		if m.EnableOpcodeProfile {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
 	if len(m.TracerJsonConfig) > 0 {
 		i -= len(m.TracerJsonConfig)
 		copy(dAtA[i:], m.TracerJsonConfig)
@@ -1619,6 +1961,63 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
+	if len(m.ScheduledContracts) > 0 {
+		for _, s := range m.ScheduledContracts {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.ScheduledCallGas != 0 {
+		n += 1 + sovEvm(uint64(m.ScheduledCallGas))
+	}
+	l = len(m.ScheduledEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if len(m.PrecompileGasCosts) > 0 {
+		for _, e := range m.PrecompileGasCosts {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.Paused {
+		n += 2
+	}
+	if len(m.BlockedAddresses) > 0 {
+		for _, s := range m.BlockedAddresses {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.FreePrecompileReads != 0 {
+		n += 1 + sovEvm(uint64(m.FreePrecompileReads))
+	}
+	if m.MaxCodeSize != 0 {
+		n += 2 + sovEvm(uint64(m.MaxCodeSize))
+	}
+	if m.EnableEIP3529 {
+		n += 3
+	}
+	return n
+}
+
+func (m *PrecompileGasCost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.MethodSelector)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovEvm(uint64(m.Gas))
+	}
 	return n
 }
 
@@ -1703,6 +2102,10 @@ func (m *ChainConfig) Size() (n int) {
 		l = m.CancunBlock.Size()
 		n += 2 + l + sovEvm(uint64(l))
 	}
+	if m.EIP2935Block != nil {
+		l = m.EIP2935Block.Size()
+		n += 2 + l + sovEvm(uint64(l))
+	}
 	return n
 }
 
@@ -1876,6 +2279,9 @@ func (m *TraceConfig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvm(uint64(l))
 	}
+	if m.EnableOpcodeProfile {
+		n += 2
+	}
 	return n
 }
 
@@ -1904,19 +2310,421 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableCreate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableCreate = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableCall", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableCall = bool(v != 0)
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExtraEIPs = append(m.ExtraEIPs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvm
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvm
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvm
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExtraEIPs) == 0 {
+					m.ExtraEIPs = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvm
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExtraEIPs = append(m.ExtraEIPs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraEIPs", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnprotectedTxs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowUnprotectedTxs = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePrecompiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivePrecompiles = append(m.ActivePrecompiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EVMChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EVMChannels = append(m.EVMChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledContracts = append(m.ScheduledContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCallGas", wireType)
+			}
+			m.ScheduledCallGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledCallGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileGasCosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrecompileGasCosts = append(m.PrecompileGasCosts, PrecompileGasCost{})
+			if err := m.PrecompileGasCosts[len(m.PrecompileGasCosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1944,13 +2752,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmDenom = string(dAtA[iNdEx:postIndex])
+			m.BlockedAddresses = append(m.BlockedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableCreate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreePrecompileReads", wireType)
 			}
-			var v int
+			m.FreePrecompileReads = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -1960,17 +2768,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.FreePrecompileReads |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableCreate = bool(v != 0)
-		case 3:
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableCall", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCodeSize", wireType)
 			}
-			var v int
+			m.MaxCodeSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -1980,93 +2787,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxCodeSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableCall = bool(v != 0)
-		case 4:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvm
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ExtraEIPs = append(m.ExtraEIPs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvm
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvm
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvm
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ExtraEIPs) == 0 {
-					m.ExtraEIPs = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvm
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ExtraEIPs = append(m.ExtraEIPs, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraEIPs", wireType)
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfig", wireType)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableEIP3529", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2076,48 +2806,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvm
+			m.EnableEIP3529 = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvm
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChainConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrecompileGasCost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnprotectedTxs", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.AllowUnprotectedTxs = bool(v != 0)
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrecompileGasCost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrecompileGasCost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivePrecompiles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2145,11 +2892,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActivePrecompiles = append(m.ActivePrecompiles, string(dAtA[iNdEx:postIndex]))
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EVMChannels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MethodSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2177,8 +2924,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EVMChannels = append(m.EVMChannels, string(dAtA[iNdEx:postIndex]))
+			m.MethodSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -2893,6 +3659,42 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EIP2935Block", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.EIP2935Block = &v
+			if err := m.EIP2935Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -4068,6 +4870,26 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.TracerJsonConfig = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableOpcodeProfile", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableOpcodeProfile = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
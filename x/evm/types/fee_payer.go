@@ -0,0 +1,53 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// feePayerSignPrefix separates the fee payer sign bytes from other signed payloads.
+var feePayerSignPrefix = []byte("evmos fee payer:")
+
+// FeePayerSignBytes returns the digest signed by the fee payer of the ethereum
+// transactions with the given hashes, in the order of the tx messages.
+func FeePayerSignBytes(txHashes ...common.Hash) []byte {
+	data := make([][]byte, 0, len(txHashes)+1)
+	data = append(data, feePayerSignPrefix)
+	for _, txHash := range txHashes {
+		data = append(data, txHash.Bytes())
+	}
+	return crypto.Keccak256(data...)
+}
+
+// VerifyFeePayerSignature checks that the given [R||S||V] signature over the
+// fee payer sign bytes of the ethereum transactions with the given hashes was
+// produced by the fee payer.
+func VerifyFeePayerSignature(feePayer sdk.AccAddress, sig []byte, txHashes ...common.Hash) error {
+	if len(sig) != crypto.SignatureLength {
+		return errorsmod.Wrap(errortypes.ErrorInvalidSigner, "fee payer signature doesn't match typical [R||S||V] signature 65 bytes")
+	}
+
+	// do not modify the signature of the tx when removing the recovery offset
+	sig = common.CopyBytes(sig)
+	if sig[crypto.RecoveryIDOffset] == 27 || sig[crypto.RecoveryIDOffset] == 28 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(FeePayerSignBytes(txHashes...), sig)
+	if err != nil {
+		return errorsmod.Wrap(errortypes.ErrorInvalidSigner, "failed to recover fee payer from signature")
+	}
+
+	if signer := crypto.PubkeyToAddress(*pubKey); !sdk.AccAddress(signer.Bytes()).Equals(feePayer) {
+		return errorsmod.Wrapf(
+			errortypes.ErrorInvalidSigner,
+			"fee payer signature signed by %s instead of %s", sdk.AccAddress(signer.Bytes()), feePayer,
+		)
+	}
+	return nil
+}
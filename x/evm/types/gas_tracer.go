@@ -0,0 +1,156 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var _ vm.EVMLogger = &CallGasTracer{}
+
+// callGasFrame holds the gas accounting of a single call frame traced by the
+// CallGasTracer.
+type callGasFrame struct {
+	// startGas is the gas available to the frame when it was entered
+	startGas uint64
+	// required is the gas the frame needs to complete, relative to its start
+	required uint64
+	// base is the gas the parent frame needs before forwarding gas to this frame
+	base uint64
+	// stipend is the call stipend the parent frame adds to the forwarded gas
+	stipend uint64
+	// callSpent and callCost are the gas spent by the frame before its last
+	// call opcode and the cost of that opcode
+	callSpent, callCost uint64
+}
+
+// CallGasTracer is a vm.EVMLogger that computes the minimum gas limit a message
+// needs for all of its internal calls to be executed with the same amount of gas
+// they consumed during the traced execution. It accounts for the gas retained by
+// the caller on every call (EIP-150 63/64 rule) and for the SSTORE call stipend
+// sentry (EIP-2200), which are not reflected in the gas used by the message.
+type CallGasTracer struct {
+	NoOpTracer
+
+	gasLimit uint64
+	frames   []*callGasFrame
+	required uint64
+}
+
+// NewCallGasTracer creates a new CallGasTracer
+func NewCallGasTracer() *CallGasTracer {
+	return &CallGasTracer{}
+}
+
+// RequiredGas returns the minimum gas limit computed from the traced execution.
+// It returns 0 if no execution was traced.
+func (t *CallGasTracer) RequiredGas() uint64 {
+	return t.required
+}
+
+// CaptureTxStart implements vm.EVMLogger interface
+func (t *CallGasTracer) CaptureTxStart(gasLimit uint64) {
+	t.gasLimit = gasLimit
+}
+
+// CaptureStart implements vm.EVMLogger interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *CallGasTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	// the intrinsic gas is consumed before the top level call is executed
+	var intrinsic uint64
+	if t.gasLimit > gas {
+		intrinsic = t.gasLimit - gas
+	}
+	t.frames = []*callGasFrame{{startGas: gas, base: intrinsic}}
+}
+
+// CaptureState implements vm.EVMLogger interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *CallGasTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if len(t.frames) == 0 {
+		return
+	}
+	frame := t.frames[len(t.frames)-1]
+	if frame.startGas < gas {
+		return
+	}
+	spent := frame.startGas - gas
+
+	switch op {
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL, vm.CREATE, vm.CREATE2:
+		// the cost of the call opcodes includes the gas forwarded to the callee,
+		// which is accounted once the callee frame exits
+		frame.callSpent, frame.callCost = spent, cost
+		return
+	case vm.SSTORE:
+		// SSTORE fails if the gas left is not greater than the call stipend
+		frame.required = max(frame.required, spent+params.SstoreSentryGasEIP2200+1)
+	}
+	frame.required = max(frame.required, spent+cost)
+}
+
+// CaptureEnter implements vm.EVMLogger interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *CallGasTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if len(t.frames) == 0 {
+		return
+	}
+	parent := t.frames[len(t.frames)-1]
+
+	var stipend, forwarded uint64
+	switch typ {
+	case vm.CREATE, vm.CREATE2:
+		// the gas forwarded to the created contract is not part of the opcode cost
+	default:
+		if (typ == vm.CALL || typ == vm.CALLCODE) && value != nil && value.Sign() > 0 {
+			stipend = params.CallStipend
+		}
+		forwarded = gas - min(gas, stipend)
+	}
+
+	overhead := parent.callCost - min(parent.callCost, forwarded)
+	t.frames = append(t.frames, &callGasFrame{
+		startGas: gas,
+		base:     parent.callSpent + overhead,
+		stipend:  stipend,
+	})
+}
+
+// CaptureExit implements vm.EVMLogger interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *CallGasTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if len(t.frames) < 2 {
+		return
+	}
+	frame := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+	parent := t.frames[len(t.frames)-1]
+
+	// the caller retains 1/64 of its available gas, so it needs 64/63 of the gas
+	// required by the callee (minus the stipend) to be available at the call
+	need := max(frame.required, gasUsed)
+	need -= min(need, frame.stipend)
+	need = (need*64 + 62) / 63
+
+	parent.required = max(parent.required, frame.base+need)
+}
+
+// CaptureEnd implements vm.EVMLogger interface
+//
+//nolint:revive // allow unused parameters to indicate expected signature
+func (t *CallGasTracer) CaptureEnd(output []byte, gasUsed uint64, tm time.Duration, err error) {
+	if len(t.frames) == 0 {
+		return
+	}
+	frame := t.frames[0]
+	t.required = frame.base + max(frame.required, gasUsed)
+}
@@ -223,3 +223,81 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		}
 	}
 }
+
+func (suite *GenesisTestSuite) TestImportGethGenesisAlloc() {
+	testCases := []struct {
+		name        string
+		genesis     string
+		expAccounts []GenesisAccount
+		errContains string
+	}{
+		{
+			"pass - prefixed and bare hex keys",
+			`{
+				"config": {"chainId": 1},
+				"alloc": {
+					"2000000000000000000000000000000000000002": {"balance": "1000"},
+					"0x1000000000000000000000000000000000000001": {
+						"balance": "0x10",
+						"code": "0x010203",
+						"storage": {"02": "0x07", "0x01": "05"}
+					}
+				}
+			}`,
+			[]GenesisAccount{
+				{
+					Address: "0x1000000000000000000000000000000000000001",
+					Code:    suite.code,
+					Storage: Storage{
+						NewState(common.HexToHash("0x01"), common.HexToHash("0x05")),
+						NewState(common.HexToHash("0x02"), common.HexToHash("0x07")),
+					},
+				},
+				{
+					Address: "0x2000000000000000000000000000000000000002",
+					Storage: Storage{},
+				},
+			},
+			"",
+		},
+		{
+			"fail - invalid address",
+			`{"alloc": {"0x1234": {"balance": "0x0"}}}`,
+			nil,
+			"hex string has length 4",
+		},
+		{
+			"fail - invalid storage key",
+			`{"alloc": {"0x1000000000000000000000000000000000000001": {"balance": "0x0", "storage": {"0xzz": "0x01"}}}}`,
+			nil,
+			"invalid hex storage key/value",
+		},
+		{
+			"fail - missing balance",
+			`{"alloc": {"0x1000000000000000000000000000000000000001": {"nonce": "0x1"}}}`,
+			nil,
+			"missing required field 'balance'",
+		},
+		{
+			"fail - no alloc section",
+			`{"config": {"chainId": 1}}`,
+			nil,
+			"no alloc section",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			alloc, err := ParseGethGenesisAlloc([]byte(tc.genesis))
+			if tc.errContains != "" {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			suite.Require().NoError(err)
+
+			accounts := GenesisAccountsFromGethAlloc(alloc)
+			suite.Require().Equal(tc.expAccounts, accounts)
+			suite.Require().NoError(NewGenesisState(DefaultParams(), accounts).Validate())
+		})
+	}
+}
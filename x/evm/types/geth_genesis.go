@@ -0,0 +1,72 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+)
+
+// ParseGethGenesisAlloc parses the alloc section of the given geth genesis
+// file. The addresses and storage keys can be hex encoded with or without the
+// 0x prefix, while the code must be 0x prefixed as in geth.
+func ParseGethGenesisAlloc(bz []byte) (core.GenesisAlloc, error) {
+	var genesis struct {
+		Alloc core.GenesisAlloc `json:"alloc"`
+	}
+	if err := json.Unmarshal(bz, &genesis); err != nil {
+		return nil, fmt.Errorf("failed to parse geth genesis alloc: %w", err)
+	}
+	if genesis.Alloc == nil {
+		return nil, errors.New("geth genesis has no alloc section")
+	}
+	return genesis.Alloc, nil
+}
+
+// GenesisAccountsFromGethAlloc returns the EVM genesis accounts, with their
+// code and storage, of the given geth genesis alloc. The accounts and their
+// storage are sorted by address and key to produce a deterministic genesis.
+//
+// NOTE: the balances and nonces are not part of the EVM genesis state and must
+// be set on the bank and auth genesis states.
+func GenesisAccountsFromGethAlloc(alloc core.GenesisAlloc) []GenesisAccount {
+	addresses := make([]common.Address, 0, len(alloc))
+	for address := range alloc {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	accounts := make([]GenesisAccount, 0, len(addresses))
+	for _, address := range addresses {
+		account := alloc[address]
+
+		keys := make([]common.Hash, 0, len(account.Storage))
+		for key := range account.Storage {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return bytes.Compare(keys[i].Bytes(), keys[j].Bytes()) < 0
+		})
+
+		storage := make(Storage, 0, len(keys))
+		for _, key := range keys {
+			storage = append(storage, NewState(key, account.Storage[key]))
+		}
+
+		accounts = append(accounts, GenesisAccount{
+			Address: address.Hex(),
+			Code:    common.Bytes2Hex(account.Code),
+			Storage: storage,
+		})
+	}
+
+	return accounts
+}
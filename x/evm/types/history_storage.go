@@ -0,0 +1,34 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// HistoryServeWindow is the number of block hashes served by the EIP-2935
+// history storage contract. The hashes are stored in a ring buffer of this size.
+const HistoryServeWindow = 8191
+
+var (
+	// HistoryStorageAddress is the address of the EIP-2935 history storage contract.
+	HistoryStorageAddress = common.HexToAddress("0x0000F90827F1C53a10cb7A02335B175320002935")
+
+	// HistoryStorageCode is the runtime bytecode of the EIP-2935 history storage
+	// contract. It only implements the get operation of the EIP, since the block
+	// hashes are written by the x/evm keeper at BeginBlock instead of through a
+	// system call. Given a 32 byte block number as input, it returns the hash of
+	// that block if it is within the last HistoryServeWindow blocks and reverts
+	// otherwise.
+	// NOTE: the reference bytecode uses the PUSH0 opcode, which is not available in
+	// the supported EVM version, so PUSH1 0x00 is used instead.
+	HistoryStorageCode = common.FromHex("0x6020361415602a5760003580431115602a5780611fff014311602a57611fff90065460005260206000f35b600080fd")
+)
+
+// HistoryStorageSlot returns the storage slot of the EIP-2935 history storage
+// contract where the hash of the given block number is stored.
+func HistoryStorageSlot(blockNumber uint64) common.Hash {
+	return common.BigToHash(new(big.Int).SetUint64(blockNumber % HistoryServeWindow))
+}
@@ -6,9 +6,13 @@ import (
 	"math/big"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
@@ -50,6 +54,22 @@ type FeeMarketKeeper interface {
 	CalculateBaseFee(ctx sdk.Context) *big.Int
 }
 
+// FeegrantKeeper defines the expected interface needed to give back the refunded
+// fees of the ethereum transactions paid through a fee grant.
+type FeegrantKeeper interface {
+	GetAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+	UpdateAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error
+}
+
+// EVMObserver defines the interface of the modules that observe the state
+// changes of the ethereum transactions.
+type EVMObserver interface {
+	// AfterMessageApplied is called after the successful execution of an
+	// ethereum transaction, with the addresses of the accounts it modified,
+	// sorted, and the logs it emitted.
+	AfterMessageApplied(ctx sdk.Context, msg core.Message, touched []common.Address, logs []*ethtypes.Log)
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
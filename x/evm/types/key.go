@@ -3,6 +3,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -36,6 +37,11 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientPrecompileLock
+	prefixTransientPendingTxGasPrice
+	prefixTransientFeePayer
+	prefixTransientFreePrecompileReads
+	prefixTransientPendingTxFee
 )
 
 // KVStore key prefixes
@@ -47,10 +53,15 @@ var (
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom               = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex             = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize             = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed             = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientPrecompileLock      = []byte{prefixTransientPrecompileLock}
+	KeyPrefixTransientPendingTxGasPrice   = []byte{prefixTransientPendingTxGasPrice}
+	KeyPrefixTransientFeePayer            = []byte{prefixTransientFeePayer}
+	KeyPrefixTransientFreePrecompileReads = []byte{prefixTransientFreePrecompileReads}
+	KeyPrefixTransientPendingTxFee        = []byte{prefixTransientPendingTxFee}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -62,3 +73,8 @@ func AddressStoragePrefix(address common.Address) []byte {
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
 }
+
+// PendingTxKey returns the key of the pending tx with the given sender and nonce.
+func PendingTxKey(sender common.Address, nonce uint64) []byte {
+	return append(sender.Bytes(), sdk.Uint64ToBigEndian(nonce)...)
+}
@@ -333,12 +333,31 @@ func (msg *MsgEthereumTx) UnmarshalBinary(b []byte) error {
 
 // BuildTx builds the canonical cosmos tx from ethereum msg
 func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.Tx, error) {
+	return msg.BuildTxWithFeePayer(b, evmDenom, nil, nil)
+}
+
+// BuildTxWithFeePayer builds the canonical cosmos tx from ethereum msg, with the
+// fees paid by the given fee payer through a fee grant to the sender. The fee
+// payer signature must be over the FeePayerSignBytes of the msg tx hash. If the
+// fee payer is empty, the fees are paid by the sender.
+func (msg *MsgEthereumTx) BuildTxWithFeePayer(
+	b client.TxBuilder,
+	evmDenom string,
+	feePayer sdk.AccAddress,
+	feePayerSig []byte,
+) (signing.Tx, error) {
 	builder, ok := b.(authtx.ExtensionOptionsTxBuilder)
 	if !ok {
 		return nil, errors.New("unsupported builder")
 	}
 
-	option, err := codectypes.NewAnyWithValue(&ExtensionOptionsEthereumTx{})
+	extension := &ExtensionOptionsEthereumTx{}
+	if !feePayer.Empty() {
+		extension.FeePayer = feePayer.String()
+		extension.FeePayerSig = feePayerSig
+	}
+
+	option, err := codectypes.NewAnyWithValue(extension)
 	if err != nil {
 		return nil, err
 	}
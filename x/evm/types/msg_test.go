@@ -882,6 +882,49 @@ func (suite *MsgsTestSuite) TestTransactionCoding() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgUpdateParams_ValidateBasic() {
+	authority := sdk.AccAddress(suite.from.Bytes()).String()
+
+	testCases := []struct {
+		msg         string
+		malleate    func(*types.Params)
+		errContains string
+	}{
+		{
+			msg:      "pass - default params",
+			malleate: func(*types.Params) {},
+		},
+		{
+			msg: "fail - zero max code size",
+			malleate: func(params *types.Params) {
+				params.MaxCodeSize = 0
+			},
+			errContains: "max code size cannot be zero",
+		},
+		{
+			msg: "fail - max code size above the EIP-170 limit",
+			malleate: func(params *types.Params) {
+				params.MaxCodeSize = types.DefaultMaxCodeSize + 1
+			},
+			errContains: "exceeds the EIP-170 limit",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			params := types.DefaultParams()
+			tc.malleate(&params)
+
+			err := (&types.MsgUpdateParams{Authority: authority, Params: params}).ValidateBasic()
+			if tc.errContains == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
 func encodeDecodeBinary(tx *ethtypes.Transaction) (*types.MsgEthereumTx, error) {
 	data, err := tx.MarshalBinary()
 	if err != nil {
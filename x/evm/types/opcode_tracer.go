@@ -0,0 +1,85 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+// OpcodeProfileKey is the key of the opcode profile in the result of an
+// OpcodeProfileTracer.
+const OpcodeProfileKey = "opcodeProfile"
+
+var _ tracers.Tracer = &OpcodeProfileTracer{}
+
+// OpcodeStats holds the aggregated execution of a single opcode.
+type OpcodeStats struct {
+	// Count is the number of times the opcode was executed
+	Count uint64 `json:"count"`
+	// TotalGas is the sum of the gas costs of the executions. The cost of the
+	// call and create opcodes includes the gas forwarded to the callee.
+	TotalGas uint64 `json:"totalGas"`
+}
+
+// OpcodeProfileTracer is a tracers.Tracer that aggregates the number of
+// executions and the gas cost of each opcode, while forwarding the execution
+// to the wrapped tracer. The opcode profile is added to the result of the
+// wrapped tracer, which must be a JSON object.
+type OpcodeProfileTracer struct {
+	tracers.Tracer
+
+	profile map[string]*OpcodeStats
+}
+
+// NewOpcodeProfileTracer creates a new OpcodeProfileTracer wrapping the given
+// tracer.
+func NewOpcodeProfileTracer(tracer tracers.Tracer) *OpcodeProfileTracer {
+	return &OpcodeProfileTracer{
+		Tracer:  tracer,
+		profile: make(map[string]*OpcodeStats),
+	}
+}
+
+// Profile returns the opcode profile, indexed by opcode name.
+func (t *OpcodeProfileTracer) Profile() map[string]*OpcodeStats {
+	return t.profile
+}
+
+// CaptureState implements vm.EVMLogger interface
+func (t *OpcodeProfileTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	t.Tracer.CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+
+	stats, ok := t.profile[op.String()]
+	if !ok {
+		stats = &OpcodeStats{}
+		t.profile[op.String()] = stats
+	}
+	stats.Count++
+	stats.TotalGas += cost
+}
+
+// GetResult implements tracers.Tracer interface. It returns the result of the
+// wrapped tracer with the opcode profile under the OpcodeProfileKey field.
+func (t *OpcodeProfileTracer) GetResult() (json.RawMessage, error) {
+	res, err := t.Tracer.GetResult()
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(res, &fields); err != nil || fields == nil {
+		return nil, errors.New("the opcode profile requires a tracer whose result is a JSON object")
+	}
+
+	profile, err := json.Marshal(t.profile)
+	if err != nil {
+		return nil, err
+	}
+	fields[OpcodeProfileKey] = profile
+
+	return json.Marshal(fields)
+}
@@ -14,6 +14,7 @@ import (
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/evmos/v16/precompiles/p256"
@@ -34,12 +35,15 @@ var (
 	// AvailableEVMExtensions defines the default active precompiles
 	AvailableEVMExtensions = []string{
 		p256.PrecompileAddress,                       // P256 precompile
+		p256.BatchPrecompileAddress,                  // P256 batch precompile
 		"0x0000000000000000000000000000000000000400", // Bech32 precompile
 		"0x0000000000000000000000000000000000000800", // Staking precompile
 		"0x0000000000000000000000000000000000000801", // Distribution precompile
 		"0x0000000000000000000000000000000000000802", // ICS20 transfer precompile
 		"0x0000000000000000000000000000000000000803", // Vesting precompile
 		"0x0000000000000000000000000000000000000804", // Bank precompile
+		"0x0000000000000000000000000000000000000805", // Epochs precompile
+		"0x0000000000000000000000000000000000000806", // Inflation precompile
 		"0x0000000000000000000000000000000000000900", // Stride outpost
 		"0x0000000000000000000000000000000000000901", // Osmosis outpost
 	}
@@ -51,6 +55,30 @@ var (
 		"channel-31", // Cronos
 		"channel-83", // Kava
 	}
+	// DefaultScheduledContracts defines the default contracts called at the end
+	// of each scheduled epoch (i.e none)
+	DefaultScheduledContracts []string
+	// DefaultScheduledCallGas defines the default gas limit of each scheduled
+	// contract call
+	DefaultScheduledCallGas uint64 = 200_000
+	// DefaultScheduledEpochIdentifier defines the default epoch at whose end the
+	// scheduled contracts are called
+	DefaultScheduledEpochIdentifier = "day"
+	// MaxScheduledContracts defines the maximum number of scheduled contracts,
+	// which are all called within the same epoch hook
+	MaxScheduledContracts = 10
+	// MaxScheduledCallGas defines the maximum gas limit of each scheduled
+	// contract call
+	MaxScheduledCallGas uint64 = 3_000_000
+	// DefaultMaxCodeSize defines the default maximum contract code size, which
+	// is the EIP-170 limit
+	DefaultMaxCodeSize uint64 = params.MaxCodeSize
+	// DefaultEnableEIP3529 applies the reduced gas refunds of EIP-3529, as the
+	// London hard fork does (i.e true)
+	DefaultEnableEIP3529 = true
+	// MaxFreePrecompileReads defines the maximum number of view-only precompile
+	// calls per block and per account whose base gas cost can be waived
+	MaxFreePrecompileReads uint64 = 100
 )
 
 // NewParams creates a new Params instance
@@ -73,6 +101,12 @@ func NewParams(
 		ChainConfig:         config,
 		ActivePrecompiles:   activePrecompiles,
 		EVMChannels:         evmChannels,
+		// scheduled contract calls are disabled by default
+		ScheduledContracts:       DefaultScheduledContracts,
+		ScheduledCallGas:         DefaultScheduledCallGas,
+		ScheduledEpochIdentifier: DefaultScheduledEpochIdentifier,
+		MaxCodeSize:              DefaultMaxCodeSize,
+		EnableEIP3529:            DefaultEnableEIP3529,
 	}
 }
 
@@ -90,6 +124,12 @@ func DefaultParams() Params {
 		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
 		ActivePrecompiles:   AvailableEVMExtensions,
 		EVMChannels:         DefaultEVMChannels,
+		// scheduled contract calls are disabled by default
+		ScheduledContracts:       DefaultScheduledContracts,
+		ScheduledCallGas:         DefaultScheduledCallGas,
+		ScheduledEpochIdentifier: DefaultScheduledEpochIdentifier,
+		MaxCodeSize:              DefaultMaxCodeSize,
+		EnableEIP3529:            DefaultEnableEIP3529,
 	}
 }
 
@@ -133,6 +173,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateBool(p.Paused); err != nil {
+		return err
+	}
+
 	if err := validateChainConfig(p.ChainConfig); err != nil {
 		return err
 	}
@@ -141,7 +185,31 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateChannels(p.EVMChannels)
+	if err := validateChannels(p.EVMChannels); err != nil {
+		return err
+	}
+
+	if err := validateScheduledCalls(p.ScheduledContracts, p.ScheduledCallGas, p.ScheduledEpochIdentifier); err != nil {
+		return err
+	}
+
+	if err := validateBlockedAddresses(p.BlockedAddresses); err != nil {
+		return err
+	}
+
+	if err := validateMaxCodeSize(p.MaxCodeSize); err != nil {
+		return err
+	}
+
+	if err := validateBool(p.EnableEIP3529); err != nil {
+		return err
+	}
+
+	if err := validateFreePrecompileReads(p.FreePrecompileReads); err != nil {
+		return err
+	}
+
+	return validatePrecompileGasCosts(p.PrecompileGasCosts)
 }
 
 // EIPs returns the ExtraEIPS as a int slice
@@ -262,6 +330,161 @@ func ValidatePrecompiles(i interface{}) error {
 	return nil
 }
 
+// validateScheduledCalls checks if the scheduled contract addresses are valid
+// and unique, and that a gas limit and an epoch identifier are set whenever
+// contracts are scheduled. Both the number of contracts and the gas limit are
+// bounded, since the calls are executed outside of any transaction.
+func validateScheduledCalls(contracts []string, gas uint64, epochIdentifier string) error {
+	if len(contracts) > MaxScheduledContracts {
+		return fmt.Errorf("scheduled contracts cannot exceed %d, got %d", MaxScheduledContracts, len(contracts))
+	}
+
+	seenContracts := make(map[string]struct{})
+	for _, contract := range contracts {
+		if err := types.ValidateNonZeroAddress(contract); err != nil {
+			return fmt.Errorf("invalid scheduled contract %s", contract)
+		}
+
+		checksummed := common.HexToAddress(contract).Hex()
+		if _, ok := seenContracts[checksummed]; ok {
+			return fmt.Errorf("duplicate scheduled contract %s", contract)
+		}
+
+		seenContracts[checksummed] = struct{}{}
+	}
+
+	if len(contracts) == 0 {
+		return nil
+	}
+
+	if gas == 0 {
+		return fmt.Errorf("scheduled call gas cannot be zero when contracts are scheduled")
+	}
+
+	if gas > MaxScheduledCallGas {
+		return fmt.Errorf("scheduled call gas cannot exceed %d, got %d", MaxScheduledCallGas, gas)
+	}
+
+	if strings.TrimSpace(epochIdentifier) == "" {
+		return fmt.Errorf("scheduled epoch identifier cannot be blank when contracts are scheduled")
+	}
+
+	return nil
+}
+
+// GetScheduledContractsAddrs returns the scheduled contracts as a slice of
+// addresses.
+func (p Params) GetScheduledContractsAddrs() []common.Address {
+	contracts := make([]common.Address, len(p.ScheduledContracts))
+	for i, contract := range p.ScheduledContracts {
+		contracts[i] = common.HexToAddress(contract)
+	}
+	return contracts
+}
+
+// validateBlockedAddresses checks if the blocked addresses are valid and unique.
+func validateBlockedAddresses(addresses []string) error {
+	seenAddresses := make(map[common.Address]struct{})
+	for _, address := range addresses {
+		if err := types.ValidateNonZeroAddress(address); err != nil {
+			return fmt.Errorf("invalid blocked address %s", address)
+		}
+
+		addr := common.HexToAddress(address)
+		if _, ok := seenAddresses[addr]; ok {
+			return fmt.Errorf("duplicate blocked address %s", address)
+		}
+
+		seenAddresses[addr] = struct{}{}
+	}
+
+	return nil
+}
+
+// IsBlockedAddress returns true if the given address is not allowed to send
+// nor receive ethereum transactions. It doesn't apply to the internal calls
+// made during the EVM execution.
+func (p Params) IsBlockedAddress(address common.Address) bool {
+	for _, blocked := range p.BlockedAddresses {
+		if common.HexToAddress(blocked) == address {
+			return true
+		}
+	}
+	return false
+}
+
+// validateMaxCodeSize checks that the maximum contract code size is not zero
+// and doesn't exceed the EIP-170 limit.
+//
+// NOTE: the EIP-170 limit is hardcoded in the EVM of the go-ethereum fork, so
+// the param can only lower it. Raising it requires patching the fork.
+func validateMaxCodeSize(size uint64) error {
+	if size == 0 {
+		return fmt.Errorf("max code size cannot be zero")
+	}
+	if size > params.MaxCodeSize {
+		return fmt.Errorf("max code size %d exceeds the EIP-170 limit of %d bytes", size, params.MaxCodeSize)
+	}
+
+	return nil
+}
+
+// validateFreePrecompileReads checks that the number of free view-only
+// precompile calls doesn't exceed MaxFreePrecompileReads.
+func validateFreePrecompileReads(reads uint64) error {
+	if reads > MaxFreePrecompileReads {
+		return fmt.Errorf("free precompile reads %d exceed the maximum of %d", reads, MaxFreePrecompileReads)
+	}
+
+	return nil
+}
+
+func validatePrecompileGasCosts(costs []PrecompileGasCost) error {
+	seen := make(map[string]struct{})
+	for _, cost := range costs {
+		if err := types.ValidateNonZeroAddress(cost.Address); err != nil {
+			return fmt.Errorf("invalid precompile gas cost address %s", cost.Address)
+		}
+
+		selector, err := hexutil.Decode(cost.MethodSelector)
+		if err != nil || len(selector) != 4 {
+			return fmt.Errorf("invalid precompile gas cost method selector %s, expected 4 hex encoded bytes", cost.MethodSelector)
+		}
+
+		if cost.Gas == 0 {
+			return fmt.Errorf("precompile gas cost of method %s on %s cannot be zero", cost.MethodSelector, cost.Address)
+		}
+
+		key := common.HexToAddress(cost.Address).Hex() + hexutil.Encode(selector)
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate precompile gas cost of method %s on %s", cost.MethodSelector, cost.Address)
+		}
+
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetPrecompileGasCostsMap returns the overridden base gas costs of the precompiled
+// contracts, indexed by contract address and method selector.
+func (p Params) GetPrecompileGasCostsMap() map[common.Address]map[[4]byte]uint64 {
+	costs := make(map[common.Address]map[[4]byte]uint64)
+	for _, cost := range p.PrecompileGasCosts {
+		selector, err := hexutil.Decode(cost.MethodSelector)
+		if err != nil || len(selector) != 4 {
+			continue
+		}
+
+		address := common.HexToAddress(cost.Address)
+		if costs[address] == nil {
+			costs[address] = make(map[[4]byte]uint64)
+		}
+		costs[address][[4]byte(selector)] = cost.Gas
+	}
+	return costs
+}
+
 // IsLondon returns if london hardfork is enabled.
 func IsLondon(ethConfig *params.ChainConfig, height int64) bool {
 	return ethConfig.IsLondon(big.NewInt(height))
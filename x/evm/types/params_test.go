@@ -1,8 +1,10 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	"github.com/stretchr/testify/require"
@@ -59,6 +61,210 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "precompiles need to be sorted",
 		},
+		{
+			name: "valid scheduled contracts",
+			params: func() Params {
+				params := DefaultParams()
+				params.ScheduledContracts = []string{"0x1000000000000000000000000000000000000001"}
+				return params
+			}(),
+			expPass: true,
+		},
+		{
+			name: "invalid scheduled contract",
+			params: func() Params {
+				params := DefaultParams()
+				params.ScheduledContracts = []string{"0x0000000000000000000000000000000000000000"}
+				return params
+			}(),
+			errContains: "invalid scheduled contract",
+		},
+		{
+			name: "duplicate scheduled contract",
+			params: func() Params {
+				params := DefaultParams()
+				params.ScheduledContracts = []string{
+					"0x1000000000000000000000000000000000000001",
+					"0x1000000000000000000000000000000000000001",
+				}
+				return params
+			}(),
+			errContains: "duplicate scheduled contract",
+		},
+		{
+			name: "zero scheduled call gas",
+			params: func() Params {
+				params := DefaultParams()
+				params.ScheduledContracts = []string{"0x1000000000000000000000000000000000000001"}
+				params.ScheduledCallGas = 0
+				return params
+			}(),
+			errContains: "scheduled call gas cannot be zero",
+		},
+		{
+			name: "scheduled call gas above the maximum",
+			params: func() Params {
+				params := DefaultParams()
+				params.ScheduledContracts = []string{"0x1000000000000000000000000000000000000001"}
+				params.ScheduledCallGas = MaxScheduledCallGas + 1
+				return params
+			}(),
+			errContains: "scheduled call gas cannot exceed",
+		},
+		{
+			name: "too many scheduled contracts",
+			params: func() Params {
+				params := DefaultParams()
+				params.ScheduledContracts = make([]string, MaxScheduledContracts+1)
+				for i := range params.ScheduledContracts {
+					params.ScheduledContracts[i] = common.BigToAddress(big.NewInt(int64(i + 1))).Hex()
+				}
+				return params
+			}(),
+			errContains: "scheduled contracts cannot exceed",
+		},
+		{
+			name: "blank scheduled epoch identifier",
+			params: func() Params {
+				params := DefaultParams()
+				params.ScheduledContracts = []string{"0x1000000000000000000000000000000000000001"}
+				params.ScheduledEpochIdentifier = " "
+				return params
+			}(),
+			errContains: "scheduled epoch identifier cannot be blank",
+		},
+		{
+			name: "valid precompile gas costs",
+			params: func() Params {
+				params := DefaultParams()
+				params.PrecompileGasCosts = []PrecompileGasCost{
+					{Address: "0x0000000000000000000000000000000000000800", MethodSelector: "0x026e402b", Gas: 50_000},
+					{Address: "0x0000000000000000000000000000000000000801", MethodSelector: "0x026e402b", Gas: 50_000},
+				}
+				return params
+			}(),
+			expPass: true,
+		},
+		{
+			name: "invalid precompile gas cost address",
+			params: func() Params {
+				params := DefaultParams()
+				params.PrecompileGasCosts = []PrecompileGasCost{
+					{Address: "0x0000000000000000000000000000000000000000", MethodSelector: "0x026e402b", Gas: 50_000},
+				}
+				return params
+			}(),
+			errContains: "invalid precompile gas cost address",
+		},
+		{
+			name: "invalid precompile gas cost method selector",
+			params: func() Params {
+				params := DefaultParams()
+				params.PrecompileGasCosts = []PrecompileGasCost{
+					{Address: "0x0000000000000000000000000000000000000800", MethodSelector: "0x026e40", Gas: 50_000},
+				}
+				return params
+			}(),
+			errContains: "invalid precompile gas cost method selector",
+		},
+		{
+			name: "zero precompile gas cost",
+			params: func() Params {
+				params := DefaultParams()
+				params.PrecompileGasCosts = []PrecompileGasCost{
+					{Address: "0x0000000000000000000000000000000000000800", MethodSelector: "0x026e402b", Gas: 0},
+				}
+				return params
+			}(),
+			errContains: "cannot be zero",
+		},
+		{
+			name: "duplicate precompile gas cost",
+			params: func() Params {
+				params := DefaultParams()
+				params.PrecompileGasCosts = []PrecompileGasCost{
+					{Address: "0x0000000000000000000000000000000000000800", MethodSelector: "0x026e402b", Gas: 50_000},
+					{Address: "0x0000000000000000000000000000000000000800", MethodSelector: "0x026E402B", Gas: 60_000},
+				}
+				return params
+			}(),
+			errContains: "duplicate precompile gas cost",
+		},
+		{
+			name: "valid blocked addresses",
+			params: func() Params {
+				params := DefaultParams()
+				params.BlockedAddresses = []string{"0x1000000000000000000000000000000000000001"}
+				return params
+			}(),
+			expPass: true,
+		},
+		{
+			name: "invalid blocked address",
+			params: func() Params {
+				params := DefaultParams()
+				params.BlockedAddresses = []string{"0x0000000000000000000000000000000000000000"}
+				return params
+			}(),
+			errContains: "invalid blocked address",
+		},
+		{
+			name: "duplicate blocked address",
+			params: func() Params {
+				params := DefaultParams()
+				params.BlockedAddresses = []string{
+					"0xabcdef0000000000000000000000000000000001",
+					"0xABCDEF0000000000000000000000000000000001",
+				}
+				return params
+			}(),
+			errContains: "duplicate blocked address",
+		},
+		{
+			name: "valid lower max code size",
+			params: func() Params {
+				params := DefaultParams()
+				params.MaxCodeSize = 1024
+				return params
+			}(),
+			expPass: true,
+		},
+		{
+			name: "zero max code size",
+			params: func() Params {
+				params := DefaultParams()
+				params.MaxCodeSize = 0
+				return params
+			}(),
+			errContains: "max code size cannot be zero",
+		},
+		{
+			name: "max code size above the EIP-170 limit",
+			params: func() Params {
+				params := DefaultParams()
+				params.MaxCodeSize = DefaultMaxCodeSize + 1
+				return params
+			}(),
+			errContains: "exceeds the EIP-170 limit",
+		},
+		{
+			name: "valid free precompile reads",
+			params: func() Params {
+				params := DefaultParams()
+				params.FreePrecompileReads = MaxFreePrecompileReads
+				return params
+			}(),
+			expPass: true,
+		},
+		{
+			name: "free precompile reads above the maximum",
+			params: func() Params {
+				params := DefaultParams()
+				params.FreePrecompileReads = MaxFreePrecompileReads + 1
+				return params
+			}(),
+			errContains: "exceed the maximum",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -204,3 +410,19 @@ func TestIsActivePrecompile(t *testing.T) {
 		})
 	}
 }
+
+func TestParamsGetPrecompileGasCostsMap(t *testing.T) {
+	params := DefaultParams()
+	require.Empty(t, params.GetPrecompileGasCostsMap())
+
+	params.PrecompileGasCosts = []PrecompileGasCost{
+		{Address: "0x0000000000000000000000000000000000000800", MethodSelector: "0x026e402b", Gas: 50_000},
+		{Address: "0x0000000000000000000000000000000000000800", MethodSelector: "0x6a627842", Gas: 20_000},
+	}
+	require.Equal(t, map[common.Address]map[[4]byte]uint64{
+		common.HexToAddress("0x0000000000000000000000000000000000000800"): {
+			{0x02, 0x6e, 0x40, 0x2b}: 50_000,
+			{0x6a, 0x62, 0x78, 0x42}: 20_000,
+		},
+	}, params.GetPrecompileGasCostsMap())
+}
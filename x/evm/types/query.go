@@ -6,6 +6,10 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 )
 
+// MaxStoragePendingTxs defines the maximum number of pending transactions a
+// storage query can apply before reading the state.
+const MaxStoragePendingTxs = 1000
+
 // UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
 func (m QueryTraceTxRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	for _, msg := range m.Predecessors {
@@ -24,3 +28,26 @@ func (m QueryTraceBlockRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker
 	}
 	return nil
 }
+
+// UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
+func (m QueryReplayTxRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, msg := range m.Predecessors {
+		if err := msg.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	if m.Msg == nil {
+		return nil
+	}
+	return m.Msg.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
+func (m QueryStorageRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, msg := range m.PendingTxs {
+		if err := msg.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
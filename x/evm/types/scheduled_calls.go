@@ -0,0 +1,55 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// ScheduledCallMethodName is the name of the method that is called on each of the
+// scheduled contracts at the end of the scheduled epoch:
+//
+//	function onEpochEnd(string calldata epochIdentifier, uint256 epochNumber) external;
+const ScheduledCallMethodName = "onEpochEnd"
+
+// ScheduledCallMethod is the ABI method that is called on the scheduled contracts.
+var ScheduledCallMethod abi.Method
+
+func init() {
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		panic(err)
+	}
+
+	uint256Type, err := abi.NewType("uint256", "", nil)
+	if err != nil {
+		panic(err)
+	}
+
+	ScheduledCallMethod = abi.NewMethod(
+		ScheduledCallMethodName,
+		ScheduledCallMethodName,
+		abi.Function,
+		"nonpayable",
+		false,
+		false,
+		abi.Arguments{
+			{Name: "epochIdentifier", Type: stringType},
+			{Name: "epochNumber", Type: uint256Type},
+		},
+		abi.Arguments{},
+	)
+}
+
+// PackScheduledCall returns the calldata of the scheduled contract call for the
+// given epoch.
+func PackScheduledCall(epochIdentifier string, epochNumber int64) ([]byte, error) {
+	args, err := ScheduledCallMethod.Inputs.Pack(epochIdentifier, big.NewInt(epochNumber))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(ScheduledCallMethod.ID, args...), nil
+}
@@ -0,0 +1,85 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// StateOverride is the collection of overridden accounts. It is shared by the
+// JSON-RPC server and the x/evm queries.
+// Duplicate struct definition since geth struct is in internal package
+// Ref: https://github.com/ethereum/go-ethereum/blob/v1.10.26/internal/ethapi/api.go#L884
+type StateOverride map[common.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of account during the execution of
+// a message call.
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
+// if statDiff is set, all diff will be applied first and then execute the call
+// message.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// Apply overrides the fields of specified accounts into the given state.
+func (diff *StateOverride) Apply(db vm.StateDB) error {
+	if diff == nil {
+		return nil
+	}
+
+	for addr, account := range *diff {
+		// Override account nonce.
+		if account.Nonce != nil {
+			db.SetNonce(addr, uint64(*account.Nonce))
+		}
+		// Override account(contract) code.
+		if account.Code != nil {
+			db.SetCode(addr, *account.Code)
+		}
+		// Override account balance.
+		if account.Balance != nil {
+			balance := (*big.Int)(*account.Balance)
+			if balance == nil || balance.Sign() < 0 {
+				return fmt.Errorf("account %s has an invalid balance override", addr.Hex())
+			}
+			db.SubBalance(addr, db.GetBalance(addr))
+			db.AddBalance(addr, balance)
+		}
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+		// Replace entire state if caller requires.
+		if account.State != nil {
+			var keys []common.Hash
+			if err := db.ForEachStorage(addr, func(key, _ common.Hash) bool {
+				keys = append(keys, key)
+				return true
+			}); err != nil {
+				return err
+			}
+			for _, key := range keys {
+				db.SetState(addr, key, common.Hash{})
+			}
+			for key, value := range *account.State {
+				db.SetState(addr, key, value)
+			}
+		}
+		// Apply state diff into specified accounts.
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				db.SetState(addr, key, value)
+			}
+		}
+	}
+	return nil
+}
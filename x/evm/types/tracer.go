@@ -19,6 +19,17 @@ const (
 	TracerJSON       = "json"
 	TracerStruct     = "struct"
 	TracerMarkdown   = "markdown"
+
+	// TracerPrestate is the name of the native go-ethereum prestate tracer
+	TracerPrestate = "prestateTracer"
+)
+
+// log levels at which the reverted ethereum transactions are logged
+const (
+	RevertLogLevelNone  = "none"
+	RevertLogLevelDebug = "debug"
+	RevertLogLevelInfo  = "info"
+	RevertLogLevelError = "error"
 )
 
 // NewTracer creates a new Logger tracer to collect execution traces from an
@@ -46,6 +57,7 @@ func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height
 
 // TxTraceResult is the result of a single transaction trace during a block trace.
 type TxTraceResult struct {
+	TxHash common.Hash `json:"txHash"`           // Transaction hash of the traced transaction
 	Result interface{} `json:"result,omitempty"` // Trace results produced by the tracer
 	Error  string      `json:"error,omitempty"`  // Trace failure produced by the tracer
 }
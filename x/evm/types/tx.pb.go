@@ -260,6 +260,15 @@ var xxx_messageInfo_DynamicFeeTx proto.InternalMessageInfo
 
 // ExtensionOptionsEthereumTx is an extension option for ethereum transactions
 type ExtensionOptionsEthereumTx struct {
+	// fee_payer is the bech32 address of the account that pays the fees of the
+	// ethereum transactions through a fee grant to their senders. If empty, the
+	// fees are paid by the senders.
+	FeePayer string `protobuf:"bytes,1,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// fee_payer_sig is the signature of the fee payer over the hashes of the
+	// ethereum transactions, which proves its consent to pay their fees. It is
+	// required when the fee payer is set, since the ethereum signatures do not
+	// cover this extension option.
+	FeePayerSig []byte `protobuf:"bytes,2,opt,name=fee_payer_sig,json=feePayerSig,proto3" json:"fee_payer_sig,omitempty"`
 }
 
 func (m *ExtensionOptionsEthereumTx) Reset()         { *m = ExtensionOptionsEthereumTx{} }
@@ -454,68 +463,70 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/tx.proto", fileDescriptor_f75ac0a12d075f21) }
 
 var fileDescriptor_f75ac0a12d075f21 = []byte{
-	// 963 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0x13, 0xe7, 0xd7, 0x24, 0x94, 0x95, 0xd5, 0x6a, 0x9d, 0x00, 0x71, 0x36, 0x48, 0x90,
-	0x45, 0xaa, 0xad, 0x16, 0xa9, 0xd2, 0xf6, 0x44, 0xb3, 0xed, 0xa2, 0x45, 0xad, 0x58, 0x99, 0xec,
-	0x05, 0x90, 0xa2, 0xa9, 0x3d, 0x9d, 0x8c, 0x88, 0x3d, 0x96, 0x67, 0x62, 0x25, 0x1c, 0xf7, 0xc4,
-	0x0d, 0x10, 0xff, 0x00, 0x07, 0x4e, 0x9c, 0x38, 0xec, 0x99, 0xf3, 0x8a, 0xd3, 0x0a, 0x2e, 0x88,
-	0x83, 0x41, 0x29, 0x12, 0x52, 0x8f, 0x9c, 0x39, 0xa0, 0x99, 0x71, 0x9a, 0x66, 0x43, 0x5a, 0x58,
-	0x89, 0xbd, 0xcd, 0xf3, 0xfb, 0xde, 0x8f, 0xf9, 0xbe, 0xf1, 0x7b, 0xa0, 0x8e, 0xf8, 0x00, 0xc5,
-	0x01, 0x09, 0xb9, 0x83, 0x92, 0xc0, 0x49, 0xb6, 0x1d, 0x3e, 0xb6, 0xa3, 0x98, 0x72, 0x6a, 0xdc,
-	0xb8, 0x70, 0xd9, 0x28, 0x09, 0xec, 0x64, 0xbb, 0x71, 0xd3, 0xa3, 0x2c, 0xa0, 0xcc, 0x09, 0x18,
-	0x16, 0xc8, 0x80, 0x61, 0x05, 0x6d, 0xd4, 0x95, 0xa3, 0x2f, 0x2d, 0x47, 0x19, 0x99, 0xab, 0xb1,
-	0x54, 0x40, 0x24, 0x53, 0xbe, 0x0d, 0x4c, 0x31, 0x55, 0x31, 0xe2, 0x94, 0x7d, 0x7d, 0x15, 0x53,
-	0x8a, 0x87, 0xc8, 0x81, 0x11, 0x71, 0x60, 0x18, 0x52, 0x0e, 0x39, 0xa1, 0xe1, 0x2c, 0x5f, 0x3d,
-	0xf3, 0x4a, 0xeb, 0x64, 0x74, 0xea, 0xc0, 0x70, 0xa2, 0x5c, 0xed, 0xcf, 0x35, 0xf0, 0xd2, 0x31,
-	0xc3, 0x87, 0xa2, 0x20, 0x1a, 0x05, 0xbd, 0xb1, 0xd1, 0x01, 0xba, 0x0f, 0x39, 0x34, 0xb5, 0x96,
-	0xd6, 0xa9, 0xee, 0x6c, 0xd8, 0x2a, 0xd6, 0x9e, 0xc5, 0xda, 0xfb, 0xe1, 0xc4, 0x95, 0x08, 0xa3,
-	0x0e, 0x74, 0x46, 0x3e, 0x45, 0x66, 0xae, 0xa5, 0x75, 0xb4, 0x6e, 0xe1, 0x3c, 0xb5, 0xb4, 0x2d,
-	0x57, 0x7e, 0x32, 0x2c, 0xa0, 0x0f, 0x20, 0x1b, 0x98, 0xf9, 0x96, 0xd6, 0xa9, 0x74, 0xab, 0x7f,
-	0xa6, 0x56, 0x29, 0x1e, 0x46, 0x7b, 0xed, 0xad, 0xb6, 0x2b, 0x1d, 0x86, 0x01, 0xf4, 0xd3, 0x98,
-	0x06, 0xa6, 0x2e, 0x00, 0xae, 0x3c, 0xef, 0xe9, 0x9f, 0x7d, 0x6d, 0xad, 0xb5, 0xbf, 0xcc, 0x81,
-	0xf2, 0x11, 0xc2, 0xd0, 0x9b, 0xf4, 0xc6, 0xc6, 0x06, 0x28, 0x84, 0x34, 0xf4, 0x90, 0xec, 0x46,
-	0x77, 0x95, 0x61, 0xec, 0x82, 0x0a, 0x86, 0x82, 0x39, 0xe2, 0xa9, 0xea, 0x95, 0x6e, 0xfd, 0x97,
-	0xd4, 0xda, 0x54, 0x24, 0x32, 0xff, 0x13, 0x9b, 0x50, 0x27, 0x80, 0x7c, 0x60, 0xdf, 0x0f, 0xb9,
-	0x5b, 0xc6, 0x90, 0x3d, 0x10, 0x50, 0xa3, 0x09, 0xf2, 0x18, 0x32, 0xd9, 0x94, 0xde, 0xad, 0x4d,
-	0x53, 0xab, 0xfc, 0x2e, 0x64, 0x47, 0x24, 0x20, 0xdc, 0x15, 0x0e, 0x63, 0x1d, 0xe4, 0x38, 0xcd,
-	0x5a, 0xca, 0x71, 0x6a, 0xdc, 0x01, 0x85, 0x04, 0x0e, 0x47, 0xc8, 0x2c, 0xc8, 0x1a, 0xaf, 0xaf,
-	0xac, 0x31, 0x4d, 0xad, 0xe2, 0x7e, 0x40, 0x47, 0x21, 0x77, 0x55, 0x84, 0xb8, 0x9f, 0x64, 0xb1,
-	0xd8, 0xd2, 0x3a, 0xb5, 0x8c, 0xaf, 0x1a, 0xd0, 0x12, 0xb3, 0x24, 0x3f, 0x68, 0x89, 0xb0, 0x62,
-	0xb3, 0xac, 0xac, 0x58, 0x58, 0xcc, 0xac, 0x28, 0x8b, 0xed, 0xad, 0x0b, 0x26, 0x7e, 0x78, 0xbc,
-	0x55, 0xec, 0x8d, 0x0f, 0x20, 0x87, 0xed, 0xef, 0xf3, 0xa0, 0xb6, 0xef, 0x79, 0x88, 0xb1, 0x23,
-	0xc2, 0x78, 0x6f, 0x6c, 0xbc, 0x07, 0xca, 0xde, 0x00, 0x92, 0xb0, 0x4f, 0x7c, 0x49, 0x4d, 0xa5,
-	0xeb, 0x5c, 0xd5, 0x5c, 0xe9, 0xae, 0x00, 0xdf, 0x3f, 0x38, 0x4f, 0xad, 0x92, 0xa7, 0x8e, 0x6e,
-	0x76, 0xf0, 0xe7, 0x1c, 0xe7, 0x56, 0x72, 0x9c, 0xff, 0xcf, 0x1c, 0xeb, 0x57, 0x73, 0x5c, 0x58,
-	0xe6, 0xb8, 0xf8, 0xdc, 0x1c, 0x97, 0x2e, 0x71, 0xfc, 0x11, 0x28, 0x43, 0x49, 0x14, 0x62, 0x66,
-	0xb9, 0x95, 0xef, 0x54, 0x77, 0x5e, 0xb3, 0x9f, 0xfd, 0x27, 0x6d, 0x45, 0x65, 0x6f, 0x14, 0x0d,
-	0x51, 0xb7, 0xf5, 0x24, 0xb5, 0xd6, 0xce, 0x53, 0x0b, 0xc0, 0x0b, 0x7e, 0xbf, 0xfd, 0xd5, 0x02,
-	0x73, 0xb6, 0xdd, 0x8b, 0x84, 0x4a, 0xc0, 0xca, 0x82, 0x80, 0x60, 0x41, 0xc0, 0xea, 0x2a, 0x01,
-	0xff, 0xca, 0x83, 0xda, 0xc1, 0x24, 0x84, 0x01, 0xf1, 0xee, 0x21, 0xf4, 0x42, 0x04, 0xbc, 0x03,
-	0xaa, 0x42, 0x40, 0x4e, 0xa2, 0xbe, 0x07, 0xa3, 0xeb, 0x25, 0x14, 0x72, 0xf7, 0x48, 0x74, 0x17,
-	0x46, 0xb3, 0xd0, 0x53, 0x84, 0x64, 0xa8, 0xfe, 0x6f, 0x42, 0xef, 0x21, 0x24, 0x42, 0x33, 0xf9,
-	0x0b, 0x57, 0xcb, 0x5f, 0x5c, 0x96, 0xbf, 0xf4, 0xdc, 0xf2, 0x97, 0x57, 0xc8, 0x5f, 0xf9, 0x5f,
-	0xe4, 0x07, 0x0b, 0xf2, 0x57, 0x17, 0xe4, 0xaf, 0xad, 0x92, 0xbf, 0x0d, 0x1a, 0x87, 0x63, 0x8e,
-	0x42, 0x46, 0x68, 0xf8, 0x7e, 0x24, 0x47, 0xf3, 0x7c, 0xe2, 0x66, 0x73, 0xef, 0x1b, 0x0d, 0x6c,
-	0x2e, 0x4c, 0x62, 0x17, 0xb1, 0x88, 0x86, 0x4c, 0x5e, 0x54, 0x0e, 0x53, 0x4d, 0xcd, 0x4a, 0x39,
-	0x3f, 0x6f, 0x03, 0x7d, 0x48, 0x31, 0x33, 0x73, 0xf2, 0x92, 0x9b, 0xcb, 0x97, 0x3c, 0xa2, 0xd8,
-	0x95, 0x10, 0xe3, 0x06, 0xc8, 0xc7, 0x88, 0xcb, 0x07, 0x50, 0x73, 0xc5, 0xd1, 0xa8, 0x83, 0x72,
-	0x12, 0xf4, 0x51, 0x1c, 0xd3, 0x38, 0x9b, 0x76, 0xa5, 0x24, 0x38, 0x14, 0xa6, 0x70, 0x09, 0xe9,
-	0x47, 0x0c, 0xf9, 0x4a, 0x44, 0xb7, 0x84, 0x21, 0x7b, 0xc8, 0x90, 0x3f, 0x1b, 0xcf, 0x1a, 0x78,
-	0xf9, 0x98, 0xe1, 0x87, 0x91, 0x0f, 0x39, 0x7a, 0x00, 0x63, 0x18, 0x30, 0x31, 0x2b, 0xe0, 0x88,
-	0x0f, 0x68, 0x4c, 0xf8, 0x24, 0x7b, 0xcd, 0xe6, 0x8f, 0x8f, 0xb7, 0x36, 0xb2, 0xa5, 0xb6, 0xef,
-	0xfb, 0x31, 0x62, 0xec, 0x03, 0x1e, 0x93, 0x10, 0xbb, 0x73, 0xa8, 0xb1, 0x0b, 0x8a, 0x91, 0xcc,
-	0x20, 0x5f, 0x6e, 0x75, 0xc7, 0x5c, 0xbe, 0x86, 0xaa, 0xd0, 0xd5, 0x85, 0x4c, 0x6e, 0x86, 0xde,
-	0x5b, 0x7f, 0xf4, 0xc7, 0x77, 0x6f, 0xcd, 0xf3, 0xb4, 0xeb, 0xe0, 0xe6, 0x33, 0x2d, 0xcd, 0xb8,
-	0xdb, 0x49, 0x35, 0x90, 0x3f, 0x66, 0xd8, 0x98, 0x00, 0x70, 0x69, 0xc7, 0x59, 0xcb, 0x85, 0x16,
-	0xa8, 0x6f, 0xbc, 0x79, 0x0d, 0x60, 0x96, 0xbf, 0x7d, 0xeb, 0xd1, 0x4f, 0xbf, 0x7f, 0x95, 0x7b,
-	0xa5, 0x5d, 0x17, 0x2b, 0x9a, 0xb2, 0x8b, 0x7d, 0x9d, 0x21, 0xfb, 0x7c, 0x6c, 0x7c, 0x0c, 0x6a,
-	0x0b, 0x6c, 0xdd, 0xfa, 0xc7, 0xdc, 0x97, 0x21, 0x8d, 0xdb, 0xd7, 0x42, 0x66, 0x0d, 0x74, 0xdf,
-	0x79, 0x32, 0x6d, 0x6a, 0x4f, 0xa7, 0x4d, 0xed, 0xb7, 0x69, 0x53, 0xfb, 0xe2, 0xac, 0xb9, 0xf6,
-	0xf4, 0xac, 0xb9, 0xf6, 0xf3, 0x59, 0x73, 0xed, 0xc3, 0x37, 0x30, 0xe1, 0x83, 0xd1, 0x89, 0xed,
-	0xd1, 0x60, 0xde, 0x1c, 0x65, 0x4e, 0xb2, 0xbd, 0xeb, 0x8c, 0x65, 0xa3, 0x7c, 0x12, 0x21, 0x76,
-	0x52, 0x94, 0xab, 0xfd, 0xed, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x90, 0x12, 0xd5, 0xd7,
-	0x08, 0x00, 0x00,
+	// 993 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xbf, 0x6f, 0x23, 0x45,
+	0x14, 0xce, 0xda, 0x6b, 0x7b, 0x3d, 0xf6, 0x85, 0xd3, 0x2a, 0xd1, 0xad, 0x7d, 0xe0, 0xf5, 0x19,
+	0x09, 0x7c, 0x48, 0xd9, 0x55, 0x82, 0x14, 0xe9, 0x52, 0x11, 0x5f, 0x72, 0xe8, 0x50, 0x22, 0xa2,
+	0x3d, 0x5f, 0x03, 0x48, 0xd6, 0x64, 0x3d, 0x19, 0x8f, 0xf0, 0xee, 0xac, 0x76, 0xc6, 0x2b, 0x9b,
+	0xf2, 0x2a, 0x3a, 0x40, 0xfc, 0x03, 0x14, 0x54, 0x54, 0x14, 0x57, 0x53, 0x9f, 0xa8, 0x4e, 0xd0,
+	0x20, 0x0a, 0x83, 0x1c, 0x24, 0xa4, 0x94, 0xd4, 0x14, 0x68, 0x66, 0xd6, 0x76, 0x7c, 0xc6, 0x09,
+	0x9c, 0x04, 0xdd, 0x7b, 0xf3, 0xbe, 0xf7, 0xc3, 0xdf, 0x37, 0xfb, 0xc6, 0xa0, 0x82, 0x78, 0x0f,
+	0xc5, 0x01, 0x09, 0xb9, 0x8b, 0x92, 0xc0, 0x4d, 0xb6, 0x5d, 0x3e, 0x74, 0xa2, 0x98, 0x72, 0x6a,
+	0xde, 0x9c, 0x85, 0x1c, 0x94, 0x04, 0x4e, 0xb2, 0x5d, 0xbd, 0xe5, 0x53, 0x16, 0x50, 0xe6, 0x06,
+	0x0c, 0x0b, 0x64, 0xc0, 0xb0, 0x82, 0x56, 0x2b, 0x2a, 0xd0, 0x91, 0x9e, 0xab, 0x9c, 0x34, 0x54,
+	0x5d, 0x6a, 0x20, 0x8a, 0xa9, 0xd8, 0x06, 0xa6, 0x98, 0xaa, 0x1c, 0x61, 0xa5, 0xa7, 0xaf, 0x62,
+	0x4a, 0x71, 0x1f, 0xb9, 0x30, 0x22, 0x2e, 0x0c, 0x43, 0xca, 0x21, 0x27, 0x34, 0x9c, 0xd6, 0xab,
+	0xa4, 0x51, 0xe9, 0x9d, 0x0e, 0xce, 0x5c, 0x18, 0x8e, 0x54, 0xa8, 0xf1, 0x99, 0x06, 0x6e, 0x1c,
+	0x33, 0x7c, 0x28, 0x1a, 0xa2, 0x41, 0xd0, 0x1e, 0x9a, 0x4d, 0xa0, 0x77, 0x21, 0x87, 0x96, 0x56,
+	0xd7, 0x9a, 0xa5, 0x9d, 0x0d, 0x47, 0xe5, 0x3a, 0xd3, 0x5c, 0x67, 0x3f, 0x1c, 0x79, 0x12, 0x61,
+	0x56, 0x80, 0xce, 0xc8, 0x27, 0xc8, 0xca, 0xd4, 0xb5, 0xa6, 0xd6, 0xca, 0x5d, 0x8c, 0x6d, 0x6d,
+	0xcb, 0x93, 0x47, 0xa6, 0x0d, 0xf4, 0x1e, 0x64, 0x3d, 0x2b, 0x5b, 0xd7, 0x9a, 0xc5, 0x56, 0xe9,
+	0x8f, 0xb1, 0x5d, 0x88, 0xfb, 0xd1, 0x5e, 0x63, 0xab, 0xe1, 0xc9, 0x80, 0x69, 0x02, 0xfd, 0x2c,
+	0xa6, 0x81, 0xa5, 0x0b, 0x80, 0x27, 0xed, 0x3d, 0xfd, 0xd3, 0xaf, 0xec, 0xb5, 0xc6, 0x17, 0x19,
+	0x60, 0x1c, 0x21, 0x0c, 0xfd, 0x51, 0x7b, 0x68, 0x6e, 0x80, 0x5c, 0x48, 0x43, 0x1f, 0xc9, 0x69,
+	0x74, 0x4f, 0x39, 0xe6, 0x2e, 0x28, 0x62, 0x28, 0x98, 0x23, 0xbe, 0xea, 0x5e, 0x6c, 0x55, 0x7e,
+	0x1e, 0xdb, 0x9b, 0x8a, 0x44, 0xd6, 0xfd, 0xd8, 0x21, 0xd4, 0x0d, 0x20, 0xef, 0x39, 0x0f, 0x43,
+	0xee, 0x19, 0x18, 0xb2, 0x13, 0x01, 0x35, 0x6b, 0x20, 0x8b, 0x21, 0x93, 0x43, 0xe9, 0xad, 0xf2,
+	0x64, 0x6c, 0x1b, 0xef, 0x42, 0x76, 0x44, 0x02, 0xc2, 0x3d, 0x11, 0x30, 0xd7, 0x41, 0x86, 0xd3,
+	0x74, 0xa4, 0x0c, 0xa7, 0xe6, 0x3d, 0x90, 0x4b, 0x60, 0x7f, 0x80, 0xac, 0x9c, 0xec, 0xf1, 0xfa,
+	0xca, 0x1e, 0x93, 0xb1, 0x9d, 0xdf, 0x0f, 0xe8, 0x20, 0xe4, 0x9e, 0xca, 0x10, 0xbf, 0x4f, 0xb2,
+	0x98, 0xaf, 0x6b, 0xcd, 0x72, 0xca, 0x57, 0x19, 0x68, 0x89, 0x55, 0x90, 0x07, 0x5a, 0x22, 0xbc,
+	0xd8, 0x32, 0x94, 0x17, 0x0b, 0x8f, 0x59, 0x45, 0xe5, 0xb1, 0xbd, 0x75, 0xc1, 0xc4, 0xf7, 0x4f,
+	0xb7, 0xf2, 0xed, 0xe1, 0x01, 0xe4, 0xb0, 0xf1, 0x5d, 0x16, 0x94, 0xf7, 0x7d, 0x1f, 0x31, 0x76,
+	0x44, 0x18, 0x6f, 0x0f, 0xcd, 0xf7, 0x80, 0xe1, 0xf7, 0x20, 0x09, 0x3b, 0xa4, 0x2b, 0xa9, 0x29,
+	0xb6, 0xdc, 0xab, 0x86, 0x2b, 0xdc, 0x17, 0xe0, 0x87, 0x07, 0x17, 0x63, 0xbb, 0xe0, 0x2b, 0xd3,
+	0x4b, 0x8d, 0xee, 0x9c, 0xe3, 0xcc, 0x4a, 0x8e, 0xb3, 0xff, 0x9a, 0x63, 0xfd, 0x6a, 0x8e, 0x73,
+	0xcb, 0x1c, 0xe7, 0x5f, 0x9a, 0xe3, 0xc2, 0x25, 0x8e, 0x3f, 0x04, 0x06, 0x94, 0x44, 0x21, 0x66,
+	0x19, 0xf5, 0x6c, 0xb3, 0xb4, 0xf3, 0x9a, 0xf3, 0xe2, 0x37, 0xe9, 0x28, 0x2a, 0xdb, 0x83, 0xa8,
+	0x8f, 0x5a, 0xf5, 0x67, 0x63, 0x7b, 0xed, 0x62, 0x6c, 0x03, 0x38, 0xe3, 0xf7, 0x9b, 0x5f, 0x6c,
+	0x30, 0x67, 0xdb, 0x9b, 0x15, 0x54, 0x02, 0x16, 0x17, 0x04, 0x04, 0x0b, 0x02, 0x96, 0x56, 0x09,
+	0xf8, 0x67, 0x16, 0x94, 0x0f, 0x46, 0x21, 0x0c, 0x88, 0xff, 0x00, 0xa1, 0xff, 0x45, 0xc0, 0x7b,
+	0xa0, 0x24, 0x04, 0xe4, 0x24, 0xea, 0xf8, 0x30, 0xba, 0x5e, 0x42, 0x21, 0x77, 0x9b, 0x44, 0xf7,
+	0x61, 0x34, 0x4d, 0x3d, 0x43, 0x48, 0xa6, 0xea, 0xff, 0x24, 0xf5, 0x01, 0x42, 0x22, 0x35, 0x95,
+	0x3f, 0x77, 0xb5, 0xfc, 0xf9, 0x65, 0xf9, 0x0b, 0x2f, 0x2d, 0xbf, 0xb1, 0x42, 0xfe, 0xe2, 0x7f,
+	0x22, 0x3f, 0x58, 0x90, 0xbf, 0xb4, 0x20, 0x7f, 0x79, 0x95, 0xfc, 0x3e, 0xa8, 0x1e, 0x0e, 0x39,
+	0x0a, 0x19, 0xa1, 0xe1, 0xfb, 0x91, 0x5c, 0xcd, 0x97, 0x36, 0xee, 0x6d, 0x50, 0x14, 0x54, 0x47,
+	0x70, 0x84, 0x62, 0x75, 0x19, 0x3c, 0xe3, 0x0c, 0xa1, 0x13, 0xe1, 0x9b, 0x0d, 0x70, 0x63, 0x16,
+	0xec, 0x30, 0x82, 0xa5, 0xc8, 0x65, 0xaf, 0x34, 0x05, 0x3c, 0x22, 0x38, 0x5d, 0x9c, 0x5f, 0x6b,
+	0x60, 0x73, 0x61, 0x95, 0x7b, 0x88, 0x45, 0x34, 0x64, 0x92, 0x29, 0xb9, 0x8d, 0x55, 0x6d, 0x69,
+	0x9b, 0x77, 0x81, 0xde, 0xa7, 0x98, 0x59, 0x19, 0xc9, 0xd2, 0xe6, 0x32, 0x4b, 0x47, 0x14, 0x7b,
+	0x12, 0x62, 0xde, 0x04, 0xd9, 0x18, 0x71, 0x79, 0x83, 0xca, 0x9e, 0x30, 0xcd, 0x0a, 0x30, 0x92,
+	0xa0, 0x83, 0xe2, 0x98, 0xc6, 0xe9, 0xba, 0x2c, 0x24, 0xc1, 0xa1, 0x70, 0x45, 0x48, 0xdc, 0x9d,
+	0x01, 0x43, 0x5d, 0x75, 0x0b, 0xbc, 0x02, 0x86, 0xec, 0x31, 0x43, 0xdd, 0xe9, 0x7e, 0xd7, 0xc0,
+	0x2b, 0xc7, 0x0c, 0x3f, 0x8e, 0xba, 0x90, 0xa3, 0x13, 0x18, 0xc3, 0x80, 0x89, 0x65, 0x03, 0x07,
+	0xbc, 0x47, 0x63, 0xc2, 0x47, 0xe9, 0xe7, 0x60, 0xfd, 0xf0, 0x74, 0x6b, 0x23, 0x7d, 0x15, 0xf7,
+	0xbb, 0xdd, 0x18, 0x31, 0xf6, 0x88, 0xc7, 0x24, 0xc4, 0xde, 0x1c, 0x6a, 0xee, 0x82, 0x7c, 0x24,
+	0x2b, 0x48, 0x56, 0x4a, 0x3b, 0xd6, 0xf2, 0xcf, 0x50, 0x1d, 0x5a, 0xba, 0xd0, 0xd9, 0x4b, 0xd1,
+	0x7b, 0xeb, 0x4f, 0x7e, 0xff, 0xf6, 0xad, 0x79, 0x9d, 0x46, 0x05, 0xdc, 0x7a, 0x61, 0xa4, 0x29,
+	0x77, 0x3b, 0x63, 0x0d, 0x64, 0x8f, 0x19, 0x36, 0x47, 0x00, 0x5c, 0x92, 0xcc, 0x5e, 0x6e, 0xb4,
+	0x40, 0x7d, 0xf5, 0xcd, 0x6b, 0x00, 0xd3, 0xfa, 0x8d, 0x3b, 0x4f, 0x7e, 0xfc, 0xed, 0xcb, 0xcc,
+	0xed, 0x46, 0x45, 0xbc, 0xf1, 0x94, 0xcd, 0x1e, 0xfc, 0x14, 0xd9, 0xe1, 0x43, 0xf3, 0x23, 0x50,
+	0x5e, 0x60, 0xeb, 0xce, 0xdf, 0xd6, 0xbe, 0x0c, 0xa9, 0xde, 0xbd, 0x16, 0x32, 0x1d, 0xa0, 0xf5,
+	0xce, 0xb3, 0x49, 0x4d, 0x7b, 0x3e, 0xa9, 0x69, 0xbf, 0x4e, 0x6a, 0xda, 0xe7, 0xe7, 0xb5, 0xb5,
+	0xe7, 0xe7, 0xb5, 0xb5, 0x9f, 0xce, 0x6b, 0x6b, 0x1f, 0xbc, 0x81, 0x09, 0xef, 0x0d, 0x4e, 0x1d,
+	0x9f, 0x06, 0xf3, 0xe1, 0x28, 0x73, 0x93, 0xed, 0x5d, 0x77, 0x28, 0x07, 0xe5, 0xa3, 0x08, 0xb1,
+	0xd3, 0xbc, 0xfc, 0x6f, 0xf0, 0xf6, 0x5f, 0x03, 0x00, 0xc3, 0xd4, 0x3b, 0x10, 0x18, 0x09, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1055,6 +1066,20 @@ func (m *ExtensionOptionsEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeePayerSig) > 0 {
+		i -= len(m.FeePayerSig)
+		copy(dAtA[i:], m.FeePayerSig)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeePayerSig)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1378,6 +1403,14 @@ func (m *ExtensionOptionsEthereumTx) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FeePayerSig)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2791,6 +2824,72 @@ func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayerSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayerSig = append(m.FeePayerSig[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeePayerSig == nil {
+				m.FeePayerSig = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3,6 +3,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -25,6 +27,7 @@ func GetQueryCmd() *cobra.Command {
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
 		GetParamsCmd(),
+		GetFeeHistoryCmd(),
 	)
 	return cmd
 }
@@ -116,3 +119,40 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetFeeHistoryCmd queries the fee data of the most recent blocks
+func GetFeeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-history [BLOCKS]",
+		Short: "Get the fee data of the most recent blocks",
+		Long: `Get the base fee, gas used and gas limit of the most recent blocks kept in the fee history.
+If the number of blocks is not provided, it returns the 100 most recent blocks kept in the fee history`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var blocks uint64
+			if len(args) == 1 {
+				blocks, err = strconv.ParseUint(args[0], 10, 32)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeHistory(cmd.Context(), &types.QueryFeeHistoryRequest{Blocks: uint32(blocks)})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
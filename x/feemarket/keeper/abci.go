@@ -63,11 +63,29 @@ func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) {
 	// gasWanted = max(gasWanted * MinGasMultiplier, gasUsed)
 	// this will be keep BaseFee protected from un-penalized manipulation
 	// more info here https://github.com/evmos/ethermint/pull/1105#discussion_r888798925
-	minGasMultiplier := k.GetParams(ctx).MinGasMultiplier
+	params := k.GetParams(ctx)
+	minGasMultiplier := params.MinGasMultiplier
 	limitedGasWanted := math.LegacyNewDec(gasWanted.Int64()).Mul(minGasMultiplier)
 	updatedGasWanted := math.LegacyMaxDec(limitedGasWanted, math.LegacyNewDec(gasUsed.Int64())).TruncateInt().Uint64()
 	k.SetBlockGasWanted(ctx, updatedGasWanted)
 
+	// keep the fee data of the block in the fee history and prune the blocks
+	// that fall outside of the history window
+	if params.FeeHistorySize > 0 {
+		baseFee := math.ZeroInt()
+		if bf := k.GetBaseFee(ctx); bf != nil {
+			baseFee = math.NewIntFromBigInt(bf)
+		}
+
+		k.SetBlockFeeData(ctx, types.BlockFeeData{
+			Height:   ctx.BlockHeight(),
+			BaseFee:  baseFee,
+			GasUsed:  gasUsed.Uint64(),
+			GasLimit: ctx.BlockGasMeter().Limit(),
+		})
+	}
+	k.PruneFeeHistory(ctx, ctx.BlockHeight(), params.FeeHistorySize)
+
 	defer func() {
 		telemetry.SetGauge(float32(updatedGasWanted), "feemarket", "block_gas")
 	}()
@@ -9,6 +9,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 // CalculateBaseFee calculates the base fee for the current block. This is only calculated once per
@@ -76,6 +77,7 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 			x.Div(y, baseFeeChangeDenominator),
 			common.Big1,
 		)
+		baseFeeDelta = boundBaseFeeDelta(params, parentBaseFee, baseFeeDelta)
 
 		return x.Add(parentBaseFee, baseFeeDelta)
 	}
@@ -86,9 +88,27 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	x := new(big.Int).Mul(parentBaseFee, gasUsedDelta)
 	y := x.Div(x, parentGasTargetBig)
 	baseFeeDelta := x.Div(y, baseFeeChangeDenominator)
+	baseFeeDelta = boundBaseFeeDelta(params, parentBaseFee, baseFeeDelta)
 
 	// Set global min gas price as lower bound of the base fee, transactions below
 	// the min gas price don't even reach the mempool.
 	minGasPrice := params.MinGasPrice.TruncateInt().BigInt()
 	return math.BigMax(x.Sub(parentBaseFee, baseFeeDelta), minGasPrice)
 }
+
+// boundBaseFeeDelta caps the base fee change between blocks to the bounds
+// defined in the parameters. The relative bound limits the delta to
+// parentBaseFee / MinBaseFeeChangeDenominator and the absolute bound limits it
+// to MaxBaseFeeDelta. A zero value disables the respective bound.
+func boundBaseFeeDelta(params types.Params, parentBaseFee, baseFeeDelta *big.Int) *big.Int {
+	if params.MinBaseFeeChangeDenominator > 0 {
+		maxDelta := new(big.Int).Div(parentBaseFee, new(big.Int).SetUint64(uint64(params.MinBaseFeeChangeDenominator)))
+		baseFeeDelta = math.BigMin(baseFeeDelta, maxDelta)
+	}
+
+	if !params.MaxBaseFeeDelta.IsNil() && params.MaxBaseFeeDelta.IsPositive() {
+		baseFeeDelta = math.BigMin(baseFeeDelta, params.MaxBaseFeeDelta.BigInt())
+	}
+
+	return baseFeeDelta
+}
@@ -115,3 +115,83 @@ func (suite *KeeperTestSuite) TestCalculateBaseFee() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCalculateBaseFeeBounds() {
+	testCases := []struct {
+		name                        string
+		parentBlockGasWanted        uint64
+		minBaseFeeChangeDenominator uint32
+		maxBaseFeeDelta             math.Int
+		expFee                      *big.Int
+	}{
+		{
+			"bounds disabled - base fee increase is unchanged",
+			100,
+			0,
+			math.ZeroInt(),
+			big.NewInt(1125000000),
+		},
+		{
+			"base fee increase bounded by min base fee change denominator",
+			100,
+			16,
+			math.ZeroInt(),
+			big.NewInt(1062500000),
+		},
+		{
+			"base fee increase bounded by max base fee delta",
+			100,
+			0,
+			math.NewInt(1000),
+			big.NewInt(1000001000),
+		},
+		{
+			"base fee increase within both bounds",
+			100,
+			4,
+			math.NewInt(500000000),
+			big.NewInt(1125000000),
+		},
+		{
+			"base fee decrease bounded by min base fee change denominator",
+			25,
+			32,
+			math.ZeroInt(),
+			big.NewInt(968750000),
+		},
+		{
+			"base fee decrease bounded by max base fee delta",
+			25,
+			16,
+			math.NewInt(1000),
+			big.NewInt(999999000),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.NoBaseFee = false
+			params.MinGasPrice = math.LegacyZeroDec()
+			params.MinBaseFeeChangeDenominator = tc.minBaseFeeChangeDenominator
+			params.MaxBaseFeeDelta = tc.maxBaseFeeDelta
+			err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			suite.ctx = suite.ctx.WithBlockHeight(1)
+			suite.app.FeeMarketKeeper.SetBlockGasWanted(suite.ctx, tc.parentBlockGasWanted)
+
+			// Set next block target/gasLimit through Consensus Param MaxGas
+			blockParams := tmproto.BlockParams{
+				MaxGas:   100,
+				MaxBytes: 10,
+			}
+			consParams := tmproto.ConsensusParams{Block: &blockParams}
+			suite.ctx = suite.ctx.WithConsensusParams(&consParams)
+
+			fee := suite.app.FeeMarketKeeper.CalculateBaseFee(suite.ctx)
+			suite.Require().Equal(tc.expFee, fee)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+// SetBlockFeeData stores the fee data of a block in the fee history.
+func (k Keeper) SetBlockFeeData(ctx sdk.Context, data types.BlockFeeData) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockFeeData)
+	bz := k.cdc.MustMarshal(&data)
+	store.Set(sdk.Uint64ToBigEndian(uint64(data.Height)), bz)
+}
+
+// GetFeeHistory returns the fee data of up to the given number of most recent
+// blocks kept in the fee history, in ascending height order. A limit of 0
+// returns all the blocks kept in the fee history.
+func (k Keeper) GetFeeHistory(ctx sdk.Context, limit uint32) []types.BlockFeeData {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockFeeData)
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	var history []types.BlockFeeData
+	for ; iterator.Valid(); iterator.Next() {
+		if limit > 0 && len(history) == int(limit) {
+			break
+		}
+
+		var data types.BlockFeeData
+		k.cdc.MustUnmarshal(iterator.Value(), &data)
+		history = append(history, data)
+	}
+
+	// reverse the entries to return them in ascending height order
+	for i, j := 0, len(history)-1; i < j; i, j = i+1, j-1 {
+		history[i], history[j] = history[j], history[i]
+	}
+
+	return history
+}
+
+// PruneFeeHistory deletes the fee data of the blocks that are older than the
+// given number of most recent blocks, counted from the given height.
+func (k Keeper) PruneFeeHistory(ctx sdk.Context, height int64, size uint32) {
+	oldest := height - int64(size) + 1
+	if oldest <= 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockFeeData)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(oldest)))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/abci/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/evmos/evmos/v16/x/feemarket"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+func (suite *KeeperTestSuite) TestFeeHistory() {
+	testCases := []struct {
+		name       string
+		size       uint32
+		endBlocks  int64
+		limit      uint32
+		expHeights []int64
+	}{
+		{
+			"fee history disabled",
+			0,
+			5,
+			0,
+			nil,
+		},
+		{
+			"all blocks within the window",
+			10,
+			5,
+			0,
+			[]int64{1, 2, 3, 4, 5},
+		},
+		{
+			"older blocks are pruned",
+			3,
+			5,
+			0,
+			[]int64{3, 4, 5},
+		},
+		{
+			"limit returns the most recent blocks",
+			10,
+			5,
+			2,
+			[]int64{4, 5},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.FeeHistorySize = tc.size
+			err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			for height := int64(1); height <= tc.endBlocks; height++ {
+				meter := storetypes.NewGasMeter(uint64(1000000))
+				meter.ConsumeGas(uint64(height*100), "test")
+				suite.ctx = suite.ctx.WithBlockHeight(height).WithBlockGasMeter(meter)
+				suite.app.FeeMarketKeeper.EndBlock(suite.ctx, types.RequestEndBlock{Height: height})
+			}
+
+			res, err := suite.queryClient.FeeHistory(suite.ctx.Context(), &feemarkettypes.QueryFeeHistoryRequest{Blocks: tc.limit})
+			suite.Require().NoError(err)
+			suite.Require().Len(res.FeeHistory, len(tc.expHeights))
+
+			baseFee := math.NewIntFromBigInt(suite.app.FeeMarketKeeper.GetBaseFee(suite.ctx))
+			for i, data := range res.FeeHistory {
+				suite.Require().Equal(tc.expHeights[i], data.Height)
+				suite.Require().Equal(uint64(data.Height*100), data.GasUsed)
+				suite.Require().Equal(uint64(1000000), data.GasLimit)
+				suite.Require().Equal(baseFee, data.BaseFee)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneFeeHistoryOnSizeDecrease() {
+	suite.SetupTest()
+
+	for height := int64(1); height <= 10; height++ {
+		suite.app.FeeMarketKeeper.SetBlockFeeData(suite.ctx, feemarkettypes.BlockFeeData{
+			Height:  height,
+			BaseFee: math.ZeroInt(),
+		})
+	}
+
+	suite.app.FeeMarketKeeper.PruneFeeHistory(suite.ctx, 10, 2)
+
+	history := suite.app.FeeMarketKeeper.GetFeeHistory(suite.ctx, 0)
+	suite.Require().Len(history, 2)
+	suite.Require().Equal(int64(9), history[0].Height)
+	suite.Require().Equal(int64(10), history[1].Height)
+}
+
+func (suite *KeeperTestSuite) TestFeeHistoryDefaultQueryBlocks() {
+	suite.SetupTest()
+
+	blocks := int64(feemarkettypes.DefaultFeeHistoryQueryBlocks) + 10
+	for height := int64(1); height <= blocks; height++ {
+		suite.app.FeeMarketKeeper.SetBlockFeeData(suite.ctx, feemarkettypes.BlockFeeData{
+			Height:  height,
+			BaseFee: math.ZeroInt(),
+		})
+	}
+
+	res, err := suite.queryClient.FeeHistory(suite.ctx.Context(), &feemarkettypes.QueryFeeHistoryRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.FeeHistory, int(feemarkettypes.DefaultFeeHistoryQueryBlocks))
+	suite.Require().Equal(int64(11), res.FeeHistory[0].Height)
+	suite.Require().Equal(blocks, res.FeeHistory[len(res.FeeHistory)-1].Height)
+}
+
+func (suite *KeeperTestSuite) TestFeeHistoryGenesis() {
+	suite.SetupTest()
+
+	for height := int64(1); height <= 3; height++ {
+		suite.app.FeeMarketKeeper.SetBlockFeeData(suite.ctx, feemarkettypes.BlockFeeData{
+			Height:   height,
+			BaseFee:  math.NewInt(height * 10),
+			GasUsed:  uint64(height * 100),
+			GasLimit: 1000,
+		})
+	}
+
+	genState := feemarket.ExportGenesis(suite.ctx, suite.app.FeeMarketKeeper)
+	suite.Require().Len(genState.FeeHistory, 3)
+	suite.Require().NoError(genState.Validate())
+
+	// import the exported state on a fresh chain
+	suite.SetupTest()
+	suite.Require().Empty(suite.app.FeeMarketKeeper.GetFeeHistory(suite.ctx, 0))
+
+	feemarket.InitGenesis(suite.ctx, suite.app.FeeMarketKeeper, *genState)
+	suite.Require().Equal(genState.FeeHistory, suite.app.FeeMarketKeeper.GetFeeHistory(suite.ctx, 0))
+}
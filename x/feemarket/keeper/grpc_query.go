@@ -8,6 +8,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/evmos/evmos/v16/x/feemarket/types"
 )
@@ -24,10 +26,23 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 	}, nil
 }
 
-// BaseFee implements the Query/BaseFee gRPC method
-func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+// BaseFee implements the Query/BaseFee gRPC method. If a height is provided,
+// the base fee is read from the versioned store at that height, which requires
+// the query context function to be set on the keeper.
+func (k Keeper) BaseFee(c context.Context, req *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
+	if req != nil && req.Height != 0 && req.Height != ctx.BlockHeight() {
+		if req.Height > ctx.BlockHeight() {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot query with height %d in the future", req.Height)
+		}
+
+		var err error
+		if ctx, err = k.historicalContext(req.Height); err != nil {
+			return nil, err
+		}
+	}
+
 	res := &types.QueryBaseFeeResponse{}
 	baseFee := k.GetBaseFee(ctx)
 
@@ -52,3 +67,43 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: gas.Int64(),
 	}, nil
 }
+
+// FeeHistory implements the Query/FeeHistory gRPC method
+func (k Keeper) FeeHistory(c context.Context, req *types.QueryFeeHistoryRequest) (*types.QueryFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	blocks := req.Blocks
+	if blocks == 0 {
+		blocks = types.DefaultFeeHistoryQueryBlocks
+	}
+
+	return &types.QueryFeeHistoryResponse{
+		FeeHistory: k.GetFeeHistory(ctx, blocks),
+	}, nil
+}
+
+// historicalContext returns a query context on the state committed at the
+// given height.
+func (k Keeper) historicalContext(height int64) (sdk.Context, error) {
+	if height < 0 {
+		return sdk.Context{}, status.Errorf(codes.InvalidArgument, "invalid height %d", height)
+	}
+
+	if k.queryContextFn == nil {
+		return sdk.Context{}, status.Error(codes.Unimplemented, "historical queries are not supported")
+	}
+
+	ctx, err := k.queryContextFn(height, false)
+	if err != nil {
+		return sdk.Context{}, status.Errorf(
+			codes.NotFound,
+			"base fee at height %d is not available, the state may have been pruned: %s", height, err,
+		)
+	}
+
+	return ctx, nil
+}
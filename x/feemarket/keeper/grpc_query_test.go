@@ -1,9 +1,16 @@
 package keeper_test
 
 import (
+	"errors"
+	"math/big"
+
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v16/x/feemarket/keeper"
 	"github.com/evmos/evmos/v16/x/feemarket/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
@@ -75,6 +82,77 @@ func (suite *KeeperTestSuite) TestQueryBaseFee() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryBaseFeeHistorical() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	suite.app.FeeMarketKeeper.SetBaseFee(ctx, big.NewInt(2))
+
+	// the state at height 5 has a different base fee
+	historicalCtx, _ := ctx.CacheContext()
+	suite.app.FeeMarketKeeper.SetBaseFee(historicalCtx, big.NewInt(1))
+
+	testCases := []struct {
+		name       string
+		height     int64
+		queryCtxFn keeper.QueryContextFn
+		expBaseFee int64
+		expCode    codes.Code
+	}{
+		{
+			name:   "pass - base fee at a historical height",
+			height: 5,
+			queryCtxFn: func(height int64, _ bool) (sdk.Context, error) {
+				suite.Require().Equal(int64(5), height)
+				return historicalCtx, nil
+			},
+			expBaseFee: 1,
+		},
+		{
+			name:       "pass - the current height is read from the current state",
+			height:     10,
+			expBaseFee: 2,
+		},
+		{
+			name:    "fail - historical queries without a query context function",
+			height:  5,
+			expCode: codes.Unimplemented,
+		},
+		{
+			name:   "fail - pruned height",
+			height: 5,
+			queryCtxFn: func(int64, bool) (sdk.Context, error) {
+				return sdk.Context{}, errors.New("version does not exist")
+			},
+			expCode: codes.NotFound,
+		},
+		{
+			name:    "fail - future height",
+			height:  11,
+			expCode: codes.InvalidArgument,
+		},
+		{
+			name:    "fail - negative height",
+			height:  -1,
+			expCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			k := suite.app.FeeMarketKeeper.WithQueryContextFn(tc.queryCtxFn)
+
+			res, err := k.BaseFee(ctx, &types.QueryBaseFeeRequest{Height: tc.height})
+			if tc.expCode != codes.OK {
+				suite.Require().Error(err)
+				suite.Require().Equal(tc.expCode, status.Code(err))
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdkmath.NewInt(tc.expBaseFee), *res.BaseFee)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryBlockGas() {
 	testCases := []struct {
 		name    string
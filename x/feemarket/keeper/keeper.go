@@ -28,8 +28,15 @@ type Keeper struct {
 	authority sdk.AccAddress
 	// Legacy subspace
 	ss paramstypes.Subspace
+	// queryContextFn creates a query context on the versioned store at a given
+	// height. It is used to query the base fee at historical heights.
+	queryContextFn QueryContextFn
 }
 
+// QueryContextFn defines the function that creates a query context on the
+// state committed at the given height, such as BaseApp.CreateQueryContext.
+type QueryContextFn func(height int64, prove bool) (sdk.Context, error)
+
 // NewKeeper generates new fee market module keeper
 func NewKeeper(
 	cdc codec.BinaryCodec, authority sdk.AccAddress, storeKey, transientKey storetypes.StoreKey, ss paramstypes.Subspace,
@@ -48,6 +55,14 @@ func NewKeeper(
 	}
 }
 
+// WithQueryContextFn sets the function used to create query contexts at
+// historical heights. If it is not set, the BaseFee queries at a past height
+// fail with codes.Unimplemented.
+func (k Keeper) WithQueryContextFn(fn QueryContextFn) Keeper {
+	k.queryContextFn = fn
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
@@ -30,6 +30,10 @@ func (suite *KeeperTestSuite) TestMigrations() {
 			"Run Migrate3to4",
 			migrator.Migrate3to4,
 		},
+		{
+			"Run Migrate4to5",
+			migrator.Migrate4to5,
+		},
 	}
 
 	for _, tc := range testCases {
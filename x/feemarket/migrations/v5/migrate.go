@@ -0,0 +1,43 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+// MigrateStore migrates the x/feemarket module state from the consensus version 4 to
+// version 5. Specifically, it adds the new MinBaseFeeChangeDenominator, MaxBaseFeeDelta,
+// MinTip and FeeHistorySize params. The defaults disable the base fee change bounds and
+// the minimum tip check, so that the fee market behavior remains unchanged.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	var params types.Params
+
+	store := ctx.KVStore(storeKey)
+
+	// NOTE: the new fields are appended to the Params proto message, so the
+	// stored params can be decoded into the current type.
+	paramsBz := store.Get(types.ParamsKey)
+	cdc.MustUnmarshal(paramsBz, &params)
+
+	params.MinBaseFeeChangeDenominator = types.DefaultMinBaseFeeChangeDenominator
+	params.MaxBaseFeeDelta = types.DefaultMaxBaseFeeDelta
+	params.MinTip = types.DefaultMinTip
+	params.FeeHistorySize = types.DefaultFeeHistorySize
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz := cdc.MustMarshal(&params)
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
@@ -0,0 +1,43 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v16/app"
+	"github.com/evmos/evmos/v16/encoding"
+	v4types "github.com/evmos/evmos/v16/x/feemarket/migrations/v4/types"
+	v5 "github.com/evmos/evmos/v16/x/feemarket/migrations/v5"
+	"github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := ctx.KVStore(storeKey)
+
+	v4Params := v4types.DefaultParams()
+
+	// Set the params in the store
+	paramsV4Bz := cdc.MustMarshal(&v4Params)
+	kvStore.Set(types.ParamsKey, paramsV4Bz)
+
+	err := v5.MigrateStore(ctx, storeKey, cdc)
+	require.NoError(t, err)
+
+	paramsBz := kvStore.Get(types.ParamsKey)
+	var params types.Params
+	cdc.MustUnmarshal(paramsBz, &params)
+
+	// test that the params have been migrated correctly
+	require.Equal(t, types.DefaultParams(), params)
+}
@@ -43,6 +43,20 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// min_base_fee_change_denominator bounds the relative change of the base fee
+	// between blocks to base_fee / min_base_fee_change_denominator. A value of 0
+	// disables the bound.
+	MinBaseFeeChangeDenominator uint32 `protobuf:"varint,9,opt,name=min_base_fee_change_denominator,json=minBaseFeeChangeDenominator,proto3" json:"min_base_fee_change_denominator,omitempty"`
+	// max_base_fee_delta bounds the absolute change of the base fee between
+	// blocks. A value of 0 disables the bound.
+	MaxBaseFeeDelta cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=max_base_fee_delta,json=maxBaseFeeDelta,proto3,customtype=cosmossdk.io/math.Int" json:"max_base_fee_delta"`
+	// min_tip defines the minimum priority fee per gas that EVM transactions must
+	// pay to be accepted in CheckTx. A value of 0 disables the check.
+	MinTip cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=min_tip,json=minTip,proto3,customtype=cosmossdk.io/math.Int" json:"min_tip"`
+	// fee_history_size defines the number of most recent blocks for which the
+	// fee data is kept in the store. A value of 0 disables the fee history. It
+	// cannot exceed 10000 blocks.
+	FeeHistorySize uint32 `protobuf:"varint,12,opt,name=fee_history_size,json=feeHistorySize,proto3" json:"fee_history_size,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -106,8 +120,89 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetMinBaseFeeChangeDenominator() uint32 {
+	if m != nil {
+		return m.MinBaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetFeeHistorySize() uint32 {
+	if m != nil {
+		return m.FeeHistorySize
+	}
+	return 0
+}
+
+// BlockFeeData defines the fee data of a block that is kept in the fee history
+type BlockFeeData struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fee of the block
+	BaseFee cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee"`
+	// gas_used is the gas consumed by the block
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas_limit is the gas limit of the block
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *BlockFeeData) Reset()         { *m = BlockFeeData{} }
+func (m *BlockFeeData) String() string { return proto.CompactTextString(m) }
+func (*BlockFeeData) ProtoMessage()    {}
+func (*BlockFeeData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+func (m *BlockFeeData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockFeeData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockFeeData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockFeeData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockFeeData.Merge(m, src)
+}
+func (m *BlockFeeData) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockFeeData) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockFeeData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockFeeData proto.InternalMessageInfo
+
+func (m *BlockFeeData) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockFeeData) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *BlockFeeData) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
+	proto.RegisterType((*BlockFeeData)(nil), "ethermint.feemarket.v1.BlockFeeData")
 }
 
 func init() {
@@ -115,33 +210,42 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0xdb, 0x30,
-	0x14, 0xc7, 0xad, 0xa5, 0x4d, 0x5d, 0x75, 0x81, 0x20, 0xda, 0x61, 0x56, 0xe6, 0x9a, 0x15, 0x86,
-	0x0f, 0xc3, 0x26, 0x14, 0xc6, 0x2e, 0xbb, 0x64, 0xa5, 0xdd, 0xc6, 0x06, 0x9d, 0x8f, 0xbb, 0x08,
-	0xd9, 0x7d, 0xb1, 0x45, 0x2c, 0xc9, 0x58, 0x8a, 0x59, 0xbe, 0xc5, 0xbe, 0xc9, 0xbe, 0x46, 0x8e,
-	0x39, 0x8e, 0x1d, 0xc2, 0x48, 0xbe, 0xc8, 0x88, 0x9d, 0xd8, 0x81, 0x5d, 0x76, 0x11, 0xd2, 0xfb,
-	0xff, 0x7f, 0x7f, 0xde, 0x43, 0x0f, 0xbf, 0x02, 0x93, 0x41, 0x29, 0xb8, 0x34, 0xe1, 0x04, 0x40,
-	0xb0, 0x72, 0x0a, 0x26, 0xac, 0x46, 0xdd, 0x23, 0x28, 0x4a, 0x65, 0x14, 0x79, 0xd6, 0xfa, 0x82,
-	0x4e, 0xaa, 0x46, 0xcf, 0xcf, 0x53, 0x95, 0xaa, 0xda, 0x12, 0x6e, 0x6f, 0x8d, 0xfb, 0xe5, 0xcf,
-	0x1e, 0xee, 0x3f, 0xb0, 0x92, 0x09, 0x4d, 0x5c, 0x7c, 0x26, 0x15, 0x8d, 0x99, 0x06, 0x3a, 0x01,
-	0x70, 0x90, 0x87, 0x7c, 0x3b, 0x3a, 0x95, 0x6a, 0xcc, 0x34, 0xdc, 0x01, 0x90, 0x77, 0xf8, 0x72,
-	0x2f, 0xd2, 0x24, 0x63, 0x32, 0x05, 0xfa, 0x08, 0x52, 0x09, 0x2e, 0x99, 0x51, 0xa5, 0xf3, 0xc4,
-	0x43, 0xfe, 0x20, 0x72, 0xe2, 0xc6, 0xfd, 0xbe, 0x36, 0xdc, 0x76, 0x3a, 0xb9, 0xc1, 0x17, 0x90,
-	0x33, 0x6d, 0x78, 0xc2, 0xcd, 0x9c, 0x8a, 0x59, 0x6e, 0x78, 0x91, 0x73, 0x28, 0x9d, 0x5e, 0x0d,
-	0x9e, 0x77, 0xe2, 0x97, 0x56, 0x23, 0xd7, 0x78, 0x00, 0x92, 0xc5, 0x39, 0xd0, 0x0c, 0x78, 0x9a,
-	0x19, 0xe7, 0xd8, 0x43, 0x7e, 0x2f, 0x7a, 0xda, 0x14, 0x3f, 0xd4, 0x35, 0xf2, 0x16, 0xdb, 0x6d,
-	0xd7, 0x7d, 0x0f, 0xf9, 0xa7, 0xe3, 0x17, 0x8b, 0xd5, 0x95, 0xf5, 0x7b, 0x75, 0x75, 0x91, 0x28,
-	0x2d, 0x94, 0xd6, 0x8f, 0xd3, 0x80, 0xab, 0x50, 0x30, 0x93, 0x05, 0x1f, 0xa5, 0x89, 0x4e, 0x76,
-	0x4d, 0x92, 0x7b, 0x3c, 0x10, 0x5c, 0xd2, 0x94, 0x69, 0x5a, 0x94, 0x3c, 0x01, 0xe7, 0xa4, 0xc6,
-	0xaf, 0x77, 0xf8, 0xe5, 0xbf, 0xf8, 0x67, 0x48, 0x59, 0x32, 0xbf, 0x85, 0x24, 0x3a, 0x13, 0x5c,
-	0xde, 0x33, 0xfd, 0xb0, 0xe5, 0xc8, 0x57, 0x4c, 0xf6, 0x41, 0x07, 0x93, 0xd9, 0xff, 0x9f, 0x36,
-	0x6c, 0xd2, 0xba, 0xd1, 0x3f, 0x1d, 0xd9, 0x47, 0xc3, 0xe3, 0x68, 0xc8, 0x25, 0x37, 0x9c, 0xe5,
-	0xed, 0xbf, 0x8c, 0xef, 0x16, 0x6b, 0x17, 0x2d, 0xd7, 0x2e, 0xfa, 0xb3, 0x76, 0xd1, 0x8f, 0x8d,
-	0x6b, 0x2d, 0x37, 0xae, 0xf5, 0x6b, 0xe3, 0x5a, 0xdf, 0x5e, 0xa7, 0xdc, 0x64, 0xb3, 0x38, 0x48,
-	0x94, 0x08, 0xa1, 0x12, 0x4a, 0xef, 0xce, 0x6a, 0xf4, 0x26, 0xfc, 0x7e, 0xb0, 0x34, 0x66, 0x5e,
-	0x80, 0x8e, 0xfb, 0xf5, 0x02, 0xdc, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xb6, 0xb6, 0x17, 0xf2,
-	0x58, 0x02, 0x00, 0x00,
+	// 547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4f, 0x6b, 0x1a, 0x4f,
+	0x18, 0x76, 0x13, 0xb3, 0xea, 0x44, 0x7f, 0x3f, 0x19, 0x92, 0xb0, 0xad, 0x74, 0x95, 0x04, 0x8a,
+	0x87, 0xe2, 0x22, 0x81, 0xd0, 0x4b, 0x2f, 0x56, 0x92, 0x34, 0xa4, 0x90, 0x6e, 0xdb, 0x4b, 0x2f,
+	0xcb, 0xb8, 0xbe, 0xee, 0xbe, 0xb8, 0x33, 0xb3, 0xec, 0x8c, 0xa2, 0xf9, 0x14, 0x3d, 0xf6, 0xde,
+	0x2f, 0x93, 0x63, 0x8e, 0xa5, 0x87, 0x50, 0xf4, 0x8b, 0x94, 0x5d, 0x8d, 0x0a, 0xa1, 0xc5, 0x5e,
+	0x96, 0x9d, 0x79, 0xfe, 0xec, 0x33, 0xef, 0x3e, 0x43, 0x5e, 0x82, 0x0e, 0x21, 0xe1, 0x28, 0xb4,
+	0x33, 0x00, 0xe0, 0x2c, 0x19, 0x82, 0x76, 0xc6, 0xed, 0xf5, 0xa2, 0x15, 0x27, 0x52, 0x4b, 0x7a,
+	0xb4, 0xe2, 0xb5, 0xd6, 0xd0, 0xb8, 0xfd, 0xfc, 0x20, 0x90, 0x81, 0xcc, 0x28, 0x4e, 0xfa, 0xb6,
+	0x60, 0x1f, 0x7f, 0xdf, 0x23, 0xe6, 0x0d, 0x4b, 0x18, 0x57, 0xd4, 0x26, 0xfb, 0x42, 0x7a, 0x3d,
+	0xa6, 0xc0, 0x1b, 0x00, 0x58, 0x46, 0xc3, 0x68, 0x16, 0xdd, 0x92, 0x90, 0x1d, 0xa6, 0xe0, 0x1c,
+	0x80, 0xbe, 0x21, 0xb5, 0x47, 0xd0, 0xf3, 0x43, 0x26, 0x02, 0xf0, 0xfa, 0x20, 0x24, 0x47, 0xc1,
+	0xb4, 0x4c, 0xac, 0x9d, 0x86, 0xd1, 0xac, 0xb8, 0x56, 0x6f, 0xc1, 0x7e, 0x9b, 0x11, 0xba, 0x6b,
+	0x9c, 0x9e, 0x92, 0x43, 0x88, 0x98, 0xd2, 0xe8, 0xa3, 0x9e, 0x7a, 0x7c, 0x14, 0x69, 0x8c, 0x23,
+	0x84, 0xc4, 0xda, 0xcd, 0x84, 0x07, 0x6b, 0xf0, 0xfd, 0x0a, 0xa3, 0x27, 0xa4, 0x02, 0x82, 0xf5,
+	0x22, 0xf0, 0x42, 0xc0, 0x20, 0xd4, 0xd6, 0x5e, 0xc3, 0x68, 0xee, 0xba, 0xe5, 0xc5, 0xe6, 0x65,
+	0xb6, 0x47, 0x5f, 0x93, 0xe2, 0x2a, 0xb5, 0xd9, 0x30, 0x9a, 0xa5, 0xce, 0x8b, 0xbb, 0x87, 0x7a,
+	0xee, 0xe7, 0x43, 0xfd, 0xd0, 0x97, 0x8a, 0x4b, 0xa5, 0xfa, 0xc3, 0x16, 0x4a, 0x87, 0x33, 0x1d,
+	0xb6, 0xde, 0x09, 0xed, 0x16, 0x96, 0x21, 0xe9, 0x05, 0xa9, 0x70, 0x14, 0x5e, 0xc0, 0x94, 0x17,
+	0x27, 0xe8, 0x83, 0x55, 0xc8, 0xe4, 0x27, 0x4b, 0x79, 0xed, 0xa9, 0xfc, 0x1a, 0x02, 0xe6, 0x4f,
+	0xbb, 0xe0, 0xbb, 0xfb, 0x1c, 0xc5, 0x05, 0x53, 0x37, 0xa9, 0x8e, 0x7e, 0x20, 0xf4, 0xd1, 0x68,
+	0xe3, 0x64, 0xc5, 0xed, 0xdd, 0xaa, 0x0b, 0xb7, 0x8d, 0xa3, 0x77, 0x49, 0x3d, 0xb5, 0xfc, 0xdb,
+	0xc8, 0x4b, 0xd9, 0xe4, 0x6a, 0x1c, 0x45, 0xe7, 0x4f, 0x53, 0xbf, 0x22, 0x94, 0xb3, 0xc9, 0xda,
+	0xa5, 0x0f, 0x91, 0x66, 0x16, 0xd9, 0x66, 0x4a, 0xff, 0x73, 0x36, 0x59, 0xfa, 0x76, 0x53, 0x15,
+	0x3d, 0x23, 0x85, 0x34, 0x91, 0xc6, 0xd8, 0xda, 0xdf, 0xc6, 0xc0, 0xe4, 0x28, 0x3e, 0x61, 0x4c,
+	0x9b, 0xa4, 0x9a, 0x7e, 0x3a, 0x44, 0xa5, 0x65, 0x32, 0xf5, 0x14, 0xde, 0x82, 0x55, 0xce, 0xa2,
+	0xff, 0x37, 0x00, 0xb8, 0x5c, 0x6c, 0x7f, 0xc4, 0x5b, 0xb8, 0xca, 0x17, 0xf3, 0xd5, 0x3d, 0xb7,
+	0x8a, 0x02, 0x35, 0xb2, 0x68, 0x95, 0xfa, 0xf8, 0x9b, 0x41, 0xca, 0x9d, 0x48, 0xfa, 0xc3, 0x34,
+	0x0b, 0xd3, 0x8c, 0x1e, 0x11, 0x73, 0x59, 0x08, 0x23, 0x2b, 0x84, 0x19, 0x3e, 0xad, 0xc2, 0xce,
+	0x3f, 0x55, 0xe1, 0x19, 0x29, 0xa6, 0x7f, 0x6f, 0xa4, 0xa0, 0x9f, 0x35, 0x32, 0xef, 0x16, 0x02,
+	0xa6, 0x3e, 0x2b, 0xe8, 0xd3, 0x1a, 0x29, 0xa5, 0x50, 0x84, 0x1c, 0xb5, 0x95, 0xcf, 0xb0, 0x94,
+	0x7b, 0x9d, 0xae, 0x3b, 0xe7, 0x77, 0x33, 0xdb, 0xb8, 0x9f, 0xd9, 0xc6, 0xaf, 0x99, 0x6d, 0x7c,
+	0x9d, 0xdb, 0xb9, 0xfb, 0xb9, 0x9d, 0xfb, 0x31, 0xb7, 0x73, 0x5f, 0x5e, 0x05, 0xa8, 0xc3, 0x51,
+	0xaf, 0xe5, 0x4b, 0xee, 0xc0, 0x98, 0x4b, 0xb5, 0x7c, 0x8e, 0xdb, 0x67, 0xce, 0x64, 0xe3, 0x0e,
+	0xeb, 0x69, 0x0c, 0xaa, 0x67, 0x66, 0xf7, 0xf1, 0xf4, 0xf7, 0x00, 0xd8, 0xd4, 0x9a, 0x17, 0xe7,
+	0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -164,6 +268,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FeeHistorySize != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.FeeHistorySize))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.MinTip.Size()
+		i -= size
+		if _, err := m.MinTip.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MaxBaseFeeDelta.Size()
+		i -= size
+		if _, err := m.MaxBaseFeeDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.MinBaseFeeChangeDenominator != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.MinBaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -222,6 +356,54 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlockFeeData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockFeeData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockFeeData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeemarket(v)
 	base := offset
@@ -257,6 +439,36 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	if m.MinBaseFeeChangeDenominator != 0 {
+		n += 1 + sovFeemarket(uint64(m.MinBaseFeeChangeDenominator))
+	}
+	l = m.MaxBaseFeeDelta.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinTip.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.FeeHistorySize != 0 {
+		n += 1 + sovFeemarket(uint64(m.FeeHistorySize))
+	}
+	return n
+}
+
+func (m *BlockFeeData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.GasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasUsed))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasLimit))
+	}
 	return n
 }
 
@@ -474,6 +686,253 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFeeChangeDenominator", wireType)
+			}
+			m.MinBaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFeeDelta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFeeDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeHistorySize", wireType)
+			}
+			m.FeeHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeHistorySize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockFeeData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockFeeData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockFeeData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
@@ -2,6 +2,8 @@
 // SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
 package types
 
+import "fmt"
+
 // DefaultGenesisState sets default fee market genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
@@ -21,5 +23,27 @@ func NewGenesisState(params Params, blockGas uint64) *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if len(gs.FeeHistory) > int(gs.Params.FeeHistorySize) {
+		return fmt.Errorf(
+			"fee history has %d blocks, above the fee history size of %d blocks",
+			len(gs.FeeHistory), gs.Params.FeeHistorySize,
+		)
+	}
+
+	var prevHeight int64
+	for _, data := range gs.FeeHistory {
+		if data.Height <= prevHeight {
+			return fmt.Errorf("fee history heights must be positive and ascending, got %d after %d", data.Height, prevHeight)
+		}
+		if data.BaseFee.IsNil() || data.BaseFee.IsNegative() {
+			return fmt.Errorf("invalid base fee of the fee history at height %d", data.Height)
+		}
+		prevHeight = data.Height
+	}
+
+	return nil
 }
@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -28,8 +29,8 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		{
 			"valid genesis",
 			&GenesisState{
-				DefaultParams(),
-				uint64(1),
+				Params:   DefaultParams(),
+				BlockGas: uint64(1),
 			},
 			true,
 		},
@@ -41,6 +42,51 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			),
 			true,
 		},
+		{
+			"valid genesis with fee history",
+			&GenesisState{
+				Params: DefaultParams(),
+				FeeHistory: []BlockFeeData{
+					{Height: 1, BaseFee: math.NewInt(10)},
+					{Height: 2, BaseFee: math.NewInt(20)},
+				},
+			},
+			true,
+		},
+		{
+			"fee history not in ascending height order",
+			&GenesisState{
+				Params: DefaultParams(),
+				FeeHistory: []BlockFeeData{
+					{Height: 2, BaseFee: math.NewInt(20)},
+					{Height: 1, BaseFee: math.NewInt(10)},
+				},
+			},
+			false,
+		},
+		{
+			"fee history with a nil base fee",
+			&GenesisState{
+				Params:     DefaultParams(),
+				FeeHistory: []BlockFeeData{{Height: 1}},
+			},
+			false,
+		},
+		{
+			"fee history above the fee history size",
+			&GenesisState{
+				Params: func() Params {
+					params := DefaultParams()
+					params.FeeHistorySize = 1
+					return params
+				}(),
+				FeeHistory: []BlockFeeData{
+					{Height: 1, BaseFee: math.NewInt(10)},
+					{Height: 2, BaseFee: math.NewInt(20)},
+				},
+			},
+			false,
+		},
 		{
 			"empty genesis",
 			&GenesisState{
@@ -22,6 +22,7 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixBlockFeeData
 )
 
 const (
@@ -31,6 +32,7 @@ const (
 // KVStore key prefixes
 var (
 	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockFeeData   = []byte{prefixBlockFeeData}
 )
 
 // Transient Store key prefixes
@@ -19,6 +19,19 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultMinBaseFeeChangeDenominator is 0 (i.e disabled)
+	DefaultMinBaseFeeChangeDenominator = uint32(0)
+	// DefaultMaxBaseFeeDelta is 0 (i.e disabled)
+	DefaultMaxBaseFeeDelta = math.ZeroInt()
+	// DefaultMinTip is 0 (i.e disabled)
+	DefaultMinTip = math.ZeroInt()
+	// DefaultFeeHistorySize is 100 blocks
+	DefaultFeeHistorySize = uint32(100)
+	// MaxFeeHistorySize is the maximum number of blocks kept in the fee history
+	MaxFeeHistorySize = uint32(10_000)
+	// DefaultFeeHistoryQueryBlocks is the number of blocks returned by the fee
+	// history query when none is requested
+	DefaultFeeHistoryQueryBlocks = uint32(100)
 )
 
 // Parameter keys
@@ -62,26 +75,34 @@ func NewParams(
 	minGasPriceMultiplier math.LegacyDec,
 ) Params {
 	return Params{
-		NoBaseFee:                noBaseFee,
-		BaseFeeChangeDenominator: baseFeeChangeDenom,
-		ElasticityMultiplier:     elasticityMultiplier,
-		BaseFee:                  math.NewIntFromUint64(baseFee),
-		EnableHeight:             enableHeight,
-		MinGasPrice:              minGasPrice,
-		MinGasMultiplier:         minGasPriceMultiplier,
+		NoBaseFee:                   noBaseFee,
+		BaseFeeChangeDenominator:    baseFeeChangeDenom,
+		ElasticityMultiplier:        elasticityMultiplier,
+		BaseFee:                     math.NewIntFromUint64(baseFee),
+		EnableHeight:                enableHeight,
+		MinGasPrice:                 minGasPrice,
+		MinGasMultiplier:            minGasPriceMultiplier,
+		MinBaseFeeChangeDenominator: DefaultMinBaseFeeChangeDenominator,
+		MaxBaseFeeDelta:             DefaultMaxBaseFeeDelta,
+		MinTip:                      DefaultMinTip,
+		FeeHistorySize:              DefaultFeeHistorySize,
 	}
 }
 
 // DefaultParams returns default evm parameters
 func DefaultParams() Params {
 	return Params{
-		NoBaseFee:                DefaultNoBaseFee,
-		BaseFeeChangeDenominator: params.BaseFeeChangeDenominator,
-		ElasticityMultiplier:     params.ElasticityMultiplier,
-		BaseFee:                  math.NewIntFromUint64(params.InitialBaseFee),
-		EnableHeight:             DefaultEnableHeight,
-		MinGasPrice:              DefaultMinGasPrice,
-		MinGasMultiplier:         DefaultMinGasMultiplier,
+		NoBaseFee:                   DefaultNoBaseFee,
+		BaseFeeChangeDenominator:    params.BaseFeeChangeDenominator,
+		ElasticityMultiplier:        params.ElasticityMultiplier,
+		BaseFee:                     math.NewIntFromUint64(params.InitialBaseFee),
+		EnableHeight:                DefaultEnableHeight,
+		MinGasPrice:                 DefaultMinGasPrice,
+		MinGasMultiplier:            DefaultMinGasMultiplier,
+		MinBaseFeeChangeDenominator: DefaultMinBaseFeeChangeDenominator,
+		MaxBaseFeeDelta:             DefaultMaxBaseFeeDelta,
+		MinTip:                      DefaultMinTip,
+		FeeHistorySize:              DefaultFeeHistorySize,
 	}
 }
 
@@ -103,6 +124,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMaxBaseFeeDelta(p.MaxBaseFeeDelta); err != nil {
+		return err
+	}
+
+	if err := validateMinTip(p.MinTip); err != nil {
+		return err
+	}
+
+	if p.FeeHistorySize > MaxFeeHistorySize {
+		return fmt.Errorf("fee history size cannot exceed %d blocks: %d", MaxFeeHistorySize, p.FeeHistorySize)
+	}
+
 	return validateMinGasPrice(p.MinGasPrice)
 }
 
@@ -203,3 +236,31 @@ func validateMinGasMultiplier(i interface{}) error {
 	}
 	return nil
 }
+
+func validateMaxBaseFeeDelta(i interface{}) error {
+	value, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// NOTE: a nil value is accepted since it is equivalent to 0 (i.e disabled)
+	if !value.IsNil() && value.IsNegative() {
+		return fmt.Errorf("max base fee delta cannot be negative: %s", value)
+	}
+
+	return nil
+}
+
+func validateMinTip(i interface{}) error {
+	value, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// NOTE: a nil value is accepted since it is equivalent to 0 (i.e disabled)
+	if !value.IsNil() && value.IsNegative() {
+		return fmt.Errorf("min tip cannot be negative: %s", value)
+	}
+
+	return nil
+}
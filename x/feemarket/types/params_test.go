@@ -52,11 +52,63 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), DefaultMinGasPrice, math.LegacyNewDecWithPrec(-5, 1)),
 			true,
 		},
+		{
+			"valid: base fee change bounds",
+			Params{
+				BaseFeeChangeDenominator:    8,
+				BaseFee:                     math.NewInt(2000000000),
+				MinGasPrice:                 DefaultMinGasPrice,
+				MinGasMultiplier:            DefaultMinGasMultiplier,
+				MinBaseFeeChangeDenominator: 16,
+				MaxBaseFeeDelta:             math.NewInt(1000),
+			},
+			false,
+		},
+		{
+			"invalid: max base fee delta is negative",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.NewInt(2000000000),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				MaxBaseFeeDelta:          math.NewInt(-1),
+			},
+			true,
+		},
+		{
+			"invalid: min tip is negative",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.NewInt(2000000000),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				MinTip:                   math.NewInt(-1),
+			},
+			true,
+		},
 		{
 			"invalid: min gas multiplier bigger than 1",
 			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), math.LegacyNewDecWithPrec(20, 4), math.LegacyNewDec(2)),
 			true,
 		},
+		{
+			"valid: max fee history size",
+			func() Params {
+				params := DefaultParams()
+				params.FeeHistorySize = MaxFeeHistorySize
+				return params
+			}(),
+			false,
+		},
+		{
+			"invalid: fee history size above the maximum",
+			func() Params {
+				params := DefaultParams()
+				params.FeeHistorySize = MaxFeeHistorySize + 1
+				return params
+			}(),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
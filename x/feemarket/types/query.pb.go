@@ -116,6 +116,9 @@ func (m *QueryParamsResponse) GetParams() Params {
 // QueryBaseFeeRequest defines the request type for querying the EIP1559 base
 // fee.
 type QueryBaseFeeRequest struct {
+	// height is the block height at which to query the base fee. If zero, the
+	// base fee of the latest state is returned.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
 func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
@@ -151,6 +154,13 @@ func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
 
+func (m *QueryBaseFeeRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // QueryBaseFeeResponse returns the EIP1559 base fee.
 type QueryBaseFeeResponse struct {
 	// base_fee is the EIP1559 base fee
@@ -274,6 +284,100 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryFeeHistoryRequest defines the request type for querying the fee data of
+// the most recent blocks.
+type QueryFeeHistoryRequest struct {
+	// blocks is the maximum number of most recent blocks to return. If 0, the
+	// fee data of up to 100 blocks is returned.
+	Blocks uint32 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *QueryFeeHistoryRequest) Reset()         { *m = QueryFeeHistoryRequest{} }
+func (m *QueryFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{6}
+}
+func (m *QueryFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryRequest) GetBlocks() uint32 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+// QueryFeeHistoryResponse returns the fee data of the most recent blocks.
+type QueryFeeHistoryResponse struct {
+	// fee_history is the fee data of the blocks in ascending height order
+	FeeHistory []BlockFeeData `protobuf:"bytes,1,rep,name=fee_history,json=feeHistory,proto3" json:"fee_history"`
+}
+
+func (m *QueryFeeHistoryResponse) Reset()         { *m = QueryFeeHistoryResponse{} }
+func (m *QueryFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{7}
+}
+func (m *QueryFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryResponse) GetFeeHistory() []BlockFeeData {
+	if m != nil {
+		return m.FeeHistory
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.feemarket.v1.QueryParamsResponse")
@@ -281,6 +385,8 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "ethermint.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "ethermint.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryFeeHistoryRequest)(nil), "ethermint.feemarket.v1.QueryFeeHistoryRequest")
+	proto.RegisterType((*QueryFeeHistoryResponse)(nil), "ethermint.feemarket.v1.QueryFeeHistoryResponse")
 }
 
 func init() {
@@ -288,35 +394,41 @@ func init() {
 }
 
 var fileDescriptor_71a07c1ffd85fde2 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0x12, 0x48, 0xcb, 0x72, 0x41, 0x4b, 0x52, 0x81, 0x15, 0x36, 0xc8, 0x02, 0xc4, 0x47,
-	0xd9, 0x55, 0x0a, 0xe2, 0xc4, 0xc9, 0x87, 0x22, 0x24, 0x0e, 0x60, 0x6e, 0x5c, 0xaa, 0x75, 0x98,
-	0x3a, 0x56, 0x6a, 0xaf, 0xeb, 0xdd, 0x58, 0xf4, 0x8a, 0xc4, 0x85, 0x03, 0x42, 0xe2, 0x77, 0xf0,
-	0x3f, 0x7a, 0xac, 0xc4, 0x05, 0x71, 0xa8, 0x50, 0xc2, 0x0f, 0x41, 0xde, 0x5d, 0x07, 0x0c, 0x09,
-	0xcd, 0xc5, 0x1a, 0x8d, 0xdf, 0xbc, 0xf7, 0xe6, 0x8d, 0x16, 0xfb, 0xa0, 0xc7, 0x50, 0xa4, 0x49,
-	0xa6, 0xf9, 0x3e, 0x40, 0x2a, 0x8a, 0x09, 0x68, 0x5e, 0x0e, 0xf9, 0xe1, 0x14, 0x8a, 0x23, 0x96,
-	0x17, 0x52, 0x4b, 0xb2, 0xb5, 0xc0, 0xb0, 0x05, 0x86, 0x95, 0x43, 0xef, 0xf6, 0x8a, 0xd9, 0xdf,
-	0x20, 0x33, 0xef, 0x75, 0x63, 0x19, 0x4b, 0x53, 0xf2, 0xaa, 0x72, 0xdd, 0x7e, 0x2c, 0x65, 0x7c,
-	0x00, 0x5c, 0xe4, 0x09, 0x17, 0x59, 0x26, 0xb5, 0xd0, 0x89, 0xcc, 0x94, 0xfd, 0xeb, 0x77, 0x31,
-	0x79, 0x59, 0x59, 0x78, 0x21, 0x0a, 0x91, 0xaa, 0x10, 0x0e, 0xa7, 0xa0, 0xb4, 0xff, 0x0a, 0x5f,
-	0x69, 0x74, 0x55, 0x2e, 0x33, 0x05, 0xe4, 0x09, 0xee, 0xe4, 0xa6, 0x73, 0x15, 0xdd, 0x40, 0x77,
-	0x2e, 0xed, 0x50, 0xb6, 0xdc, 0x31, 0xb3, 0x73, 0xc1, 0xf9, 0xe3, 0xd3, 0x41, 0x2b, 0x74, 0x33,
-	0x7e, 0xcf, 0x91, 0x06, 0x42, 0xc1, 0x2e, 0x40, 0xad, 0xf5, 0x1c, 0x77, 0x9b, 0x6d, 0x27, 0xf6,
-	0x08, 0x6f, 0x46, 0x42, 0xc1, 0xde, 0x3e, 0x80, 0x91, 0xbb, 0x18, 0x5c, 0xfb, 0x7e, 0x3a, 0xe8,
-	0x8d, 0xa4, 0x4a, 0xa5, 0x52, 0x6f, 0x26, 0x2c, 0x91, 0x3c, 0x15, 0x7a, 0xcc, 0x9e, 0x65, 0x3a,
-	0xdc, 0x88, 0xec, 0xb4, 0xbf, 0x55, 0xb3, 0x1d, 0xc8, 0xd1, 0xe4, 0xa9, 0x58, 0x6c, 0x74, 0x17,
-	0xf7, 0xfe, 0xea, 0x3b, 0x99, 0xcb, 0xb8, 0x1d, 0x0b, 0xbb, 0x50, 0x3b, 0xac, 0xca, 0x9d, 0x2f,
-	0x6d, 0x7c, 0xc1, 0x60, 0xc9, 0x7b, 0x84, 0x3b, 0x76, 0x15, 0x72, 0x6f, 0xd5, 0xaa, 0xff, 0xa6,
-	0xe7, 0xdd, 0x5f, 0x0b, 0x6b, 0xf5, 0x7d, 0xff, 0xdd, 0xd7, 0x9f, 0x9f, 0xcf, 0xf5, 0x89, 0xc7,
-	0xa1, 0x4c, 0xa5, 0x6a, 0x5e, 0xd8, 0x26, 0x47, 0x3e, 0x20, 0xbc, 0xe1, 0xe2, 0x21, 0xff, 0x27,
-	0x6f, 0x66, 0xeb, 0x6d, 0xaf, 0x07, 0x76, 0x56, 0x6e, 0x1a, 0x2b, 0x94, 0xf4, 0x97, 0x59, 0xa9,
-	0x6f, 0x41, 0x3e, 0x22, 0xbc, 0x59, 0xa7, 0x48, 0xce, 0x10, 0x68, 0x1e, 0xc1, 0x7b, 0xb0, 0x26,
-	0xda, 0xf9, 0xb9, 0x65, 0xfc, 0x0c, 0xc8, 0xf5, 0xa5, 0x7e, 0x2a, 0xf4, 0x5e, 0x2c, 0x54, 0xb0,
-	0x7b, 0x3c, 0xa3, 0xe8, 0x64, 0x46, 0xd1, 0x8f, 0x19, 0x45, 0x9f, 0xe6, 0xb4, 0x75, 0x32, 0xa7,
-	0xad, 0x6f, 0x73, 0xda, 0x7a, 0xbd, 0x1d, 0x27, 0x7a, 0x3c, 0x8d, 0xd8, 0x48, 0xa6, 0x8e, 0xc2,
-	0x7e, 0xcb, 0xe1, 0x63, 0xfe, 0xf6, 0x0f, 0x3a, 0x7d, 0x94, 0x83, 0x8a, 0x3a, 0xe6, 0x45, 0x3c,
-	0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0xc1, 0xd3, 0xfa, 0x92, 0xab, 0x03, 0x00, 0x00,
+	// 544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xc1, 0x6f, 0x12, 0x41,
+	0x14, 0xc6, 0x19, 0xa9, 0xb4, 0x0e, 0x31, 0x31, 0x23, 0xc5, 0xba, 0xc1, 0xa5, 0x4e, 0xaa, 0x56,
+	0x6d, 0x77, 0x04, 0x8d, 0x27, 0x4f, 0xc4, 0xa0, 0x46, 0x0f, 0x8a, 0x37, 0x2f, 0x64, 0xc0, 0xc7,
+	0xee, 0x86, 0xee, 0x0e, 0xdd, 0x19, 0x88, 0x5c, 0x4d, 0xbc, 0x78, 0x30, 0x26, 0x26, 0xfe, 0x49,
+	0xa6, 0xc7, 0x26, 0x5e, 0x8c, 0x87, 0xc6, 0x80, 0x7f, 0x88, 0xd9, 0xd9, 0x81, 0xb2, 0x02, 0x2d,
+	0x17, 0x32, 0xfb, 0xf8, 0xbe, 0xef, 0xfd, 0x76, 0xde, 0xcb, 0x62, 0x0a, 0xca, 0x83, 0x28, 0xf0,
+	0x43, 0xc5, 0x3a, 0x00, 0x01, 0x8f, 0xba, 0xa0, 0xd8, 0xa0, 0xc2, 0x0e, 0xfb, 0x10, 0x0d, 0x9d,
+	0x5e, 0x24, 0x94, 0x20, 0xc5, 0xa9, 0xc6, 0x99, 0x6a, 0x9c, 0x41, 0xc5, 0xba, 0xbd, 0xc4, 0x7b,
+	0x2a, 0xd2, 0x7e, 0xab, 0xe0, 0x0a, 0x57, 0xe8, 0x23, 0x8b, 0x4f, 0xa6, 0x5a, 0x72, 0x85, 0x70,
+	0x0f, 0x80, 0xf1, 0x9e, 0xcf, 0x78, 0x18, 0x0a, 0xc5, 0x95, 0x2f, 0x42, 0x99, 0xfc, 0x4b, 0x0b,
+	0x98, 0xbc, 0x89, 0x11, 0x5e, 0xf3, 0x88, 0x07, 0xb2, 0x01, 0x87, 0x7d, 0x90, 0x8a, 0xbe, 0xc5,
+	0x57, 0x53, 0x55, 0xd9, 0x13, 0xa1, 0x04, 0xf2, 0x04, 0xe7, 0x7a, 0xba, 0xb2, 0x85, 0xb6, 0xd1,
+	0x6e, 0xbe, 0x6a, 0x3b, 0x8b, 0x89, 0x9d, 0xc4, 0x57, 0x5b, 0x3b, 0x3a, 0x29, 0x67, 0x1a, 0xc6,
+	0x43, 0xf7, 0x4d, 0x68, 0x8d, 0x4b, 0xa8, 0x03, 0x98, 0x5e, 0xa4, 0x88, 0x73, 0x1e, 0xf8, 0xae,
+	0xa7, 0x74, 0x68, 0xb6, 0x61, 0x9e, 0xe8, 0x2b, 0x5c, 0x48, 0xcb, 0x0d, 0xc4, 0x23, 0xbc, 0xd1,
+	0xe2, 0x12, 0x9a, 0x1d, 0x00, 0xed, 0xb8, 0x54, 0xbb, 0xfe, 0xfb, 0xa4, 0xbc, 0xd9, 0x16, 0x32,
+	0x10, 0x52, 0xbe, 0xef, 0x3a, 0xbe, 0x60, 0x01, 0x57, 0x9e, 0xf3, 0x22, 0x54, 0x8d, 0xf5, 0x56,
+	0xe2, 0xa6, 0xc5, 0x49, 0xda, 0x81, 0x68, 0x77, 0x9f, 0xf1, 0xe9, 0x9b, 0xde, 0xc5, 0x9b, 0xff,
+	0xd5, 0x4d, 0x9b, 0x2b, 0x38, 0xeb, 0x72, 0x69, 0x98, 0xe2, 0x23, 0x7d, 0x80, 0x8b, 0x5a, 0x5a,
+	0x07, 0x78, 0xee, 0x4b, 0x25, 0xa2, 0xe1, 0xcc, 0x2b, 0xb4, 0x62, 0x7f, 0x22, 0xbf, 0xdc, 0x30,
+	0x4f, 0xb4, 0x83, 0xaf, 0xcd, 0x39, 0x4c, 0xfc, 0x4b, 0x9c, 0xef, 0x00, 0x34, 0xbd, 0xa4, 0xbc,
+	0x85, 0xb6, 0xb3, 0xbb, 0xf9, 0xea, 0xce, 0xb2, 0xfb, 0xd4, 0x74, 0x75, 0x80, 0xa7, 0x5c, 0x71,
+	0x73, 0xab, 0xb8, 0x33, 0x0d, 0xad, 0xfe, 0x58, 0xc3, 0x17, 0x75, 0x23, 0xf2, 0x09, 0xe1, 0x5c,
+	0x72, 0xf9, 0xe4, 0xde, 0xb2, 0xb0, 0xf9, 0x79, 0x5b, 0xf7, 0x57, 0xd2, 0x26, 0xe8, 0x94, 0x7e,
+	0xfc, 0xf9, 0xf7, 0xdb, 0x85, 0x12, 0xb1, 0x18, 0x0c, 0x02, 0x21, 0xd3, 0x3b, 0x99, 0xcc, 0x9a,
+	0x7c, 0x46, 0x78, 0xdd, 0x0c, 0x8e, 0x9c, 0x1d, 0x9e, 0xde, 0x06, 0x6b, 0x6f, 0x35, 0xb1, 0x41,
+	0xd9, 0xd1, 0x28, 0x36, 0x29, 0x2d, 0x42, 0x99, 0x6c, 0x09, 0xf9, 0x82, 0xf0, 0xc6, 0x64, 0xbe,
+	0xe4, 0x9c, 0x06, 0xe9, 0xf5, 0xb0, 0xf6, 0x57, 0x54, 0x1b, 0x9e, 0x5b, 0x9a, 0xa7, 0x4c, 0x6e,
+	0x2c, 0xe4, 0x89, 0xd5, 0x4d, 0x97, 0x4b, 0xf2, 0x1d, 0x61, 0x7c, 0xba, 0x13, 0xc4, 0x39, 0xb3,
+	0xc9, 0xdc, 0xba, 0x59, 0x6c, 0x65, 0xbd, 0xc1, 0xba, 0xa3, 0xb1, 0x6e, 0x92, 0xf2, 0x22, 0xac,
+	0x99, 0x35, 0xac, 0xd5, 0x8f, 0x46, 0x36, 0x3a, 0x1e, 0xd9, 0xe8, 0xcf, 0xc8, 0x46, 0x5f, 0xc7,
+	0x76, 0xe6, 0x78, 0x6c, 0x67, 0x7e, 0x8d, 0xed, 0xcc, 0xbb, 0x3d, 0xd7, 0x57, 0x5e, 0xbf, 0xe5,
+	0xb4, 0x45, 0x60, 0x42, 0x92, 0xdf, 0x41, 0xe5, 0x31, 0xfb, 0x30, 0x13, 0xa8, 0x86, 0x3d, 0x90,
+	0xad, 0x9c, 0xfe, 0xb8, 0x3c, 0xfc, 0x37, 0x00, 0xcd, 0x61, 0xfb, 0xc2, 0xf6, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -333,10 +445,13 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries the parameters of x/feemarket module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// BaseFee queries the base fee of the parent block of the current block.
+	// BaseFee queries the base fee of the parent block of the current block, or
+	// the base fee in effect at the given block height.
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// FeeHistory queries the fee data of the most recent blocks
+	FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -374,14 +489,26 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error) {
+	out := new(QueryFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/FeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// BaseFee queries the base fee of the parent block of the current block.
+	// BaseFee queries the base fee of the parent block of the current block, or
+	// the base fee in effect at the given block height.
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// FeeHistory queries the fee data of the most recent blocks
+	FeeHistory(context.Context, *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -397,6 +524,9 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) FeeHistory(ctx context.Context, req *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -456,6 +586,24 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/FeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeHistory(ctx, req.(*QueryFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -472,6 +620,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "FeeHistory",
+			Handler:    _Query_FeeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -553,6 +705,11 @@ func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -642,6 +799,71 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeHistory) > 0 {
+		for iNdEx := len(m.FeeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -679,6 +901,9 @@ func (m *QueryBaseFeeRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
 	return n
 }
 
@@ -716,6 +941,33 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *QueryFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeHistory) > 0 {
+		for _, e := range m.FeeHistory {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -884,6 +1136,25 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1110,6 +1381,159 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFeeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeHistory = append(m.FeeHistory, BlockFeeData{})
+			if err := m.FeeHistory[len(m.FeeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0